@@ -0,0 +1,318 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package algo
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Graph is a directed graph held in memory, used to run graph analytics. Nodes are identified by
+// their position in Uids, which is sorted, and Out holds the positions of the nodes that every
+// node has an edge to.
+type Graph struct {
+	Uids []uint64
+	Out  [][]int
+}
+
+// NewGraph returns a graph over the given sorted uids, without any edge.
+func NewGraph(uids []uint64) *Graph {
+	return &Graph{
+		Uids: uids,
+		Out:  make([][]int, len(uids)),
+	}
+}
+
+// Index returns the position of uid in the graph, or -1 if it is not a node of the graph.
+func (g *Graph) Index(uid uint64) int {
+	i := sort.Search(len(g.Uids), func(i int) bool { return g.Uids[i] >= uid })
+	if i < len(g.Uids) && g.Uids[i] == uid {
+		return i
+	}
+	return -1
+}
+
+// AddEdge adds an edge between the nodes at the positions from and to. Parallel edges and self
+// loops are kept, the algorithms deal with them.
+func (g *Graph) AddEdge(from, to int) {
+	g.Out[from] = append(g.Out[from], to)
+}
+
+// undirected returns the sorted, deduplicated neighbours of every node ignoring the direction of
+// the edges and the self loops.
+func (g *Graph) undirected() [][]int {
+	adj := make([][]int, len(g.Uids))
+	for from, out := range g.Out {
+		for _, to := range out {
+			if from == to {
+				continue
+			}
+			adj[from] = append(adj[from], to)
+			adj[to] = append(adj[to], from)
+		}
+	}
+	for i, l := range adj {
+		sort.Ints(l)
+		out := l[:0]
+		for j, n := range l {
+			if j == 0 || n != l[j-1] {
+				out = append(out, n)
+			}
+		}
+		adj[i] = out
+	}
+	return adj
+}
+
+// PageRank returns the PageRank of every node. The rank of the nodes without outgoing edges is
+// spread over all the nodes. It stops after maxIter iterations, or earlier once the ranks change
+// by less than tolerance in total. The ranks add up to 1.
+func PageRank(g *Graph, damping float64, maxIter int, tolerance float64) []float64 {
+	n := len(g.Uids)
+	if n == 0 {
+		return nil
+	}
+	rank := make([]float64, n)
+	next := make([]float64, n)
+	for i := range rank {
+		rank[i] = 1 / float64(n)
+	}
+	for range maxIter {
+		var dangling float64
+		for i, out := range g.Out {
+			if len(out) == 0 {
+				dangling += rank[i]
+			}
+		}
+		base := (1-damping)/float64(n) + damping*dangling/float64(n)
+		for i := range next {
+			next[i] = base
+		}
+		for i, out := range g.Out {
+			if len(out) == 0 {
+				continue
+			}
+			share := damping * rank[i] / float64(len(out))
+			for _, to := range out {
+				next[to] += share
+			}
+		}
+
+		var delta float64
+		for i := range rank {
+			delta += math.Abs(next[i] - rank[i])
+		}
+		rank, next = next, rank
+		if delta < tolerance {
+			break
+		}
+	}
+	return rank
+}
+
+// WeaklyConnectedComponents returns, for every node, the position of the smallest node of the
+// component it belongs to when the direction of the edges is ignored.
+func WeaklyConnectedComponents(g *Graph) []int {
+	parent := make([]int, len(g.Uids))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		for parent[i] != i {
+			parent[i] = parent[parent[i]]
+			i = parent[i]
+		}
+		return i
+	}
+	for from, out := range g.Out {
+		for _, to := range out {
+			a, b := find(from), find(to)
+			// Keep the smallest node as the root so that it labels the component.
+			switch {
+			case a < b:
+				parent[b] = a
+			case b < a:
+				parent[a] = b
+			}
+		}
+	}
+	comp := make([]int, len(parent))
+	for i := range comp {
+		comp[i] = find(i)
+	}
+	return comp
+}
+
+// StronglyConnectedComponents returns, for every node, the position of the smallest node of the
+// strongly connected component it belongs to. It runs an iterative version of Tarjan's
+// algorithm, so that long paths don't overflow the stack.
+func StronglyConnectedComponents(g *Graph) []int {
+	n := len(g.Uids)
+	index := make([]int, n)
+	low := make([]int, n)
+	onStack := make([]bool, n)
+	comp := make([]int, n)
+	for i := range index {
+		index[i] = -1
+	}
+
+	type frame struct {
+		node int
+		edge int
+	}
+	var stack []int
+	var calls []frame
+	next := 0
+	for root := range n {
+		if index[root] != -1 {
+			continue
+		}
+		calls = append(calls, frame{node: root})
+		for len(calls) > 0 {
+			top := &calls[len(calls)-1]
+			v := top.node
+			if top.edge == 0 {
+				index[v], low[v] = next, next
+				next++
+				stack = append(stack, v)
+				onStack[v] = true
+			}
+
+			// Visit the next unvisited neighbour, if any.
+			recursed := false
+			for top.edge < len(g.Out[v]) {
+				w := g.Out[v][top.edge]
+				top.edge++
+				if index[w] == -1 {
+					calls = append(calls, frame{node: w})
+					recursed = true
+					break
+				}
+				if onStack[w] && index[w] < low[v] {
+					low[v] = index[w]
+				}
+			}
+			if recursed {
+				continue
+			}
+
+			if low[v] == index[v] {
+				// v is the root of a component, pop it off the stack.
+				start := len(stack) - 1
+				for stack[start] != v {
+					start--
+				}
+				members := stack[start:]
+				smallest := v
+				for _, w := range members {
+					if w < smallest {
+						smallest = w
+					}
+				}
+				for _, w := range members {
+					onStack[w] = false
+					comp[w] = smallest
+				}
+				stack = stack[:start]
+			}
+			calls = calls[:len(calls)-1]
+			if len(calls) > 0 {
+				parent := calls[len(calls)-1].node
+				if low[v] < low[parent] {
+					low[parent] = low[v]
+				}
+			}
+		}
+	}
+	return comp
+}
+
+// LabelPropagation detects communities by repeatedly giving every node the label that is the
+// most frequent among its neighbours, ignoring the direction of the edges. Every node starts
+// with its own position as label. The nodes are visited in a random order and ties are broken
+// randomly, unless the current label of the node is one of the most frequent ones. The random
+// numbers come from a fixed seed, so that the result is the same on every run. It stops after
+// maxIter iterations or once no label changes.
+func LabelPropagation(g *Graph, maxIter int) []int {
+	adj := g.undirected()
+	labels := make([]int, len(adj))
+	order := make([]int, len(adj))
+	for i := range labels {
+		labels[i] = i
+		order[i] = i
+	}
+	rng := rand.New(rand.NewSource(1))
+	counts := make(map[int]int)
+	var candidates []int
+	for range maxIter {
+		rng.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+		changed := false
+		for _, v := range order {
+			if len(adj[v]) == 0 {
+				continue
+			}
+			clear(counts)
+			maxCount := 0
+			for _, w := range adj[v] {
+				counts[labels[w]]++
+				maxCount = max(maxCount, counts[labels[w]])
+			}
+			if counts[labels[v]] == maxCount {
+				continue
+			}
+			candidates = candidates[:0]
+			for l, c := range counts {
+				if c == maxCount {
+					candidates = append(candidates, l)
+				}
+			}
+			// Map iteration order is random, sort to only depend on the seed.
+			sort.Ints(candidates)
+			labels[v] = candidates[rng.Intn(len(candidates))]
+			changed = true
+		}
+		if !changed {
+			break
+		}
+	}
+	return labels
+}
+
+// TriangleCount returns the number of triangles that every node is part of, ignoring the
+// direction of the edges.
+func TriangleCount(g *Graph) []int64 {
+	adj := g.undirected()
+	counts := make([]int64, len(adj))
+	for u, nu := range adj {
+		for _, v := range nu {
+			if v <= u {
+				continue
+			}
+			// Count the common neighbours w > v, so that every triangle u < v < w is seen once.
+			nv := adj[v]
+			i, j := 0, 0
+			for i < len(nu) && j < len(nv) {
+				switch {
+				case nu[i] < nv[j]:
+					i++
+				case nu[i] > nv[j]:
+					j++
+				default:
+					if w := nu[i]; w > v {
+						counts[u]++
+						counts[v]++
+						counts[w]++
+					}
+					i++
+					j++
+				}
+			}
+		}
+	}
+	return counts
+}
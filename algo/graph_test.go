@@ -0,0 +1,95 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package algo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testGraph builds a graph with nodes 1..n and the given edges between them.
+func testGraph(n int, edges [][2]uint64) *Graph {
+	uids := make([]uint64, n)
+	for i := range uids {
+		uids[i] = uint64(i + 1)
+	}
+	g := NewGraph(uids)
+	for _, e := range edges {
+		g.AddEdge(g.Index(e[0]), g.Index(e[1]))
+	}
+	return g
+}
+
+func TestGraphIndex(t *testing.T) {
+	g := NewGraph([]uint64{3, 7, 9})
+	require.Equal(t, 1, g.Index(7))
+	require.Equal(t, -1, g.Index(8))
+	require.Equal(t, -1, g.Index(10))
+}
+
+func TestPageRank(t *testing.T) {
+	// 1 and 2 both point to 3, which points back to 1.
+	g := testGraph(3, [][2]uint64{{1, 3}, {2, 3}, {3, 1}})
+	rank := PageRank(g, 0.85, 100, 1e-9)
+
+	var sum float64
+	for _, r := range rank {
+		sum += r
+	}
+	require.InDelta(t, 1.0, sum, 1e-6)
+	require.Greater(t, rank[2], rank[0])
+	require.Greater(t, rank[0], rank[1])
+}
+
+func TestPageRankDanglingNodes(t *testing.T) {
+	g := testGraph(2, [][2]uint64{{1, 2}})
+	rank := PageRank(g, 0.85, 100, 1e-9)
+	require.InDelta(t, 1.0, rank[0]+rank[1], 1e-6)
+	require.Greater(t, rank[1], rank[0])
+}
+
+func TestWeaklyConnectedComponents(t *testing.T) {
+	g := testGraph(6, [][2]uint64{{2, 1}, {3, 2}, {5, 4}})
+	require.Equal(t, []int{0, 0, 0, 3, 3, 5}, WeaklyConnectedComponents(g))
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	// 1 -> 2 -> 3 -> 1 is a cycle, 3 -> 4 -> 5 -> 4 has another one and 6 is on its own.
+	g := testGraph(6, [][2]uint64{{1, 2}, {2, 3}, {3, 1}, {3, 4}, {4, 5}, {5, 4}})
+	require.Equal(t, []int{0, 0, 0, 3, 3, 5}, StronglyConnectedComponents(g))
+}
+
+func TestStronglyConnectedComponentsLongPath(t *testing.T) {
+	const n = 100000
+	var edges [][2]uint64
+	for i := uint64(1); i < n; i++ {
+		edges = append(edges, [2]uint64{i, i + 1})
+	}
+	edges = append(edges, [2]uint64{n, 1})
+	comp := StronglyConnectedComponents(testGraph(n, edges))
+	for _, c := range comp {
+		require.Equal(t, 0, c)
+	}
+}
+
+func TestLabelPropagation(t *testing.T) {
+	// Two triangles joined by a single edge between 3 and 4.
+	g := testGraph(6, [][2]uint64{{1, 2}, {2, 3}, {3, 1}, {4, 5}, {5, 6}, {6, 4}, {3, 4}})
+	labels := LabelPropagation(g, 10)
+	require.Equal(t, labels[0], labels[1])
+	require.Equal(t, labels[0], labels[2])
+	require.Equal(t, labels[3], labels[4])
+	require.Equal(t, labels[3], labels[5])
+	require.NotEqual(t, labels[0], labels[3])
+}
+
+func TestTriangleCount(t *testing.T) {
+	// 1, 2, 3 and 4 form a clique with edges in both directions between 1 and 2, 5 hangs off 4.
+	g := testGraph(5, [][2]uint64{
+		{1, 2}, {2, 1}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}, {4, 5}, {5, 5}})
+	require.Equal(t, []int64{3, 3, 3, 3, 0}, TriangleCount(g))
+}
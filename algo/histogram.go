@@ -0,0 +1,117 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package algo
+
+import (
+	"bytes"
+	"sort"
+)
+
+// Histogram is a bounded, equi-depth-ish histogram over byte-string keys. It is meant to be
+// used for keys that sort in the same order as the values they encode, for example the tokens
+// produced by sortable tokenizers. Each bucket covers a closed range [Lower, Upper] of keys and
+// keeps the total count and the number of distinct keys added to it. Once the number of buckets
+// grows past the configured maximum, the two adjacent buckets with the smallest combined count
+// are merged, so that dense regions of the key space retain a finer resolution.
+type Histogram struct {
+	buckets    []*bucket
+	maxBuckets int
+	total      uint64
+}
+
+type bucket struct {
+	lower    []byte
+	upper    []byte
+	count    uint64
+	distinct uint64
+}
+
+// NewHistogram returns a new Histogram that keeps at most maxBuckets buckets.
+func NewHistogram(maxBuckets int) *Histogram {
+	if maxBuckets < 2 {
+		maxBuckets = 2
+	}
+	return &Histogram{maxBuckets: maxBuckets}
+}
+
+// NumBuckets returns the number of buckets currently held by the histogram.
+func (h *Histogram) NumBuckets() int {
+	return len(h.buckets)
+}
+
+// TotalCount returns the sum of all the counts added to the histogram.
+func (h *Histogram) TotalCount() uint64 {
+	return h.total
+}
+
+// Add adds n to the count of key. isNew should be set if key has not been added to the
+// histogram before, it is used to keep track of the number of distinct keys per bucket.
+func (h *Histogram) Add(key []byte, n uint64, isNew bool) {
+	h.total += n
+
+	// Find the first bucket whose upper bound is >= key.
+	idx := sort.Search(len(h.buckets), func(i int) bool {
+		return bytes.Compare(h.buckets[i].upper, key) >= 0
+	})
+	if idx < len(h.buckets) && bytes.Compare(h.buckets[idx].lower, key) <= 0 {
+		b := h.buckets[idx]
+		b.count += n
+		if isNew {
+			b.distinct++
+		}
+		return
+	}
+
+	k := append([]byte{}, key...)
+	b := &bucket{lower: k, upper: k, count: n, distinct: 1}
+	h.buckets = append(h.buckets, nil)
+	copy(h.buckets[idx+1:], h.buckets[idx:])
+	h.buckets[idx] = b
+
+	if len(h.buckets) > h.maxBuckets {
+		h.mergeSmallest()
+	}
+}
+
+// mergeSmallest merges the pair of adjacent buckets with the smallest combined count.
+func (h *Histogram) mergeSmallest() {
+	best := 0
+	bestCount := ^uint64(0)
+	for i := 0; i+1 < len(h.buckets); i++ {
+		if c := h.buckets[i].count + h.buckets[i+1].count; c < bestCount {
+			best, bestCount = i, c
+		}
+	}
+	left, right := h.buckets[best], h.buckets[best+1]
+	left.upper = right.upper
+	left.count += right.count
+	left.distinct += right.distinct
+	h.buckets = append(h.buckets[:best+1], h.buckets[best+2:]...)
+}
+
+// EstimateRange returns the estimated sum of counts of the keys that lie in the range
+// [lower, upper]. A nil bound means that the range is unbounded on that side. Buckets that
+// only partially overlap the range contribute half of their count, since there is no way to
+// interpolate between arbitrary byte strings.
+func (h *Histogram) EstimateRange(lower, upper []byte) uint64 {
+	var est uint64
+	for _, b := range h.buckets {
+		if lower != nil && bytes.Compare(b.upper, lower) < 0 {
+			continue
+		}
+		if upper != nil && bytes.Compare(b.lower, upper) > 0 {
+			break
+		}
+		fullyInside := (lower == nil || bytes.Compare(b.lower, lower) >= 0) &&
+			(upper == nil || bytes.Compare(b.upper, upper) <= 0)
+		if fullyInside {
+			est += b.count
+			continue
+		}
+		est += b.count / 2
+	}
+	return est
+}
@@ -0,0 +1,56 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package algo
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func histKey(i uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], i)
+	return b[:]
+}
+
+func TestHistogramAddAndEstimate(t *testing.T) {
+	h := NewHistogram(8)
+	for i := uint64(0); i < 100; i++ {
+		h.Add(histKey(i), 10, true)
+	}
+	require.LessOrEqual(t, h.NumBuckets(), 8)
+	require.EqualValues(t, 1000, h.TotalCount())
+	require.EqualValues(t, 1000, h.EstimateRange(nil, nil))
+
+	// Half of the keys lie in the range, the estimate should be in the right ballpark.
+	est := h.EstimateRange(histKey(50), nil)
+	require.Greater(t, est, uint64(300))
+	require.Less(t, est, uint64(700))
+
+	require.EqualValues(t, 0, h.EstimateRange(histKey(200), nil))
+}
+
+func TestHistogramExistingKey(t *testing.T) {
+	h := NewHistogram(4)
+	h.Add(histKey(1), 5, true)
+	h.Add(histKey(1), 3, false)
+	h.Add(histKey(7), 2, true)
+	require.Equal(t, 2, h.NumBuckets())
+	require.EqualValues(t, 8, h.EstimateRange(histKey(1), histKey(1)))
+	require.EqualValues(t, 2, h.EstimateRange(histKey(2), nil))
+}
+
+func TestHistogramMergesSmallestBuckets(t *testing.T) {
+	h := NewHistogram(2)
+	h.Add(histKey(1), 1000, true)
+	h.Add(histKey(5), 1, true)
+	h.Add(histKey(9), 1, true)
+	require.Equal(t, 2, h.NumBuckets())
+	// The heavy bucket must remain on its own.
+	require.EqualValues(t, 1000, h.EstimateRange(nil, histKey(1)))
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/hypermodeinc/dgraph/v25/lex"
+	"github.com/hypermodeinc/dgraph/v25/types"
 	"github.com/hypermodeinc/dgraph/v25/types/facets"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
@@ -160,6 +161,26 @@ var testNQuads = []struct {
 			ObjectValue: &api.Value{Val: &api.Value_PasswordVal{PasswordVal: "password1"}},
 		},
 	},
+	{
+		input: `_:alice <embedding> "[1, -2, 3]"^^<xs:[]int8> .`,
+		nq: api.NQuad{
+			Subject:   "_:alice",
+			Predicate: "embedding",
+			ObjectId:  "",
+			ObjectValue: &api.Value{Val: &api.Value_Vfloat32Val{
+				Vfloat32Val: types.FloatArrayAsBytes([]float32{1, -2, 3})}},
+		},
+	},
+	{
+		input: `_:alice <embedding> "[1, 0, 0, 1, 1, 0, 1, 0]"^^<xs:[]binary> .`,
+		nq: api.NQuad{
+			Subject:   "_:alice",
+			Predicate: "embedding",
+			ObjectId:  "",
+			ObjectValue: &api.Value{Val: &api.Value_Vfloat32Val{
+				Vfloat32Val: types.FloatArrayAsBytes([]float32{1, 0, 0, 1, 1, 0, 1, 0})}},
+		},
+	},
 	{
 		input: `<http://www.w3.org/2001/sw/RDFCore/nedges/> <http://purl.org/dc/terms/title> "N-Edges"@en-US .`,
 		nq: api.NQuad{
@@ -273,6 +294,10 @@ var testNQuads = []struct {
 		input:       `_:alice <age> "thirteen"^^<xs:int> .`,
 		expectedErr: true,
 	},
+	{
+		input:       `_:alice <embedding> "[300]"^^<xs:[]int8> .`,
+		expectedErr: true,
+	},
 	{
 		input:       `<alice> <knows> <*> .`,
 		expectedErr: true,
@@ -72,6 +72,10 @@ type Node struct {
 
 	Proposals proposals
 
+	// ReadState returns the max assigned timestamp applied by this node and how long it has been
+	// behind the leader of its group. It is sent to the peers with the heartbeats if set.
+	ReadState func() (maxAssigned uint64, lag time.Duration)
+
 	heartbeatsOut int64
 	heartbeatsIn  int64
 }
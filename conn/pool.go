@@ -9,6 +9,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
@@ -336,6 +337,28 @@ func (p *Pool) IsHealthy() bool {
 	return time.Since(p.lastEcho) < 4*echoDuration
 }
 
+// NoLeader is the lag of a node which has no leader.
+const NoLeader = time.Duration(math.MaxInt64)
+
+// LagMs returns the lag in milliseconds sent with the heartbeats, -1 if it is NoLeader.
+func LagMs(lag time.Duration) int64 {
+	if lag == NoLeader {
+		return -1
+	}
+	return lag.Milliseconds()
+}
+
+// ReadState returns the max assigned timestamp applied by the node and how long it has been
+// behind the leader of its group, as of its last heartbeat.
+func (p *Pool) ReadState() (uint64, time.Duration) {
+	p.RLock()
+	defer p.RUnlock()
+	if p.healthInfo.LagMs < 0 {
+		return p.healthInfo.MaxAssigned, NoLeader
+	}
+	return p.healthInfo.MaxAssigned, time.Duration(p.healthInfo.LagMs) * time.Millisecond
+}
+
 // HealthInfo returns the healthinfo.
 func (p *Pool) HealthInfo() pb.HealthInfo {
 	ok := p.IsHealthy()
@@ -290,6 +290,11 @@ func (w *RaftServer) Heartbeat(_ *api.Payload, stream pb.Raft_HeartbeatServer) e
 
 	for {
 		info.Uptime = int64(time.Since(node.StartTime) / time.Second)
+		if node.ReadState != nil {
+			var lag time.Duration
+			info.MaxAssigned, lag = node.ReadState()
+			info.LagMs = LagMs(lag)
+		}
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
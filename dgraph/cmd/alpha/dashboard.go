@@ -58,6 +58,7 @@ func keywordHandler(w http.ResponseWriter, r *http.Request) {
 		"anyoftext",
 		"as",
 		"avg",
+		"bm25",
 		"ceil",
 		"cond",
 		"contains",
@@ -71,6 +72,7 @@ func keywordHandler(w http.ResponseWriter, r *http.Request) {
 		"floor",
 		"fulltext",
 		"func",
+		"fuse",
 		"ge",
 		"gt",
 		"index",
@@ -95,6 +97,7 @@ func keywordHandler(w http.ResponseWriter, r *http.Request) {
 		"regexp",
 		"reverse",
 		"schema",
+		"search",
 		"since",
 		"set",
 		"sqrt",
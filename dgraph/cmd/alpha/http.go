@@ -32,6 +32,7 @@ import (
 	"github.com/hypermodeinc/dgraph/v25/graphql/admin"
 	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
 	"github.com/hypermodeinc/dgraph/v25/query"
+	"github.com/hypermodeinc/dgraph/v25/worker"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
 
@@ -191,11 +192,21 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
+	isExplainMode, err := parseBool(r, "explain")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
 	queryTimeout, err := parseDuration(r, "timeout")
 	if err != nil {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
+	maxStaleness, err := parseDuration(r, "maxStaleness")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
 	startTs, err := parseUint64(r, "startTs")
 	hash := r.URL.Query().Get("hash")
 	if err != nil {
@@ -243,6 +254,11 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 	ctx = x.AttachAccessJwt(ctx, r)
 	ctx = x.AttachRemoteIP(ctx, r)
 
+	var explain *query.Explain
+	if isExplainMode {
+		ctx, explain = query.WithExplain(ctx)
+	}
+
 	if queryTimeout != 0 {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, queryTimeout)
@@ -277,6 +293,27 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		if isReadOnly {
 			req.ReadOnly = true
 		}
+
+		// If maxStaleness is set, run this as a readonly query which can read stale data.
+		if maxStaleness > 0 {
+			req.ReadOnly = true
+			ctx = worker.WithMaxStaleness(ctx, maxStaleness)
+		}
+	}
+
+	// If zone is set, read from the replicas in that zone.
+	if zone := r.URL.Query().Get("zone"); zone != "" {
+		ctx = worker.WithZone(ctx, zone)
+	}
+
+	// If serializable is set, track the keys read by the transaction.
+	isSerializable, err := parseBool(r, "serializable")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	if isSerializable {
+		ctx = worker.WithSerializable(ctx)
 	}
 
 	// If rdf is set true, then response will be in rdf format.
@@ -304,6 +341,7 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		Txn:     resp.Txn,
 		Latency: resp.Latency,
 		Metrics: resp.Metrics,
+		Explain: explain,
 	}
 	js, err := json.Marshal(e)
 	if err != nil {
@@ -358,6 +396,11 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
+	isSerializable, err := parseBool(r, "serializable")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
 	body := readRequest(w, r)
 	if body == nil {
 		return
@@ -468,6 +511,10 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	req.CommitNow = commitNow
 
 	ctx := x.AttachAccessJwt(context.Background(), r)
+	if isSerializable {
+		// The keys written by the transaction are sent to Zero, to check the serializable reads.
+		ctx = worker.WithSerializable(ctx)
+	}
 	resp, err := (&edgraph.Server{}).QueryNoGrpc(ctx, req)
 	if err != nil {
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
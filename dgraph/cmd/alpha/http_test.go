@@ -27,6 +27,7 @@ import (
 	"github.com/hypermodeinc/dgraph/v25/dgraphapi"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/query"
+	"github.com/hypermodeinc/dgraph/v25/worker"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
 
@@ -812,6 +813,75 @@ func TestDebugSupport(t *testing.T) {
 	require.Empty(t, resp.Header.Get("Content-Encoding"))
 }
 
+func TestExplainSupport(t *testing.T) {
+	require.NoError(t, dropAll())
+	require.NoError(t, alterSchema(`
+		name: string @index(exact) .
+		age: int @index(int) .
+		friend: [uid] .`))
+
+	m1 := `
+	{
+	  set {
+		_:a <name> "Alice" .
+		_:a <age> "20" .
+		_:a <friend> _:b .
+		_:b <name> "Bob" .
+		_:b <age> "30" .
+	  }
+	}
+	`
+	require.NoError(t, runMutation(m1))
+
+	q1 := `
+	{
+	  users(func: eq(name, "Alice")) @filter(ge(age, 18)) {
+	    name
+	    friend {
+	      name
+	    }
+	  }
+	}
+	`
+	_, body, err := runWithRetries("POST", "application/dql", addr+"/query?explain=true", q1)
+	require.NoError(t, err)
+
+	var r struct {
+		Extensions struct {
+			Explain query.Explain `json:"explain"`
+		} `json:"extensions"`
+	}
+	require.NoError(t, json.Unmarshal(body, &r))
+	require.Len(t, r.Extensions.Explain.Blocks, 1)
+
+	root := r.Extensions.Explain.Blocks[0]
+	require.Equal(t, "users", root.Alias)
+	require.Equal(t, `eq(name, Alice)`, root.Func)
+	require.Equal(t, worker.AccessIndex, root.Access)
+	require.Equal(t, 1, root.DestUids)
+	require.NotEmpty(t, root.Tasks)
+	// The access path is the one reported by the worker that processed the task.
+	require.Equal(t, worker.AccessIndex, root.Tasks[0].Access)
+
+	require.Len(t, root.Filters, 1)
+	require.Equal(t, worker.AccessUidList, root.Filters[0].Access)
+	require.Equal(t, 1, root.Filters[0].SrcUids)
+
+	var friend *query.ExplainNode
+	for _, c := range root.Children {
+		if c.Attr == "friend" {
+			friend = c
+		}
+	}
+	require.NotNil(t, friend)
+	require.Equal(t, 1, friend.DestUids)
+
+	// Without the explain parameter, nothing extra is returned.
+	_, body, err = runWithRetries("POST", "application/dql", addr+"/query", q1)
+	require.NoError(t, err)
+	require.NotContains(t, string(body), `"explain"`)
+}
+
 func TestHealth(t *testing.T) {
 	url := fmt.Sprintf("%s/health", addr)
 	resp, err := http.Get(url)
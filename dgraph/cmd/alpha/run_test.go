@@ -32,6 +32,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/dgraph-io/dgo/v250"
 	"github.com/dgraph-io/dgo/v250/protos/api"
@@ -41,6 +42,7 @@ import (
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/query"
 	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/worker"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
 
@@ -1328,6 +1330,63 @@ func TestGrpcCompressionSupport(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestExplainGrpc(t *testing.T) {
+	require.NoError(t, dropAll())
+	require.NoError(t, alterSchema(`name: string @index(exact) .`))
+	require.NoError(t, runMutation(`{ set { _:a <name> "Alice" . _:b <name> "Bob" . } }`))
+
+	conn, err := grpc.NewClient(alphaSockAdd,
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, conn.Close()) }()
+	dc := dgo.NewDgraphClient(api.NewDgraphClient(conn))
+	require.NoError(t, dc.LoginIntoNamespace(context.Background(), x.GrootId, "password",
+		x.RootNamespace))
+
+	req := &api.Request{Query: `{ users(func: eq(name, "Alice")) { name } }`, ReadOnly: true}
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		"accessJwt", dc.GetJwt().AccessJwt, "explain", "true")
+	var header metadata.MD
+	resp, err := api.NewDgraphClient(conn).Query(ctx, req, grpc.Header(&header))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"users":[{"name":"Alice"}]}`, string(resp.Json))
+
+	// The explain output is sent in a header, as the response has no room for it.
+	values := header.Get(x.DgraphExplainHeader)
+	require.Len(t, values, 1)
+	var explain query.Explain
+	require.NoError(t, json.Unmarshal([]byte(values[0]), &explain))
+	require.Len(t, explain.Blocks, 1)
+	require.Equal(t, "users", explain.Blocks[0].Alias)
+	require.Equal(t, `eq(name, Alice)`, explain.Blocks[0].Func)
+	require.Equal(t, worker.AccessIndex, explain.Blocks[0].Access)
+	require.Equal(t, 1, explain.Blocks[0].DestUids)
+
+	ctx = metadata.AppendToOutgoingContext(context.Background(),
+		"accessJwt", dc.GetJwt().AccessJwt)
+	header = nil
+	_, err = api.NewDgraphClient(conn).Query(ctx, req, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Empty(t, header.Get(x.DgraphExplainHeader))
+
+	// Arguments that aren't ASCII must make it through the header as well.
+	require.NoError(t, runMutation(`{ set { _:z <name> "Zoë" . } }`))
+	req = &api.Request{Query: `{ users(func: eq(name, "Zoë")) { name } }`, ReadOnly: true}
+	ctx = metadata.AppendToOutgoingContext(context.Background(),
+		"accessJwt", dc.GetJwt().AccessJwt, "explain", "true")
+	header = nil
+	resp, err = api.NewDgraphClient(conn).Query(ctx, req, grpc.Header(&header))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"users":[{"name":"Zoë"}]}`, string(resp.Json))
+	values = header.Get(x.DgraphExplainHeader)
+	require.Len(t, values, 1)
+	explain = query.Explain{}
+	require.NoError(t, json.Unmarshal([]byte(values[0]), &explain))
+	require.Len(t, explain.Blocks, 1)
+	require.Equal(t, `eq(name, Zoë)`, explain.Blocks[0].Func)
+	require.Equal(t, 1, explain.Blocks[0].DestUids)
+}
+
 func TestTypeMutationAndQuery(t *testing.T) {
 	var m = `
 	{
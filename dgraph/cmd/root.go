@@ -37,6 +37,7 @@ import (
 	"github.com/hypermodeinc/dgraph/v25/dgraph/cmd/live"
 	"github.com/hypermodeinc/dgraph/v25/dgraph/cmd/mcp"
 	"github.com/hypermodeinc/dgraph/v25/dgraph/cmd/migrate"
+	"github.com/hypermodeinc/dgraph/v25/dgraph/cmd/vectorbench"
 	"github.com/hypermodeinc/dgraph/v25/dgraph/cmd/version"
 	"github.com/hypermodeinc/dgraph/v25/dgraph/cmd/zero"
 	"github.com/hypermodeinc/dgraph/v25/upgrade"
@@ -80,7 +81,7 @@ var subcommands = []*x.SubCommand{
 	&bulk.Bulk, &cert.Cert, &conv.Conv, &live.Live, &alpha.Alpha, &zero.Zero, &version.Version,
 	&debug.Debug, &migrate.Migrate, &debuginfo.DebugInfo, &upgrade.Upgrade, &decrypt.Decrypt, &increment.Increment,
 	&checkupgrade.CheckUpgrade, &backup.Restore, &backup.LsBackup, &backup.ExportBackup, &acl.CmdAcl,
-	&audit.CmdAudit, &mcp.Mcp, &dgraphimport.ImportCmd,
+	&audit.CmdAudit, &mcp.Mcp, &dgraphimport.ImportCmd, &vectorbench.VectorBench,
 }
 
 func initCmds() {
@@ -0,0 +1,143 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package vectorbench
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// readVectors reads at most limit vectors from the file at path, all of them if limit is 0. The
+// format is picked from the extension: .fvecs or .npy.
+func readVectors(path string, limit int) ([][]float32, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".fvecs":
+		return readFvecs(r, limit)
+	case ".npy":
+		return readNpy(r, limit)
+	default:
+		return nil, errors.Errorf("unsupported vector file %s, expected .fvecs or .npy", path)
+	}
+}
+
+// readFvecs reads vectors in the fvecs format of the ANN benchmark datasets, where each vector is
+// stored as its dimension as a little endian int32 followed by its float32 values.
+func readFvecs(r io.Reader, limit int) ([][]float32, error) {
+	var vecs [][]float32
+	for limit == 0 || len(vecs) < limit {
+		var dim int32
+		if err := binary.Read(r, binary.LittleEndian, &dim); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, errors.Wrapf(err, "while reading vector %d", len(vecs))
+		}
+		if dim <= 0 {
+			return nil, errors.Errorf("invalid dimension %d of vector %d", dim, len(vecs))
+		}
+		vec := make([]float32, dim)
+		if err := binary.Read(r, binary.LittleEndian, vec); err != nil {
+			return nil, errors.Wrapf(err, "while reading vector %d", len(vecs))
+		}
+		vecs = append(vecs, vec)
+	}
+	return vecs, nil
+}
+
+var (
+	npyMagic = []byte("\x93NUMPY")
+	npyDescr = regexp.MustCompile(`'descr':\s*'([^']*)'`)
+	npyOrder = regexp.MustCompile(`'fortran_order':\s*(True|False)`)
+	npyShape = regexp.MustCompile(`'shape':\s*\((\d+),\s*(\d+),?\s*\)`)
+)
+
+// readNpy reads vectors from a two dimensional numpy array of float32 or float64 values, one
+// vector per row, as saved by numpy.save.
+func readNpy(r io.Reader, limit int) ([][]float32, error) {
+	magic := make([]byte, len(npyMagic)+2)
+	if _, err := io.ReadFull(r, magic); err != nil || !bytes.HasPrefix(magic, npyMagic) {
+		return nil, errors.New("not a numpy file")
+	}
+	var headerLen uint32
+	switch major := magic[len(npyMagic)]; major {
+	case 1:
+		var n uint16
+		if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+			return nil, err
+		}
+		headerLen = uint32(n)
+	case 2, 3:
+		if err := binary.Read(r, binary.LittleEndian, &headerLen); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Errorf("unsupported numpy format version %d", major)
+	}
+	header := make([]byte, headerLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, errors.Wrap(err, "while reading numpy header")
+	}
+
+	descr := npyDescr.FindSubmatch(header)
+	order := npyOrder.FindSubmatch(header)
+	shape := npyShape.FindSubmatch(header)
+	if descr == nil || order == nil || shape == nil {
+		return nil, errors.Errorf("numpy header %q does not describe a two dimensional array",
+			header)
+	}
+	if string(order[1]) == "True" {
+		return nil, errors.New("numpy arrays in fortran order are not supported")
+	}
+	var size int
+	switch string(descr[1]) {
+	case "<f4":
+		size = 4
+	case "<f8":
+		size = 8
+	default:
+		return nil, errors.Errorf("unsupported numpy type %s, expected <f4 or <f8", descr[1])
+	}
+	rows, _ := strconv.Atoi(string(shape[1]))
+	dim, _ := strconv.Atoi(string(shape[2]))
+	if limit > 0 && limit < rows {
+		rows = limit
+	}
+
+	vecs := make([][]float32, rows)
+	buf := make([]byte, dim*size)
+	for i := range vecs {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, errors.Wrapf(err, "while reading vector %d", i)
+		}
+		vec := make([]float32, dim)
+		for j := range vec {
+			if size == 4 {
+				vec[j] = math.Float32frombits(binary.LittleEndian.Uint32(buf[4*j:]))
+			} else {
+				vec[j] = float32(math.Float64frombits(binary.LittleEndian.Uint64(buf[8*j:])))
+			}
+		}
+		vecs[i] = vec
+	}
+	return vecs, nil
+}
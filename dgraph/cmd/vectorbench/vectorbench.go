@@ -0,0 +1,441 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package vectorbench
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/viterin/vek/vek32"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/tok/index"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// VectorBench is the sub-command invoked when calling "dgraph vector-bench".
+var VectorBench x.SubCommand
+
+func init() {
+	VectorBench.Cmd = &cobra.Command{
+		Use:   "vector-bench",
+		Short: "Benchmark the recall and the speed of the vector index",
+		Long: `Builds the HNSW vector index of a dataset in a local posting store, the same
+way Alpha does, for every combination of the given index options. For each of
+them, it reports the build time, the memory and the disk space used by the
+index, and the recall@k, the throughput and the latency of the queries, the
+recall being measured against an exact brute force search.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := run(VectorBench.Conf); err != nil {
+				glog.Fatalf("%v", err)
+			}
+		},
+		Annotations: map[string]string{"group": "tool"},
+	}
+	VectorBench.EnvPrefix = "DGRAPH_VECTOR_BENCH"
+	VectorBench.Cmd.SetHelpTemplate(x.NonRootTemplate)
+
+	flag := VectorBench.Cmd.Flags()
+	flag.StringP("data", "d", "", "Path to the vectors to index, in the .fvecs or .npy format.")
+	flag.StringP("queries", "q", "",
+		"Path to the query vectors, in the .fvecs or .npy format. By default, the queries are "+
+			"picked among the indexed vectors.")
+	flag.Int("num_vectors", 0, "Number of vectors of the data to index, all of them if 0.")
+	flag.Int("num_queries", 100, "Number of queries to run.")
+	flag.IntP("k", "k", 10, "Number of neighbors looked for by each query.")
+	flag.String("metric", hnsw.Euclidean,
+		"Metric of the index, one of euclidean, cosine, dotproduct or hamming.")
+	flag.String("max_levels", "3", "Comma separated values of the maxLevels option to try.")
+	flag.String("ef_construction", "150",
+		"Comma separated values of the efConstruction option to try.")
+	flag.String("ef_search", "40,90,200", "Comma separated values of the efSearch option to try.")
+	flag.Int("batch", 1000, "Number of vectors inserted per transaction.")
+	flag.String("tmp", os.TempDir(), "Directory in which the posting store is created.")
+}
+
+type options struct {
+	data           string
+	queries        string
+	numVectors     int
+	numQueries     int
+	k              int
+	metric         string
+	maxLevels      []int
+	efConstruction []int
+	efSearch       []int
+	batch          int
+	tmp            string
+}
+
+func parseInts(conf *viper.Viper, name string) ([]int, error) {
+	var vals []int
+	for _, s := range strings.Split(conf.GetString(name), ",") {
+		v, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || v <= 0 {
+			return nil, errors.Errorf("invalid value %q in --%s", s, name)
+		}
+		vals = append(vals, v)
+	}
+	return vals, nil
+}
+
+func parseOptions(conf *viper.Viper) (*options, error) {
+	opts := &options{
+		data:       conf.GetString("data"),
+		queries:    conf.GetString("queries"),
+		numVectors: conf.GetInt("num_vectors"),
+		numQueries: conf.GetInt("num_queries"),
+		k:          conf.GetInt("k"),
+		metric:     conf.GetString("metric"),
+		batch:      conf.GetInt("batch"),
+		tmp:        conf.GetString("tmp"),
+	}
+	if opts.data == "" {
+		return nil, errors.New("the vectors to index must be given with --data")
+	}
+	if opts.numQueries <= 0 || opts.k <= 0 || opts.batch <= 0 {
+		return nil, errors.New("--num_queries, --k and --batch must be positive")
+	}
+	if _, ok := distanceFuncs[opts.metric]; !ok {
+		return nil, errors.Errorf("unknown metric %q", opts.metric)
+	}
+	var err error
+	if opts.maxLevels, err = parseInts(conf, "max_levels"); err != nil {
+		return nil, err
+	}
+	if opts.efConstruction, err = parseInts(conf, "ef_construction"); err != nil {
+		return nil, err
+	}
+	if opts.efSearch, err = parseInts(conf, "ef_search"); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// distanceFuncs compute the distance between two vectors for each metric, a smaller distance
+// meaning closer vectors.
+var distanceFuncs = map[string]func(a, b []float32) float32{
+	hnsw.Euclidean: vek32.Distance,
+	hnsw.Cosine:    func(a, b []float32) float32 { return -vek32.CosineSimilarity(a, b) },
+	hnsw.DotProd:   func(a, b []float32) float32 { return -vek32.Dot(a, b) },
+	hnsw.Hamming: func(a, b []float32) float32 {
+		var n float32
+		for i := range a {
+			if a[i] != b[i] {
+				n++
+			}
+		}
+		return n
+	},
+}
+
+// exactNeighbors returns the uids of the k vectors of data closest to each query, the uid of a
+// vector being its position in data plus one.
+func exactNeighbors(data, queries [][]float32, k int, metric string) [][]uint64 {
+	distance := distanceFuncs[metric]
+	res := make([][]uint64, len(queries))
+	var wg sync.WaitGroup
+	next := make(chan int)
+	for range runtime.NumCPU() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dists := make([]float32, len(data))
+			order := make([]int, len(data))
+			for q := range next {
+				for i, vec := range data {
+					dists[i] = distance(queries[q], vec)
+					order[i] = i
+				}
+				sort.Slice(order, func(i, j int) bool { return dists[order[i]] < dists[order[j]] })
+				n := min(k, len(order))
+				res[q] = make([]uint64, n)
+				for i := range n {
+					res[q][i] = uint64(order[i] + 1)
+				}
+			}
+		}()
+	}
+	for q := range queries {
+		next <- q
+	}
+	close(next)
+	wg.Wait()
+	return res
+}
+
+// recall returns the fraction of the exact neighbors found.
+func recall(exact, found []uint64) float64 {
+	if len(exact) == 0 {
+		return 1
+	}
+	inExact := make(map[uint64]struct{}, len(exact))
+	for _, uid := range exact {
+		inExact[uid] = struct{}{}
+	}
+	var n int
+	for _, uid := range found {
+		if _, ok := inExact[uid]; ok {
+			n++
+		}
+	}
+	return float64(n) / float64(len(exact))
+}
+
+// sampleQueries picks n vectors spread evenly over data.
+func sampleQueries(data [][]float32, n int) [][]float32 {
+	n = min(n, len(data))
+	queries := make([][]float32, n)
+	for i := range queries {
+		queries[i] = data[i*len(data)/n]
+	}
+	return queries
+}
+
+func hnswSpec(metric string, maxLevels, efConstruction, efSearch int) *pb.VectorIndexSpec {
+	spec := &pb.VectorIndexSpec{
+		Name: hnsw.Hnsw,
+		Options: []*pb.OptionPair{
+			{Key: hnsw.MetricOpt, Value: metric},
+			{Key: hnsw.MaxLevelsOpt, Value: strconv.Itoa(maxLevels)},
+			{Key: hnsw.EfConstructionOpt, Value: strconv.Itoa(efConstruction)},
+		},
+	}
+	if efSearch > 0 {
+		spec.Options = append(spec.Options,
+			&pb.OptionPair{Key: hnsw.EfSearchOpt, Value: strconv.Itoa(efSearch)})
+	}
+	return spec
+}
+
+// bench holds the posting store the indexes are built in, and the timestamps of the
+// transactions writing to it.
+type bench struct {
+	db      *badger.DB
+	ts      uint64
+	opts    *options
+	data    [][]float32
+	queries [][]float32
+	exact   [][]uint64
+}
+
+func (b *bench) nextTs() uint64 {
+	b.ts++
+	return b.ts
+}
+
+// build indexes the data in the predicate attr, inserting the vectors with the mutations Alpha
+// applies, and returns how long it took.
+func (b *bench) build(ctx context.Context, attr string, spec *pb.VectorIndexSpec) (
+	time.Duration, error) {
+	schema.State().Set(attr, &pb.SchemaUpdate{
+		Predicate:  attr,
+		ValueType:  pb.Posting_VFLOAT,
+		Directive:  pb.SchemaUpdate_INDEX,
+		IndexSpecs: []*pb.VectorIndexSpec{spec},
+	})
+
+	start := time.Now()
+	for i := 0; i < len(b.data); i += b.opts.batch {
+		txn := posting.NewTxn(b.nextTs())
+		for j := i; j < min(i+b.opts.batch, len(b.data)); j++ {
+			edge := &pb.DirectedEdge{
+				Entity:    uint64(j + 1),
+				Attr:      attr,
+				Value:     types.FloatArrayAsBytes(b.data[j]),
+				ValueType: pb.Posting_VFLOAT,
+				Op:        pb.DirectedEdge_SET,
+			}
+			l, err := txn.Get(x.DataKey(attr, edge.Entity))
+			if err != nil {
+				return 0, err
+			}
+			if err := l.AddMutationWithIndex(ctx, edge, txn); err != nil {
+				return 0, errors.Wrapf(err, "while inserting vector %d", j)
+			}
+		}
+
+		commitTs := b.nextTs()
+		txn.Update()
+		txn.UpdateCachedKeys(commitTs)
+		writer := posting.NewTxnWriter(b.db)
+		if err := txn.CommitToDisk(writer, commitTs); err != nil {
+			return 0, err
+		}
+		if err := writer.Flush(); err != nil {
+			return 0, err
+		}
+	}
+	return time.Since(start), nil
+}
+
+// indexSize returns the size of the keys and values of the index of attr.
+func (b *bench) indexSize(attr string) int64 {
+	txn := b.db.NewTransactionAt(b.ts, false)
+	defer txn.Discard()
+	var size int64
+	for _, pred := range hnsw.SupportingPredicates(attr, false) {
+		itOpts := badger.DefaultIteratorOptions
+		itOpts.PrefetchValues = false
+		itOpts.Prefix = x.PredicatePrefix(pred)
+		it := txn.NewIterator(itOpts)
+		for it.Rewind(); it.Valid(); it.Next() {
+			size += it.Item().EstimatedSize()
+		}
+		it.Close()
+	}
+	return size
+}
+
+type searchResult struct {
+	recall float64
+	qps    float64
+	p50    time.Duration
+	p99    time.Duration
+}
+
+// search runs the queries against the index of attr with the options of spec.
+func (b *bench) search(ctx context.Context, attr string, spec *pb.VectorIndexSpec) (
+	*searchResult, error) {
+	cspec, err := tok.GetFactoryCreateSpecFromSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	indexer, err := cspec.CreateIndex(attr)
+	if err != nil {
+		return nil, err
+	}
+	qc := hnsw.NewQueryCache(posting.NewViLocalCache(posting.NewLocalCache(b.ts)), b.ts)
+
+	var res searchResult
+	latencies := make([]time.Duration, len(b.queries))
+	start := time.Now()
+	for i, query := range b.queries {
+		qstart := time.Now()
+		uids, err := indexer.Search(ctx, qc, query, b.opts.k, index.AcceptAll[float32])
+		if err != nil {
+			return nil, errors.Wrapf(err, "while running query %d", i)
+		}
+		latencies[i] = time.Since(qstart)
+		res.recall += recall(b.exact[i], uids)
+	}
+	res.qps = float64(len(b.queries)) / time.Since(start).Seconds()
+	res.recall /= float64(len(b.queries))
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	res.p50 = latencies[len(latencies)/2]
+	res.p99 = latencies[len(latencies)*99/100]
+	return &res, nil
+}
+
+func heapInUse() uint64 {
+	runtime.GC()
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	return ms.HeapInuse
+}
+
+func run(conf *viper.Viper) error {
+	opts, err := parseOptions(conf)
+	if err != nil {
+		return err
+	}
+	b := &bench{opts: opts}
+	if b.data, err = readVectors(opts.data, opts.numVectors); err != nil {
+		return err
+	}
+	if len(b.data) == 0 {
+		return errors.Errorf("no vectors found in %s", opts.data)
+	}
+	if opts.queries != "" {
+		if b.queries, err = readVectors(opts.queries, opts.numQueries); err != nil {
+			return err
+		}
+	} else {
+		b.queries = sampleQueries(b.data, opts.numQueries)
+	}
+	if len(b.queries) == 0 {
+		return errors.Errorf("no query vectors found in %s", opts.queries)
+	}
+	dim := len(b.data[0])
+	for _, vecs := range [][][]float32{b.data, b.queries} {
+		for i, vec := range vecs {
+			if len(vec) != dim {
+				return errors.Errorf("vector %d has %d dimensions instead of %d", i, len(vec),
+					dim)
+			}
+		}
+	}
+
+	fmt.Printf("Computing the %d exact neighbors of %d queries among %d vectors of %d dimensions\n",
+		opts.k, len(b.queries), len(b.data), dim)
+	b.exact = exactNeighbors(b.data, b.queries, opts.k, opts.metric)
+
+	dir, err := os.MkdirTemp(opts.tmp, "dgraph_vector_bench_")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	b.db, err = badger.OpenManaged(badger.DefaultOptions(dir).WithLogger(nil))
+	if err != nil {
+		return errors.Wrap(err, "while opening the posting store")
+	}
+	defer b.db.Close()
+	posting.Init(b.db, 0, false)
+	schema.Init(b.db)
+
+	ctx := context.Background()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(w, "maxLevels\tefConstruction\tefSearch\tbuild\tinserts/s\theap MiB\t"+
+		"index MiB\trecall@%d\tQPS\tp50\tp99\t\n", opts.k)
+	n := 0
+	for _, maxLevels := range opts.maxLevels {
+		for _, efConstruction := range opts.efConstruction {
+			n++
+			attr := x.AttrInRootNamespace(fmt.Sprintf("vector_bench_%d", n))
+			fmt.Printf("Building the index with maxLevels=%d efConstruction=%d\n",
+				maxLevels, efConstruction)
+			heapBefore := heapInUse()
+			took, err := b.build(ctx, attr, hnswSpec(opts.metric, maxLevels, efConstruction, 0))
+			if err != nil {
+				return err
+			}
+			heapAfter := heapInUse()
+			heap := float64(heapAfter-min(heapBefore, heapAfter)) / (1 << 20)
+			size := float64(b.indexSize(attr)) / (1 << 20)
+
+			for _, efSearch := range opts.efSearch {
+				spec := hnswSpec(opts.metric, maxLevels, efConstruction, efSearch)
+				res, err := b.search(ctx, attr, spec)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(w, "%d\t%d\t%d\t%s\t%.0f\t%.1f\t%.1f\t%.4f\t%.0f\t%s\t%s\t\n",
+					maxLevels, efConstruction, efSearch, took.Round(time.Millisecond),
+					float64(len(b.data))/took.Seconds(), heap, size, res.recall, res.qps,
+					res.p50.Round(time.Microsecond), res.p99.Round(time.Microsecond))
+			}
+		}
+	}
+	return w.Flush()
+}
@@ -0,0 +1,116 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package vectorbench
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestReadFvecs(t *testing.T) {
+	var buf bytes.Buffer
+	for _, vec := range [][]float32{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}} {
+		require.NoError(t, binary.Write(&buf, binary.LittleEndian, int32(len(vec))))
+		require.NoError(t, binary.Write(&buf, binary.LittleEndian, vec))
+	}
+	data := buf.Bytes()
+
+	vecs, err := readFvecs(bytes.NewReader(data), 0)
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, vecs)
+
+	vecs, err = readFvecs(bytes.NewReader(data), 2)
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{1, 2, 3}, {4, 5, 6}}, vecs)
+
+	_, err = readFvecs(bytes.NewReader(data[:len(data)-2]), 0)
+	require.Error(t, err)
+}
+
+func npyFile(t *testing.T, descr string, values any) []byte {
+	header := "{'descr': '" + descr + "', 'fortran_order': False, 'shape': (2, 2), }"
+	var buf bytes.Buffer
+	buf.WriteString("\x93NUMPY\x01\x00")
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, uint16(len(header))))
+	buf.WriteString(header)
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, values))
+	return buf.Bytes()
+}
+
+func TestReadNpy(t *testing.T) {
+	vecs, err := readNpy(bytes.NewReader(npyFile(t, "<f4", []float32{1, 2, 3, 4})), 0)
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{1, 2}, {3, 4}}, vecs)
+
+	vecs, err = readNpy(bytes.NewReader(npyFile(t, "<f8", []float64{1, 2, 3, 4})), 1)
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{1, 2}}, vecs)
+
+	_, err = readNpy(bytes.NewReader(npyFile(t, "<i4", []int32{1, 2, 3, 4})), 0)
+	require.ErrorContains(t, err, "unsupported numpy type")
+}
+
+func TestExactNeighbors(t *testing.T) {
+	data := [][]float32{{0, 0}, {1, 0}, {5, 0}, {10, 0}}
+	exact := exactNeighbors(data, [][]float32{{4, 0}, {10, 0}}, 2, hnsw.Euclidean)
+	require.Equal(t, [][]uint64{{3, 2}, {4, 3}}, exact)
+	require.Equal(t, 0.5, recall(exact[0], []uint64{3, 4}))
+
+	exact = exactNeighbors(data, [][]float32{{1, 0}}, 1, hnsw.DotProd)
+	require.Equal(t, [][]uint64{{4}}, exact)
+}
+
+func TestBench(t *testing.T) {
+	dir := t.TempDir()
+	db, err := badger.OpenManaged(badger.DefaultOptions(dir).WithLogger(nil))
+	require.NoError(t, err)
+	defer db.Close()
+	posting.Init(db, 0, false)
+	schema.Init(db)
+
+	rng := rand.New(rand.NewSource(1))
+	data := make([][]float32, 300)
+	for i := range data {
+		data[i] = []float32{rng.Float32(), rng.Float32(), rng.Float32(), rng.Float32()}
+	}
+	b := &bench{
+		db:      db,
+		opts:    &options{k: 5, batch: 100},
+		data:    data,
+		queries: sampleQueries(data, 20),
+	}
+	b.exact = exactNeighbors(b.data, b.queries, b.opts.k, hnsw.Euclidean)
+
+	ctx := context.Background()
+	attr := x.AttrInRootNamespace("vector_bench")
+	_, err = b.build(ctx, attr, hnswSpec(hnsw.Euclidean, 3, 100, 0))
+	require.NoError(t, err)
+	require.Positive(t, b.indexSize(attr))
+
+	res, err := b.search(ctx, attr, hnswSpec(hnsw.Euclidean, 3, 100, 50))
+	require.NoError(t, err)
+	require.Greater(t, res.recall, 0.8)
+	require.Positive(t, res.qps)
+}
+
+func TestReadVectorsExtension(t *testing.T) {
+	path := t.TempDir() + "/vectors.bin"
+	require.NoError(t, os.WriteFile(path, nil, 0644))
+	_, err := readVectors(path, 0)
+	require.ErrorContains(t, err, "expected .fvecs or .npy")
+}
@@ -205,6 +205,122 @@ func (st *state) moveTablet(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// tabletRequest checks a request to change a tablet and returns its namespace and tablet. It
+// writes the error status if the request is invalid.
+func (st *state) tabletRequest(w http.ResponseWriter, r *http.Request) (uint64, string, bool) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return 0, "", false
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return 0, "", false
+	}
+	if !st.node.AmLeader() {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			"This Zero server is not the leader. Re-run command on leader.")
+		return 0, "", false
+	}
+
+	ns := x.RootNamespace
+	if namespace := strings.TrimSpace(r.URL.Query().Get("namespace")); namespace != "" {
+		var err error
+		if ns, err = strconv.ParseUint(namespace, 0, 64); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			x.SetStatus(w, x.ErrorInvalidRequest, "Invalid namespace in query parameter.")
+			return 0, "", false
+		}
+	}
+	tablet := r.URL.Query().Get("tablet")
+	if len(tablet) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, "tablet is a mandatory query parameter")
+		return 0, "", false
+	}
+	return ns, tablet, true
+}
+
+func writeTabletStatus(w http.ResponseWriter, resp *pb.Status, err error) {
+	if err != nil {
+		if resp.GetMsg() == x.ErrorInvalidRequest {
+			w.WriteHeader(http.StatusBadRequest)
+			x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			x.SetStatus(w, x.Error, err.Error())
+		}
+		return
+	}
+	if _, err := fmt.Fprint(w, resp.GetMsg()); err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
+// splitTablet moves the uids of a tablet from the query parameter at up to the end of their range
+// to another group.
+func (st *state) splitTablet(w http.ResponseWriter, r *http.Request) {
+	ns, tablet, ok := st.tabletRequest(w, r)
+	if !ok {
+		return
+	}
+	at, ok := intFromQueryParam(w, r, "at")
+	if !ok {
+		return
+	}
+	groupId, ok := intFromQueryParam(w, r, "group")
+	if !ok {
+		return
+	}
+
+	resp, err := st.zero.SplitTablet(context.Background(), &pb.SplitTabletRequest{
+		Namespace: ns, Tablet: tablet, At: at, DstGroup: uint32(groupId)})
+	writeTabletStatus(w, resp, err)
+}
+
+// mergeTablet moves the range of a split tablet starting at the query parameter at to the group
+// serving the range before it.
+func (st *state) mergeTablet(w http.ResponseWriter, r *http.Request) {
+	ns, tablet, ok := st.tabletRequest(w, r)
+	if !ok {
+		return
+	}
+	at, ok := intFromQueryParam(w, r, "at")
+	if !ok {
+		return
+	}
+
+	resp, err := st.zero.MergeTablet(context.Background(), &pb.MergeTabletRequest{
+		Namespace: ns, Tablet: tablet, At: at})
+	writeTabletStatus(w, resp, err)
+}
+
+// rebalancePlan returns the cost of the groups and the moves of tablets that the next rebalance
+// would make, without making them.
+func (st *state) rebalancePlan(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+	if !st.node.AmLeader() {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			"This Zero server is not the leader. Re-run command on leader.")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(st.zero.planRebalance()); err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
 func (st *state) getState(w http.ResponseWriter, r *http.Request) {
 	x.AddCorsHeaders(w)
 	w.Header().Set("Content-Type", "application/json")
@@ -68,6 +68,24 @@ func (o *Oracle) updateStartTxnTs(ts uint64) {
 	o.keyCommit.Reset()
 }
 
+// parseKey parses a key sent by a transaction, returning its prefix if it is a read or write key.
+// We store src.Keys as string to ensure compatibility with all the various language clients we
+// have. But, really they are just uint64s encoded as strings. We use base 36 during creation of
+// these keys in FillContext in posting/mvcc.go.
+func parseKey(k string) (uint64, string, error) {
+	var prefix string
+	for _, p := range []string{x.ReadKeyPrefix, x.WriteKeyPrefix} {
+		if strings.HasPrefix(k, p) {
+			prefix, k = p, k[len(p):]
+			break
+		}
+	}
+	ki, err := strconv.ParseUint(k, 36, 64)
+	return ki, prefix, err
+}
+
+// hasConflict returns whether a key the transaction wrote to, or read if it is serializable, was
+// committed to since the transaction started.
 // TODO: This should be done during proposal application for Txn status.
 func (o *Oracle) hasConflict(src *api.TxnContext) bool {
 	// This transaction was started before I became leader.
@@ -75,11 +93,14 @@ func (o *Oracle) hasConflict(src *api.TxnContext) bool {
 		return true
 	}
 	for _, k := range src.Keys {
-		ki, err := strconv.ParseUint(k, 36, 64)
+		ki, prefix, err := parseKey(k)
 		if err != nil {
 			glog.Errorf("Got error while parsing conflict key %q: %v\n", k, err)
 			continue
 		}
+		if prefix == x.WriteKeyPrefix {
+			continue
+		}
 		if last := o.keyCommit.Get(ki); last > src.StartTs {
 			return true
 		}
@@ -127,15 +148,15 @@ func (o *Oracle) commit(src *api.TxnContext) error {
 	if o.hasConflict(src) {
 		return x.ErrConflict
 	}
-	// We store src.Keys as string to ensure compatibility with all the various language clients we
-	// have. But, really they are just uint64s encoded as strings. We use base 36 during creation of
-	// these keys in FillContext in posting/mvcc.go.
 	for _, k := range src.Keys {
-		ki, err := strconv.ParseUint(k, 36, 64)
+		ki, prefix, err := parseKey(k)
 		if err != nil {
 			glog.Errorf("Got error while parsing conflict key %q: %v\n", k, err)
 			continue
 		}
+		if prefix == x.ReadKeyPrefix {
+			continue
+		}
 		o.keyCommit.Set(ki, src.CommitTs) // CommitTs is handed out before calling this func.
 	}
 	return nil
@@ -375,10 +396,15 @@ func (s *Server) commit(ctx context.Context, src *api.TxnContext) error {
 			if tablet == nil {
 				return errors.Errorf("Tablet for %s is nil", pred)
 			}
-			if tablet.GroupId != uint32(gid) {
+			split := s.servesRange(pred, uint32(gid))
+			if tablet.GroupId != uint32(gid) && !split {
 				return errors.Errorf("Mutation done in group: %d. Predicate %s assigned to %d",
 					gid, pred, tablet.GroupId)
 			}
+			// The data of a uid might have moved to another group since the mutation was done.
+			if split && tablet.MoveTs > src.StartTs {
+				return errors.Errorf("Ranges of predicate %s moved after the txn started", pred)
+			}
 			if s.isBlocked(pred) {
 				return errors.Errorf("Commits on predicate %s are blocked due to predicate move", pred)
 			}
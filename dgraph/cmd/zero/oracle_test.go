@@ -0,0 +1,43 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package zero
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestSerializableConflicts(t *testing.T) {
+	var o Oracle
+	o.Init()
+	defer o.close()
+
+	// Transaction 1 adds to a list, which only conflicts on the value added, and sets a value.
+	require.NoError(t, o.commit(&api.TxnContext{StartTs: 10, CommitTs: 12,
+		Keys: []string{"a1", x.WriteKeyPrefix + "a", "b"}}))
+
+	// Snapshot isolation only checks the keys written to.
+	require.False(t, o.hasConflict(&api.TxnContext{StartTs: 11, Keys: []string{"a2"}}))
+	require.True(t, o.hasConflict(&api.TxnContext{StartTs: 11, Keys: []string{"b"}}))
+	// Writing to a key written to by another transaction doesn't conflict.
+	require.False(t, o.hasConflict(&api.TxnContext{StartTs: 11,
+		Keys: []string{x.WriteKeyPrefix + "b"}}))
+
+	// A serializable transaction also conflicts on the keys it read.
+	require.True(t, o.hasConflict(&api.TxnContext{StartTs: 11,
+		Keys: []string{x.ReadKeyPrefix + "a"}}))
+	require.False(t, o.hasConflict(&api.TxnContext{StartTs: 12,
+		Keys: []string{x.ReadKeyPrefix + "a"}}))
+
+	// The keys read aren't recorded as written to.
+	require.NoError(t, o.commit(&api.TxnContext{StartTs: 13, CommitTs: 14,
+		Keys: []string{x.ReadKeyPrefix + "c"}}))
+	require.False(t, o.hasConflict(&api.TxnContext{StartTs: 13, Keys: []string{"c"}}))
+}
@@ -231,12 +231,7 @@ func (n *node) handleMemberProposal(member *pb.Member) error {
 		}
 		return nil
 	}
-	var numReplicas int
-	for _, gm := range group.Members {
-		if !gm.Learner {
-			numReplicas++
-		}
-	}
+	numReplicas := numVoters(group)
 	switch {
 	case has || member.GetLearner():
 		// pass
@@ -275,11 +270,20 @@ func (n *node) regenerateChecksum() {
 	// Regenerate group checksums. These checksums are solely based on which tablets are being
 	// served by the group. If the tablets that a group is serving changes, and the Alpha does
 	// not know about these changes, then the read request must fail.
-	for _, g := range state.GetGroups() {
+	// The ranges of the predicates split across groups are included, so that the groups know
+	// about a range moving to or from them before serving it.
+	ranges := make(map[uint32][]string)
+	for pred, tr := range state.GetTabletRanges() {
+		for _, r := range tr.GetRanges() {
+			ranges[r.GroupId] = append(ranges[r.GroupId], fmt.Sprintf("%s@%d", pred, r.StartUid))
+		}
+	}
+	for gid, g := range state.GetGroups() {
 		preds := make([]string, 0, len(g.GetTablets()))
 		for pred := range g.GetTablets() {
 			preds = append(preds, pred)
 		}
+		preds = append(preds, ranges[gid]...)
 		sort.Strings(preds)
 		g.Checksum = farm.Fingerprint64([]byte(strings.Join(preds, "")))
 	}
@@ -321,6 +325,7 @@ func (n *node) handleTablet(tablet *pb.Tablet) error {
 		if group != nil {
 			delete(group.Tablets, tablet.Predicate)
 		}
+		delete(state.TabletRanges, tablet.Predicate)
 		return nil
 	}
 	if group == nil {
@@ -353,6 +358,44 @@ func (n *node) handleTabletProposal(tablet *pb.Tablet) error {
 	return n.handleTablet(tablet)
 }
 
+// handleTabletRanges sets the uid ranges of a predicate split across groups. The tablet is moved to
+// the group serving the first range. If there is only one range left, the predicate is no longer
+// split.
+func (n *node) handleTabletRanges(tr *pb.TabletRanges) error {
+	n.server.AssertLock()
+	defer n.regenerateChecksum()
+
+	state := n.server.state
+	tablet := n.server.servingTablet(tr.Predicate)
+	if tablet == nil {
+		return errors.Errorf("Tablet for %s is not being served", tr.Predicate)
+	}
+	if err := checkRanges(tr.Ranges); err != nil {
+		return err
+	}
+	for _, r := range tr.Ranges {
+		if _, ok := state.Groups[r.GroupId]; !ok {
+			return errors.Errorf("Unknown group %d in the ranges of %s", r.GroupId, tr.Predicate)
+		}
+	}
+
+	if owner := tr.Ranges[0].GroupId; owner != tablet.GroupId {
+		delete(state.Groups[tablet.GroupId].Tablets, tr.Predicate)
+		tablet.GroupId = owner
+		state.Groups[owner].Tablets[tr.Predicate] = tablet
+	}
+	tablet.MoveTs = tr.MoveTs
+	if len(tr.Ranges) == 1 {
+		delete(state.TabletRanges, tr.Predicate)
+		return nil
+	}
+	if state.TabletRanges == nil {
+		state.TabletRanges = make(map[string]*pb.TabletRanges)
+	}
+	state.TabletRanges[tr.Predicate] = tr
+	return nil
+}
+
 func (n *node) deleteNamespace(delNs uint64) error {
 	n.server.AssertLock()
 	state := n.server.state
@@ -367,6 +410,11 @@ func (n *node) deleteNamespace(delNs uint64) error {
 			}
 		}
 	}
+	for pred := range state.TabletRanges {
+		if x.ParseNamespace(pred) == delNs {
+			delete(state.TabletRanges, pred)
+		}
+	}
 	return nil
 }
 
@@ -454,6 +502,14 @@ func (n *node) applyProposal(e raftpb.Entry) (uint64, error) {
 		}
 	}
 
+	if p.TabletRanges != nil {
+		if err := n.handleTabletRanges(p.TabletRanges); err != nil {
+			span.AddEvent(fmt.Sprintf("While applying tablet ranges proposal: %v", err))
+			glog.Errorf("While applying tablet ranges proposal: %v", err)
+			return key, err
+		}
+	}
+
 	if p.Snapshot != nil {
 		if err := n.applySnapshot(p.Snapshot); err != nil {
 			glog.Errorf("While applying snapshot: %v\n", err)
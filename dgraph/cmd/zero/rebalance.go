@@ -0,0 +1,405 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package zero
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+const (
+	rebalanceDefaults = `max-moves=1; blackout=; size-weight=1; query-weight=1; ` +
+		`mutation-weight=1; cpu-weight=1;`
+
+	// loadTTL is the time after which the load reported by an Alpha is ignored.
+	loadTTL = time.Minute
+)
+
+/*
+Tablets are balanced across the groups by their cost, which combines their share of the data on disk
+with their share of the load reported by the Alphas: the queries and the edges mutated per second,
+and the time spent processing the queries. Each share is weighted by the rebalance superflag, and
+the shares without any data or load are left out. With no load reported, the cost of a tablet is
+its share of the data on disk, so the tablets are balanced by their size alone.
+
+Every rebalance interval, Zero picks the group with the highest cost and the one with the lowest.
+If their costs differ by at least 10% of the lowest one, it moves the costliest tablet that keeps
+the source group at or above the destination one. It goes on with the remaining groups until it
+has planned as many moves as are allowed to run at the same time. A group only takes part in one
+move at a time, as an Alpha streams one predicate at a time.
+*/
+
+type rebalanceOptions struct {
+	maxMoves  int
+	blackouts []blackoutWindow
+	weights   loadWeights
+}
+
+type loadWeights struct {
+	size, queries, mutations, cpu float64
+}
+
+// blackoutWindow is a daily window of time in UTC during which no tablets are rebalanced. It ends
+// the next day if it ends before it starts.
+type blackoutWindow struct {
+	start, end time.Duration // Since midnight.
+}
+
+func parseRebalanceOptions(sf *z.SuperFlag) (rebalanceOptions, error) {
+	ro := rebalanceOptions{
+		maxMoves: int(sf.GetInt64("max-moves")),
+		weights: loadWeights{
+			size:      sf.GetFloat64("size-weight"),
+			queries:   sf.GetFloat64("query-weight"),
+			mutations: sf.GetFloat64("mutation-weight"),
+			cpu:       sf.GetFloat64("cpu-weight"),
+		},
+	}
+	if ro.maxMoves < 1 {
+		return ro, errors.Errorf("max-moves must be at least 1. Found: %d", ro.maxMoves)
+	}
+	w := ro.weights
+	if w.size < 0 || w.queries < 0 || w.mutations < 0 || w.cpu < 0 {
+		return ro, errors.Errorf("The rebalance weights can't be negative. Found: %+v", w)
+	}
+	var err error
+	ro.blackouts, err = parseBlackouts(sf.GetString("blackout"))
+	return ro, err
+}
+
+// parseBlackouts parses a comma separated list of blackout windows like "01:00-03:30".
+func parseBlackouts(s string) ([]blackoutWindow, error) {
+	var windows []blackoutWindow
+	for _, w := range strings.Split(s, ",") {
+		if w = strings.TrimSpace(w); w == "" {
+			continue
+		}
+		start, end, ok := strings.Cut(w, "-")
+		if !ok {
+			return nil, errors.Errorf("Invalid blackout window %q, expected HH:MM-HH:MM", w)
+		}
+		var bw blackoutWindow
+		for _, part := range []struct {
+			s string
+			d *time.Duration
+		}{{start, &bw.start}, {end, &bw.end}} {
+			t, err := time.Parse("15:04", strings.TrimSpace(part.s))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid blackout window %q", w)
+			}
+			*part.d = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+		}
+		windows = append(windows, bw)
+	}
+	return windows, nil
+}
+
+func inBlackout(windows []blackoutWindow, now time.Time) bool {
+	now = now.UTC()
+	day := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute +
+		time.Duration(now.Second())*time.Second
+	for _, w := range windows {
+		if w.start <= w.end && day >= w.start && day < w.end {
+			return true
+		}
+		if w.start > w.end && (day >= w.start || day < w.end) {
+			return true
+		}
+	}
+	return false
+}
+
+// memberLoad is the load last reported by an Alpha.
+type memberLoad struct {
+	gid   uint32
+	at    time.Time
+	loads map[string]*pb.TabletLoad
+}
+
+// recordLoad keeps the load on the tablets reported with a membership update.
+func (s *Server) recordLoad(group *pb.Group) {
+	s.Lock()
+	defer s.Unlock()
+	for id, ml := range s.memberLoads {
+		if time.Since(ml.at) > loadTTL {
+			delete(s.memberLoads, id)
+		}
+	}
+	for id, m := range group.GetMembers() {
+		s.memberLoads[id] = &memberLoad{gid: m.GroupId, at: time.Now(), loads: group.TabletLoad}
+	}
+}
+
+// groupLoads returns the load on the tablets of each group. The queries are spread across the
+// members of a group while every member applies all the mutations, so the queries add up and the
+// mutations don't.
+func (s *Server) groupLoads() map[uint32]map[string]*pb.TabletLoad {
+	s.AssertRLock()
+	out := make(map[uint32]map[string]*pb.TabletLoad)
+	for id, ml := range s.memberLoads {
+		if time.Since(ml.at) > loadTTL {
+			continue
+		}
+		group, ok := s.state.GetGroups()[ml.gid]
+		if !ok || group.Members[id] == nil {
+			continue
+		}
+		if out[ml.gid] == nil {
+			out[ml.gid] = make(map[string]*pb.TabletLoad)
+		}
+		for pred, l := range ml.loads {
+			sum, ok := out[ml.gid][pred]
+			if !ok {
+				sum = &pb.TabletLoad{}
+				out[ml.gid][pred] = sum
+			}
+			sum.Queries += l.Queries
+			sum.CpuMs += l.CpuMs
+			if l.Mutations > sum.Mutations {
+				sum.Mutations = l.Mutations
+			}
+		}
+	}
+	return out
+}
+
+// reserveMove marks the predicate and the groups as taking part in a move, so that no other move
+// or deletion of predicates runs on them until the returned function is called.
+func (s *Server) reserveMove(pred string, gids ...uint32) (func(), error) {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.movingPreds[pred]; ok && pred != "" {
+		return nil, errors.Errorf("Predicate %s is already being moved", pred)
+	}
+	for _, gid := range gids {
+		if _, ok := s.movingGroups[gid]; ok {
+			return nil, errors.Errorf("Group %d is already moving a predicate", gid)
+		}
+	}
+	if pred != "" {
+		s.movingPreds[pred] = struct{}{}
+	}
+	for _, gid := range gids {
+		s.movingGroups[gid] = struct{}{}
+	}
+	return func() {
+		s.Lock()
+		defer s.Unlock()
+		delete(s.movingPreds, pred)
+		for _, gid := range gids {
+			delete(s.movingGroups, gid)
+		}
+	}, nil
+}
+
+// GroupLoad is the cost of a group, along with the data and the load on its tablets.
+type GroupLoad struct {
+	GroupId     uint32  `json:"groupId"`
+	Cost        float64 `json:"cost"`
+	OnDiskBytes int64   `json:"onDiskBytes"`
+	Queries     float64 `json:"queries"`
+	Mutations   float64 `json:"mutations"`
+	CpuMs       float64 `json:"cpuMs"`
+}
+
+// TabletMove is a move of a tablet planned to balance the groups.
+type TabletMove struct {
+	Predicate string  `json:"predicate"`
+	SrcGroup  uint32  `json:"srcGroup"`
+	DstGroup  uint32  `json:"dstGroup"`
+	Cost      float64 `json:"cost"`
+}
+
+// RebalancePlan holds the moves that the next rebalance would make.
+type RebalancePlan struct {
+	Groups       []*GroupLoad  `json:"groups"`
+	Moves        []*TabletMove `json:"moves"`
+	MovesOngoing int           `json:"movesOngoing"`
+	// Blackout is true if the current time is in a blackout window. The moves are then only
+	// made once the window is over.
+	Blackout bool `json:"blackout"`
+}
+
+// planRebalance returns the moves that would balance the cost of the groups.
+func (s *Server) planRebalance() *RebalancePlan {
+	s.RLock()
+	defer s.RUnlock()
+	plan := &RebalancePlan{
+		MovesOngoing: len(s.moveOngoing),
+		Blackout:     inBlackout(opts.rebalance.blackouts, time.Now()),
+	}
+	if s.state == nil {
+		return plan
+	}
+
+	loads := make(map[string]*pb.TabletLoad)
+	for gid, groupLoads := range s.groupLoads() {
+		for pred, l := range groupLoads {
+			// Only count the load reported by the group serving the tablet.
+			if tab := s.state.Groups[gid].Tablets[pred]; tab != nil {
+				loads[pred] = l
+			}
+		}
+	}
+	busy := func(gid uint32) bool {
+		_, moving := s.movingGroups[gid]
+		return moving || !s.hasLeader(gid)
+	}
+	movable := func(tab *pb.Tablet) bool {
+		// Reserved predicates should always be in group 1 so do not re-balance them. Predicates
+		// split across groups are only moved by splitting and merging them.
+		_, split := s.state.TabletRanges[tab.Predicate]
+		_, moving := s.movingPreds[tab.Predicate]
+		return !x.IsReservedPredicate(tab.Predicate) && !split && !moving
+	}
+	slots := opts.rebalance.maxMoves - plan.MovesOngoing
+	plan.Groups, plan.Moves = planMoves(s.state.Groups, loads, opts.rebalance.weights, slots,
+		busy, movable)
+	return plan
+}
+
+// planMoves plans up to n moves of tablets from the costliest groups to the cheapest ones. The
+// busy groups don't take part in any move.
+func planMoves(groups map[uint32]*pb.Group, loads map[string]*pb.TabletLoad, w loadWeights,
+	n int, busy func(uint32) bool, movable func(*pb.Tablet) bool) ([]*GroupLoad, []*TabletMove) {
+
+	var total pb.TabletLoad
+	var totalBytes int64
+	for _, g := range groups {
+		for pred, tab := range g.Tablets {
+			totalBytes += tab.OnDiskBytes
+			if l := loads[pred]; l != nil {
+				total.Queries += l.Queries
+				total.Mutations += l.Mutations
+				total.CpuMs += l.CpuMs
+			}
+		}
+	}
+	var weights float64
+	share := func(weight, v, total float64) float64 {
+		if total <= 0 {
+			return 0
+		}
+		return weight * v / total
+	}
+	for _, d := range []struct{ w, total float64 }{{w.size, float64(totalBytes)},
+		{w.queries, total.Queries}, {w.mutations, total.Mutations}, {w.cpu, total.CpuMs}} {
+		if d.total > 0 {
+			weights += d.w
+		}
+	}
+	cost := func(tab *pb.Tablet) float64 {
+		if weights == 0 {
+			return 0
+		}
+		l := loads[tab.Predicate]
+		return (share(w.size, float64(tab.OnDiskBytes), float64(totalBytes)) +
+			share(w.queries, l.GetQueries(), total.Queries) +
+			share(w.mutations, l.GetMutations(), total.Mutations) +
+			share(w.cpu, l.GetCpuMs(), total.CpuMs)) / weights
+	}
+
+	var out []*GroupLoad
+	for gid, g := range groups {
+		gl := &GroupLoad{GroupId: gid}
+		for pred, tab := range g.Tablets {
+			gl.Cost += cost(tab)
+			gl.OnDiskBytes += tab.OnDiskBytes
+			if l := loads[pred]; l != nil {
+				gl.Queries += l.Queries
+				gl.Mutations += l.Mutations
+				gl.CpuMs += l.CpuMs
+			}
+		}
+		out = append(out, gl)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].GroupId < out[j].GroupId })
+
+	costs := make(map[uint32]float64, len(out))
+	for _, gl := range out {
+		costs[gl.GroupId] = gl.Cost
+	}
+	planned := make(map[uint32]bool)
+	var moves []*TabletMove
+	for len(moves) < n {
+		var candidates []uint32
+		for _, gl := range out {
+			if !planned[gl.GroupId] && !busy(gl.GroupId) {
+				candidates = append(candidates, gl.GroupId)
+			}
+		}
+		if len(candidates) < 2 {
+			break
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return costs[candidates[i]] < costs[candidates[j]]
+		})
+
+		dst := candidates[0]
+		var move *TabletMove
+		for i := len(candidates) - 1; i > 0 && move == nil; i-- {
+			src := candidates[i]
+			diff := costs[src] - costs[dst]
+			// We move a tablet only if the difference between the costs of both groups is at
+			// least 10% of the cost of the destination group.
+			if diff < 0.1*costs[dst] {
+				continue
+			}
+			// Find a tablet as costly as possible such that on moving it, the cost of the
+			// destination group is less than or equal to that of the source group.
+			for _, tab := range groups[src].Tablets {
+				c := cost(tab)
+				if !movable(tab) || c > diff/2 || c <= 0 {
+					continue
+				}
+				if move == nil || c > move.Cost ||
+					(c == move.Cost && tab.Predicate < move.Predicate) {
+					move = &TabletMove{Predicate: tab.Predicate, SrcGroup: src, DstGroup: dst,
+						Cost: c}
+				}
+			}
+		}
+		if move == nil {
+			break
+		}
+		moves = append(moves, move)
+		costs[move.SrcGroup] -= move.Cost
+		costs[move.DstGroup] += move.Cost
+		planned[move.SrcGroup], planned[move.DstGroup] = true, true
+	}
+	return out, moves
+}
+
+// TODO: Have a event log for everything.
+func (s *Server) rebalanceTablets() {
+	ticker := time.NewTicker(opts.rebalanceInterval)
+	for range ticker.C {
+		if !s.Node.AmLeader() {
+			continue
+		}
+		plan := s.planRebalance()
+		glog.Infof("Rebalance plan. Groups: %+v. Moves: %+v. Blackout: %v",
+			plan.Groups, plan.Moves, plan.Blackout)
+		if plan.Blackout {
+			continue
+		}
+		for _, mv := range plan.Moves {
+			go func(mv *TabletMove) {
+				if err := s.movePredicate(mv.Predicate, mv.SrcGroup, mv.DstGroup); err != nil {
+					glog.Errorln(err)
+				}
+			}(mv)
+		}
+	}
+}
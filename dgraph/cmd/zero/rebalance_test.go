@@ -0,0 +1,110 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package zero
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+func testGroups(sizes map[uint32]map[string]int64) map[uint32]*pb.Group {
+	groups := make(map[uint32]*pb.Group)
+	for gid, tablets := range sizes {
+		g := &pb.Group{Tablets: make(map[string]*pb.Tablet)}
+		for pred, size := range tablets {
+			g.Tablets[pred] = &pb.Tablet{GroupId: gid, Predicate: pred, OnDiskBytes: size}
+		}
+		groups[gid] = g
+	}
+	return groups
+}
+
+func TestPlanMoves(t *testing.T) {
+	w := loadWeights{size: 1, queries: 1, mutations: 1, cpu: 1}
+	idle := func(uint32) bool { return false }
+	all := func(*pb.Tablet) bool { return true }
+	groups := testGroups(map[uint32]map[string]int64{
+		1: {"a": 500, "b": 300, "c": 100},
+		2: {"d": 100},
+		3: {"e": 100, "f": 100},
+	})
+
+	// Without any load, the tablets are balanced by their size.
+	costs, moves := planMoves(groups, nil, w, 1, idle, all)
+	require.Len(t, costs, 3)
+	require.InDelta(t, 0.75, costs[0].Cost, 1e-9)
+	require.Equal(t, []*TabletMove{{Predicate: "b", SrcGroup: 1, DstGroup: 2, Cost: 0.25}},
+		moves)
+
+	// The small tablets under heavy read load are moved off their group instead.
+	loads := map[string]*pb.TabletLoad{
+		"e": {Queries: 600, CpuMs: 600},
+		"f": {Queries: 400, CpuMs: 400},
+	}
+	costs, moves = planMoves(groups, loads, w, 1, idle, all)
+	require.InDelta(t, 0.25, costs[0].Cost, 1e-9)
+	require.InDelta(t, 1000, costs[2].Queries, 1e-9)
+	require.Len(t, moves, 1)
+	require.Equal(t, "f", moves[0].Predicate)
+	require.Equal(t, uint32(3), moves[0].SrcGroup)
+	require.Equal(t, uint32(2), moves[0].DstGroup)
+
+	// Each group takes part in a single move.
+	groups = testGroups(map[uint32]map[string]int64{
+		1: {"a": 400, "b": 400},
+		2: {"c": 400, "d": 400},
+		3: {},
+		4: {},
+	})
+	w = loadWeights{size: 1}
+	_, moves = planMoves(groups, nil, w, 4, idle, all)
+	require.Len(t, moves, 2)
+	require.NotEqual(t, moves[0].SrcGroup, moves[1].SrcGroup)
+	require.NotEqual(t, moves[0].DstGroup, moves[1].DstGroup)
+
+	_, moves = planMoves(groups, nil, w, 4, func(gid uint32) bool { return gid == 3 }, all)
+	require.Len(t, moves, 1)
+	require.Equal(t, uint32(4), moves[0].DstGroup)
+
+	_, moves = planMoves(groups, nil, w, 4, idle,
+		func(tab *pb.Tablet) bool { return tab.GroupId != 1 })
+	require.Len(t, moves, 1)
+	require.Equal(t, uint32(2), moves[0].SrcGroup)
+
+	// Groups within 10% of each other are left alone.
+	groups = testGroups(map[uint32]map[string]int64{1: {"a": 105}, 2: {"b": 100}})
+	_, moves = planMoves(groups, nil, w, 1, idle, all)
+	require.Empty(t, moves)
+}
+
+func TestBlackouts(t *testing.T) {
+	windows, err := parseBlackouts("01:00-03:30, 22:00-00:30")
+	require.NoError(t, err)
+	require.Len(t, windows, 2)
+
+	at := func(hour, min int) time.Time {
+		return time.Date(2024, 5, 1, hour, min, 0, 0, time.UTC)
+	}
+	require.True(t, inBlackout(windows, at(1, 0)))
+	require.True(t, inBlackout(windows, at(3, 29)))
+	require.False(t, inBlackout(windows, at(3, 30)))
+	require.False(t, inBlackout(windows, at(12, 0)))
+	require.True(t, inBlackout(windows, at(23, 0)))
+	require.True(t, inBlackout(windows, at(0, 15)))
+	require.False(t, inBlackout(nil, at(1, 0)))
+
+	windows, err = parseBlackouts("")
+	require.NoError(t, err)
+	require.Empty(t, windows)
+	_, err = parseBlackouts("01:00")
+	require.Error(t, err)
+	_, err = parseBlackouts("1am-3am")
+	require.Error(t, err)
+}
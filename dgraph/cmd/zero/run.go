@@ -38,6 +38,7 @@ type options struct {
 	raft              *z.SuperFlag
 	telemetry         *z.SuperFlag
 	limit             *z.SuperFlag
+	rebalance         rebalanceOptions
 	bindall           bool
 	portOffset        int
 	numReplicas       int
@@ -84,6 +85,23 @@ instances to achieve high-availability.
 	flag.String("peer", "", "Address of another dgraphzero server.")
 	flag.StringP("wal", "w", "zw", "Directory storing WAL.")
 	flag.Duration("rebalance_interval", 8*time.Minute, "Interval for trying a predicate move.")
+	flag.String("rebalance", rebalanceDefaults, z.NewSuperFlagHelp(rebalanceDefaults).
+		Head("Tablet rebalancing options").
+		Flag("max-moves",
+			"The maximum number of predicate moves going on at the same time.").
+		Flag("blackout",
+			`Comma separated daily windows in UTC like "01:00-03:30", during which no tablets `+
+				"are moved to rebalance the groups.").
+		Flag("size-weight",
+			"The weight of the size of the tablets on disk in the cost of a group.").
+		Flag("query-weight",
+			"The weight of the queries per second on the tablets in the cost of a group.").
+		Flag("mutation-weight",
+			"The weight of the edges mutated per second on the tablets in the cost of a group.").
+		Flag("cpu-weight",
+			"The weight of the time spent processing queries on the tablets in the cost of a "+
+				"group.").
+		String())
 	flag.String("enterprise_license", "", "(deprecated) Path to the enterprise license file.")
 	flag.String("cid", "", "Cluster ID")
 
@@ -215,6 +233,11 @@ func run() {
 		UidLeaseLimit: limit.GetUint64("uid-lease"),
 		RefillAfter:   limit.GetDuration("refill-interval"),
 	}
+	rebalance, err := parseRebalanceOptions(z.NewSuperFlag(Zero.Conf.GetString("rebalance")).
+		MergeAndCheckDefault(rebalanceDefaults))
+	if err != nil {
+		log.Fatalf("ERROR: Invalid rebalance options: %v", err)
+	}
 	opts = options{
 		telemetry:         telemetry,
 		raft:              raft,
@@ -225,6 +248,7 @@ func run() {
 		peer:              Zero.Conf.GetString("peer"),
 		w:                 Zero.Conf.GetString("wal"),
 		rebalanceInterval: Zero.Conf.GetDuration("rebalance_interval"),
+		rebalance:         rebalance,
 		tlsClientConfig:   tlsConf,
 		audit:             auditConf,
 		limiterConfig:     limitConf,
@@ -298,6 +322,9 @@ func run() {
 		baseMux.HandleFunc("/state", st.getState)
 		baseMux.HandleFunc("/removeNode", st.removeNode)
 		baseMux.HandleFunc("/moveTablet", st.moveTablet)
+		baseMux.HandleFunc("/splitTablet", st.splitTablet)
+		baseMux.HandleFunc("/mergeTablet", st.mergeTablet)
+		baseMux.HandleFunc("/rebalancePlan", st.rebalancePlan)
 		baseMux.HandleFunc("/assign", st.assign)
 	}
 	baseMux.HandleFunc("/debug/jemalloc", x.JemallocHandler)
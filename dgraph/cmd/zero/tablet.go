@@ -8,7 +8,6 @@ package zero
 import (
 	"context"
 	"fmt"
-	"sort"
 	"time"
 
 	humanize "github.com/dustin/go-humanize"
@@ -49,20 +48,6 @@ This would trigger G1 to get latest state. Wait for it.
 
 */
 
-// TODO: Have a event log for everything.
-func (s *Server) rebalanceTablets() {
-	ticker := time.NewTicker(opts.rebalanceInterval)
-	for range ticker.C {
-		predicate, srcGroup, dstGroup := s.chooseTablet()
-		if len(predicate) == 0 {
-			continue
-		}
-		if err := s.movePredicate(predicate, srcGroup, dstGroup); err != nil {
-			glog.Errorln(err)
-		}
-	}
-}
-
 // MoveTablet can be used to move a tablet to a specific group.
 // It takes in tablet and destination group as argument.
 // It returns a *pb.Status to be used by the `/moveTablet` HTTP handler in Zero.
@@ -91,6 +76,12 @@ func (s *Server) MoveTablet(ctx context.Context, req *pb.MoveTabletRequest) (*pb
 			fmt.Errorf("namespace: %d. No tablet found for: %s", req.Namespace, req.Tablet)
 	}
 
+	if s.isSplit(tablet) {
+		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest},
+			fmt.Errorf("namespace: %d. Tablet: [%s] is split across groups, merge it first",
+				req.Namespace, req.Tablet)
+	}
+
 	srcGroup := tab.GroupId
 	if srcGroup == req.DstGroup {
 		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest},
@@ -117,6 +108,11 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 	defer func() {
 		<-s.moveOngoing
 	}()
+	release, err := s.reserveMove(predicate, srcGroup, dstGroup)
+	if err != nil {
+		return err
+	}
+	defer release()
 
 	ctx, cancel := context.WithTimeout(context.Background(), predicateMoveTimeout)
 	defer cancel()
@@ -216,71 +212,3 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 	}
 	return nil
 }
-
-func (s *Server) chooseTablet() (predicate string, srcGroup uint32, dstGroup uint32) {
-	s.RLock()
-	defer s.RUnlock()
-	if s.state == nil {
-		return
-	}
-	numGroups := len(s.state.Groups)
-	if !s.Node.AmLeader() || numGroups <= 1 {
-		return
-	}
-
-	// Sort all groups by their sizes.
-	type kv struct {
-		gid  uint32
-		size int64 // in bytes
-	}
-	var groups []kv
-	for k, v := range s.state.Groups {
-		space := int64(0)
-		for _, tab := range v.Tablets {
-			space += tab.OnDiskBytes
-		}
-		groups = append(groups, kv{k, space})
-	}
-	sort.Slice(groups, func(i, j int) bool {
-		return groups[i].size < groups[j].size
-	})
-
-	glog.Infof("\n\nGroups sorted by size: %+v\n\n", groups)
-	for lastGroup := numGroups - 1; lastGroup > 0; lastGroup-- {
-		srcGroup = groups[lastGroup].gid
-		dstGroup = groups[0].gid
-		sizeDiff := groups[lastGroup].size - groups[0].size
-		glog.Infof("size_diff %v\n", sizeDiff)
-		// Don't move a node unless you receive atleast one update regarding tablet size.
-		// Tablet size would have come up with leader update.
-		if !s.hasLeader(dstGroup) {
-			return
-		}
-		// We move the predicate only if the difference between size of both machines is
-		// atleast 10% of dst group.
-		if float64(sizeDiff) < 0.1*float64(groups[0].size) {
-			continue
-		}
-
-		// Try to find a predicate which we can move.
-		size := int64(0)
-		group := s.state.Groups[srcGroup]
-		for _, tab := range group.Tablets {
-			// Reserved predicates should always be in group 1 so do not re-balance them.
-			if x.IsReservedPredicate(tab.Predicate) {
-				continue
-			}
-
-			// Finds a tablet as big a possible such that on moving it dstGroup's size is
-			// less than or equal to srcGroup.
-			if tab.OnDiskBytes <= sizeDiff/2 && tab.OnDiskBytes > size {
-				predicate = tab.Predicate
-				size = tab.OnDiskBytes
-			}
-		}
-		if len(predicate) > 0 {
-			return
-		}
-	}
-	return
-}
@@ -0,0 +1,374 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package zero
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+/*
+A predicate too big for one group can be split by uid range across groups. Zero keeps the ranges
+of a split predicate in MembershipState.TabletRanges, next to its tablet, which stays with the group
+serving the first range. Alphas route the data of a uid to the group serving its range, and send
+the queries on a split predicate to all the groups serving a range of it.
+
+Splitting and merging ranges moves the data of a uid range from one group to another, the same way
+a predicate is moved:
+• Zero blocks the commits on the predicate and leases a timestamp.
+• The source group streams the data keys of the range to the destination group.
+• Zero proposes the new ranges, with the lease timestamp as the move timestamp.
+• Both groups delete the data outside of the ranges they now serve, and rebuild the indexes of the
+  predicate from the data they have left.
+
+Backups are refused while a predicate is split, as a restore assigns every predicate to one group.
+*/
+
+var (
+	// rangeCleanUpAttempts and rangeCleanUpBackoff bound the retries of the clean up of the groups
+	// after a range move.
+	rangeCleanUpAttempts = 8
+	rangeCleanUpBackoff  = time.Second
+)
+
+// rangeMove is the move of the uids in [start, end) of a predicate from group src to group dst,
+// after which the predicate is split into ranges.
+type rangeMove struct {
+	start, end uint64 // An end of 0 means that the range has no upper bound.
+	src, dst   uint32
+	ranges     []*pb.TabletRange
+}
+
+// rangeIndex returns the index of the range holding uid.
+func rangeIndex(ranges []*pb.TabletRange, uid uint64) int {
+	return sort.Search(len(ranges), func(i int) bool { return ranges[i].StartUid > uid }) - 1
+}
+
+// rangeEnd returns the uid at which the range at index i ends, 0 for the last range.
+func rangeEnd(ranges []*pb.TabletRange, i int) uint64 {
+	if i+1 < len(ranges) {
+		return ranges[i+1].StartUid
+	}
+	return 0
+}
+
+func checkRanges(ranges []*pb.TabletRange) error {
+	if len(ranges) == 0 || ranges[0].StartUid != 0 {
+		return errors.Errorf("The first tablet range must start at uid 0")
+	}
+	for i := 1; i < len(ranges); i++ {
+		if ranges[i].StartUid <= ranges[i-1].StartUid {
+			return errors.Errorf("Tablet ranges must be ordered by their start uid")
+		}
+	}
+	return nil
+}
+
+// joinRanges joins the neighbouring ranges served by the same group.
+func joinRanges(ranges []*pb.TabletRange) []*pb.TabletRange {
+	out := ranges[:1]
+	for _, r := range ranges[1:] {
+		if r.GroupId != out[len(out)-1].GroupId {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+func copyRanges(ranges []*pb.TabletRange) []*pb.TabletRange {
+	out := make([]*pb.TabletRange, 0, len(ranges)+1)
+	for _, r := range ranges {
+		out = append(out, proto.Clone(r).(*pb.TabletRange))
+	}
+	return out
+}
+
+// splitRanges returns the move of the uids from at up to the end of their range to group dst.
+func splitRanges(ranges []*pb.TabletRange, at uint64, dst uint32) (*rangeMove, error) {
+	if at == 0 {
+		return nil, errors.Errorf("Can't split a tablet at uid 0")
+	}
+	i := rangeIndex(ranges, at)
+	if ranges[i].GroupId == dst {
+		return nil, errors.Errorf("Uid %#x is already served by group %d", at, dst)
+	}
+	mv := &rangeMove{start: at, end: rangeEnd(ranges, i), src: ranges[i].GroupId, dst: dst}
+	mv.ranges = copyRanges(ranges)
+	if ranges[i].StartUid == at {
+		mv.ranges[i].GroupId = dst
+	} else {
+		mv.ranges = append(mv.ranges[:i+1],
+			append([]*pb.TabletRange{{StartUid: at, GroupId: dst}}, mv.ranges[i+1:]...)...)
+	}
+	mv.ranges = joinRanges(mv.ranges)
+	return mv, nil
+}
+
+// mergeRanges returns the move of the range starting at uid at to the group serving the range
+// before it.
+func mergeRanges(ranges []*pb.TabletRange, at uint64) (*rangeMove, error) {
+	i := rangeIndex(ranges, at)
+	if i <= 0 || ranges[i].StartUid != at {
+		return nil, errors.Errorf("No tablet range after the first one starts at uid %#x", at)
+	}
+	mv := &rangeMove{start: at, end: rangeEnd(ranges, i), src: ranges[i].GroupId,
+		dst: ranges[i-1].GroupId}
+	mv.ranges = copyRanges(ranges)
+	mv.ranges[i].GroupId = mv.dst
+	mv.ranges = joinRanges(mv.ranges)
+	return mv, nil
+}
+
+// tabletRanges returns the ranges of the predicate, a single range if it isn't split.
+func (s *Server) tabletRanges(tablet *pb.Tablet) []*pb.TabletRange {
+	s.RLock()
+	defer s.RUnlock()
+	if tr, ok := s.state.TabletRanges[tablet.Predicate]; ok {
+		return copyRanges(tr.Ranges)
+	}
+	return []*pb.TabletRange{{GroupId: tablet.GroupId}}
+}
+
+// isSplit returns whether the predicate is split across groups.
+func (s *Server) isSplit(pred string) bool {
+	s.RLock()
+	defer s.RUnlock()
+	_, ok := s.state.TabletRanges[pred]
+	return ok
+}
+
+// servesRange returns whether the group serves a range of the predicate.
+func (s *Server) servesRange(pred string, gid uint32) bool {
+	s.RLock()
+	defer s.RUnlock()
+	return rangeServedBy(s.state, pred, gid)
+}
+
+func rangeServedBy(state *pb.MembershipState, pred string, gid uint32) bool {
+	for _, r := range state.GetTabletRanges()[pred].GetRanges() {
+		if r.GroupId == gid {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitTablet moves the uids of a tablet from req.At up to the end of their range to the group
+// req.DstGroup, splitting the tablet across groups if it wasn't split yet.
+// It returns a *pb.Status to be used by the `/splitTablet` HTTP handler in Zero.
+func (s *Server) SplitTablet(ctx context.Context, req *pb.SplitTabletRequest) (*pb.Status, error) {
+	if !s.Node.AmLeader() {
+		return &pb.Status{Code: 1, Msg: x.Error}, errNotLeader
+	}
+
+	var isKnown bool
+	for _, grp := range s.KnownGroups() {
+		if grp == req.DstGroup {
+			isKnown = true
+			break
+		}
+	}
+	if !isKnown {
+		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest},
+			fmt.Errorf("group: [%d] is not a known group", req.DstGroup)
+	}
+
+	tablet := x.NamespaceAttr(req.Namespace, req.Tablet)
+	tab, err := s.rangeTablet(tablet)
+	if err != nil {
+		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest}, err
+	}
+	mv, err := splitRanges(s.tabletRanges(tab), req.At, req.DstGroup)
+	if err != nil {
+		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest}, err
+	}
+	if err := s.moveTabletRange(tablet, mv); err != nil {
+		glog.Errorf("namespace: %d. While splitting tablet %s at %#x to group %d. Error: %v",
+			req.Namespace, req.Tablet, req.At, req.DstGroup, err)
+		return &pb.Status{Code: 1, Msg: x.Error}, err
+	}
+	return &pb.Status{Code: 0, Msg: fmt.Sprintf("namespace: %d. Tablet: [%s] split at %#x. "+
+		"Moved uids [%#x, %#x) from group [%d] to [%d]", req.Namespace, req.Tablet, req.At,
+		mv.start, mv.end, mv.src, mv.dst)}, nil
+}
+
+// MergeTablet moves the range of a split tablet starting at req.At to the group serving the
+// range before it. The tablet is no longer split once a single group serves all of it.
+// It returns a *pb.Status to be used by the `/mergeTablet` HTTP handler in Zero.
+func (s *Server) MergeTablet(ctx context.Context, req *pb.MergeTabletRequest) (*pb.Status, error) {
+	if !s.Node.AmLeader() {
+		return &pb.Status{Code: 1, Msg: x.Error}, errNotLeader
+	}
+
+	tablet := x.NamespaceAttr(req.Namespace, req.Tablet)
+	tab, err := s.rangeTablet(tablet)
+	if err != nil {
+		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest}, err
+	}
+	if !s.isSplit(tablet) {
+		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest},
+			fmt.Errorf("namespace: %d. Tablet: [%s] is not split", req.Namespace, req.Tablet)
+	}
+	mv, err := mergeRanges(s.tabletRanges(tab), req.At)
+	if err != nil {
+		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest}, err
+	}
+	if err := s.moveTabletRange(tablet, mv); err != nil {
+		glog.Errorf("namespace: %d. While merging the range of tablet %s at %#x. Error: %v",
+			req.Namespace, req.Tablet, req.At, err)
+		return &pb.Status{Code: 1, Msg: x.Error}, err
+	}
+	return &pb.Status{Code: 0, Msg: fmt.Sprintf("namespace: %d. Tablet: [%s] merged at %#x. "+
+		"Moved uids [%#x, %#x) from group [%d] to [%d]", req.Namespace, req.Tablet, req.At,
+		mv.start, mv.end, mv.src, mv.dst)}, nil
+}
+
+// rangeTablet returns the tablet of a predicate that can be split across groups.
+func (s *Server) rangeTablet(pred string) (*pb.Tablet, error) {
+	tab := s.ServingTablet(pred)
+	if tab == nil {
+		return nil, errors.Errorf("No tablet found for: %s", x.ParseAttr(pred))
+	}
+	if strings.Contains(pred, hnsw.VecKeyword) {
+		return nil, errors.Errorf("Can't split the vector index tablet %s", x.ParseAttr(pred))
+	}
+	// The vector indexes are stored in the predicates of the index, which can't be split.
+	for _, sibling := range hnsw.SupportingPredicates(pred, true) {
+		if s.ServingTablet(sibling) != nil {
+			return nil, errors.Errorf("Can't split the tablet %s with a vector index",
+				x.ParseAttr(pred))
+		}
+	}
+	return tab, nil
+}
+
+// moveTabletRange moves the data of a uid range of the predicate from one group to another and
+// then proposes the ranges after the move. Like movePredicate, this Zero must remain the leader
+// for the entire duration of the move.
+func (s *Server) moveTabletRange(predicate string, mv *rangeMove) error {
+	s.moveOngoing <- struct{}{}
+	defer func() {
+		<-s.moveOngoing
+	}()
+	release, err := s.reserveMove(predicate, mv.src, mv.dst)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), predicateMoveTimeout)
+	defer cancel()
+
+	// Reserved predicates must stay in group 1, which serves their first range.
+	if x.IsReservedPredicate(predicate) && mv.ranges[0].GroupId != 1 {
+		return errors.Errorf("The first range of reserved predicate %s must stay in group 1",
+			predicate)
+	}
+	if _, err := s.latestMembershipState(ctx); err != nil {
+		return errors.Wrapf(err, "unable to reach quorum")
+	}
+	if !s.Node.AmLeader() {
+		return errors.Errorf("I am not the Zero leader")
+	}
+	glog.Infof("Going to move uids [%#x, %#x) of predicate: [%v] from group %d to %d\n",
+		mv.start, mv.end, predicate, mv.src, mv.dst)
+
+	// Block all commits on this predicate. Keep them blocked until we return from this function.
+	unblock := s.blockTablet(predicate)
+	defer unblock()
+
+	ids, err := s.Timestamps(ctx, &pb.Num{Val: 1})
+	if err != nil || ids.StartId == 0 {
+		return errors.Wrapf(err, "while leasing txn timestamp. Id: %+v", ids)
+	}
+
+	pl := s.Leader(mv.src)
+	if pl == nil {
+		return errors.Errorf("No healthy connection found to leader of group %d", mv.src)
+	}
+	in := &pb.MovePredicatePayload{
+		Predicate: predicate,
+		SourceGid: mv.src,
+		DestGid:   mv.dst,
+		TxnTs:     ids.StartId,
+		StartUid:  mv.start,
+		EndUid:    mv.end,
+		UidRange:  true,
+	}
+	glog.Infof("Starting range move: %+v", in)
+	if _, err := pb.NewWorkerClient(pl.Get()).MovePredicate(ctx, in); err != nil {
+		return errors.Wrapf(err, "while calling MovePredicate")
+	}
+
+	p := &pb.ZeroProposal{TabletRanges: &pb.TabletRanges{
+		Predicate: predicate,
+		Ranges:    mv.ranges,
+		MoveTs:    in.TxnTs,
+	}}
+	glog.Infof("Range move at Alpha done. Now proposing: %+v", p)
+	if err := s.Node.proposeAndWait(ctx, p); err != nil {
+		return errors.Wrapf(err, "while proposing tablet ranges. Proposal: %+v", p)
+	}
+
+	// Both groups now drop the data outside of the ranges they serve and rebuild the indexes of
+	// the predicate, once they know about the new ranges.
+	for _, gid := range []uint32{mv.src, mv.dst} {
+		if err := s.cleanUpRanges(ctx, predicate, gid, in.TxnTs); err != nil {
+			return errors.Wrapf(err, "the ranges of predicate %s are moved, but not cleaned up",
+				predicate)
+		}
+	}
+	glog.Infof("Range move done for: [%v] uids [%#x, %#x) from group %d to %d\n",
+		predicate, mv.start, mv.end, mv.src, mv.dst)
+	return nil
+}
+
+// cleanUpRanges asks the leader of group gid to drop the data of the predicate outside of the
+// ranges it serves, retrying with a backoff until it succeeds. The data left behind would be
+// found by the indexes of the group, so the move fails if the clean up never completes.
+func (s *Server) cleanUpRanges(ctx context.Context, predicate string, gid uint32, ts uint64) error {
+	backoff := rangeCleanUpBackoff
+	var err error
+	for attempt := 0; attempt < rangeCleanUpAttempts; attempt++ {
+		if attempt > 0 {
+			glog.Warningf("While cleaning up predicate [%v] in group %d. Retrying in %v. Error: %v",
+				predicate, gid, backoff, err)
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			backoff *= 2
+		}
+		pl := s.Leader(gid)
+		if pl == nil {
+			err = errors.Errorf("No healthy connection found to leader of group %d", gid)
+			continue
+		}
+		in := &pb.MovePredicatePayload{
+			Predicate:        predicate,
+			SourceGid:        gid,
+			TxnTs:            ts,
+			ExpectedChecksum: s.groupChecksums()[gid],
+			UidRange:         true,
+		}
+		if _, err = pb.NewWorkerClient(pl.Get()).MovePredicate(ctx, in); err == nil {
+			return nil
+		}
+	}
+	return errors.Wrapf(err, "while cleaning up predicate %s in group %d", predicate, gid)
+}
@@ -0,0 +1,97 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package zero
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+func rangesOf(pairs ...uint64) []*pb.TabletRange {
+	var ranges []*pb.TabletRange
+	for i := 0; i < len(pairs); i += 2 {
+		ranges = append(ranges, &pb.TabletRange{StartUid: pairs[i], GroupId: uint32(pairs[i+1])})
+	}
+	return ranges
+}
+
+func requireRanges(t *testing.T, want, got []*pb.TabletRange) {
+	require.Len(t, got, len(want))
+	for i := range want {
+		require.Equal(t, want[i].StartUid, got[i].StartUid)
+		require.Equal(t, want[i].GroupId, got[i].GroupId)
+	}
+}
+
+func TestSplitRanges(t *testing.T) {
+	mv, err := splitRanges(rangesOf(0, 1), 100, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), mv.start)
+	require.Equal(t, uint64(0), mv.end)
+	require.Equal(t, uint32(1), mv.src)
+	requireRanges(t, rangesOf(0, 1, 100, 2), mv.ranges)
+	require.NoError(t, checkRanges(mv.ranges))
+
+	mv, err = splitRanges(rangesOf(0, 1, 100, 2), 50, 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), mv.end)
+	requireRanges(t, rangesOf(0, 1, 50, 3, 100, 2), mv.ranges)
+
+	// Moving a whole range joins it with its neighbours in the same group.
+	mv, err = splitRanges(rangesOf(0, 1, 50, 3, 100, 1), 50, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), mv.src)
+	requireRanges(t, rangesOf(0, 1), mv.ranges)
+
+	_, err = splitRanges(rangesOf(0, 1, 100, 2), 150, 2)
+	require.Error(t, err)
+	_, err = splitRanges(rangesOf(0, 1), 0, 2)
+	require.Error(t, err)
+}
+
+func TestMergeRanges(t *testing.T) {
+	mv, err := mergeRanges(rangesOf(0, 1, 50, 3, 100, 2), 50)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), mv.src)
+	require.Equal(t, uint32(1), mv.dst)
+	require.Equal(t, uint64(100), mv.end)
+	requireRanges(t, rangesOf(0, 1, 100, 2), mv.ranges)
+
+	mv, err = mergeRanges(rangesOf(0, 1, 100, 2), 100)
+	require.NoError(t, err)
+	requireRanges(t, rangesOf(0, 1), mv.ranges)
+
+	_, err = mergeRanges(rangesOf(0, 1, 100, 2), 0)
+	require.Error(t, err)
+	_, err = mergeRanges(rangesOf(0, 1, 100, 2), 120)
+	require.Error(t, err)
+
+	require.Error(t, checkRanges(rangesOf(10, 1)))
+	require.Error(t, checkRanges(rangesOf(0, 1, 20, 2, 20, 1)))
+}
+
+func TestCleanUpRangesFails(t *testing.T) {
+	defer func(backoff time.Duration) { rangeCleanUpBackoff = backoff }(rangeCleanUpBackoff)
+	rangeCleanUpBackoff = time.Millisecond
+
+	// Group 2 has no leader, the clean up is retried and then fails the move.
+	s := &Server{state: &pb.MembershipState{Groups: map[uint32]*pb.Group{2: {}}}}
+	err := s.cleanUpRanges(context.Background(), "name", 2, 10)
+	require.ErrorContains(t, err, "No healthy connection found to leader of group 2")
+	require.ErrorContains(t, err, "while cleaning up predicate name in group 2")
+
+	// The retries stop with the context of the move.
+	rangeCleanUpBackoff = time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = s.cleanUpRanges(ctx, "name", 2, 10)
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -59,8 +59,11 @@ type Server struct {
 	// tls client config used to connect with zero internally
 	tlsClientConfig *tls.Config
 
-	moveOngoing    chan struct{}
+	moveOngoing    chan struct{} // Holds a slot for each predicate move going on.
 	blockCommitsOn *sync.Map
+	movingGroups   map[uint32]struct{}    // Groups taking part in a move.
+	movingPreds    map[string]struct{}    // Predicates being moved.
+	memberLoads    map[uint64]*memberLoad // Raft ID -> load last reported by the Alpha.
 
 	checkpointPerGroup map[uint32]uint64
 	// embedding the pb.UnimplementedZeroServer struct to ensure forward compatibility of the server.
@@ -87,7 +90,14 @@ func (s *Server) Init() {
 	s.leaderChangeCh = make(chan struct{}, 1)
 	s.closer = z.NewCloser(2) // grpc and http
 	s.blockCommitsOn = new(sync.Map)
-	s.moveOngoing = make(chan struct{}, 1)
+	maxMoves := opts.rebalance.maxMoves
+	if maxMoves < 1 {
+		maxMoves = 1
+	}
+	s.moveOngoing = make(chan struct{}, maxMoves)
+	s.movingGroups = make(map[uint32]struct{})
+	s.movingPreds = make(map[string]struct{})
+	s.memberLoads = make(map[uint64]*memberLoad)
 	s.checkpointPerGroup = make(map[uint32]uint64)
 	if opts.limiterConfig.UidLeaseLimit > 0 {
 		// rate limiting is not enabled when lease limit is set to zero.
@@ -167,6 +177,17 @@ func (s *Server) member(addr string) *pb.Member {
 	return nil
 }
 
+// numVoters returns the number of members of the group which are not learners.
+func numVoters(group *pb.Group) int {
+	var n int
+	for _, m := range group.Members {
+		if !m.Learner {
+			n++
+		}
+	}
+	return n
+}
+
 // Leader returns a connection pool to the zero leader.
 func (s *Server) Leader(gid uint32) *conn.Pool {
 	s.RLock()
@@ -358,7 +379,9 @@ func (s *Server) createProposals(dst *pb.Group) ([]*pb.ZeroProposal, error) {
 			return res, errors.Errorf("Unknown member: %+v", dstMember)
 		}
 		if srcMember.Addr != dstMember.Addr ||
-			srcMember.Leader != dstMember.Leader {
+			srcMember.Leader != dstMember.Leader ||
+			srcMember.Learner != dstMember.Learner ||
+			srcMember.Zone != dstMember.Zone {
 
 			proposal := &pb.ZeroProposal{
 				Member: dstMember,
@@ -479,10 +502,15 @@ func (s *Server) RemoveNode(ctx context.Context, req *pb.RemoveNodeRequest) (*pb
 	if _, ok := s.state.Groups[req.GroupId]; !ok {
 		return nil, errors.Errorf("No group with groupId %d found", req.GroupId)
 	}
-	if _, ok := s.state.Groups[req.GroupId].Members[req.NodeId]; !ok {
+	member, ok := s.state.Groups[req.GroupId].Members[req.NodeId]
+	if !ok {
 		return nil, errors.Errorf("No node with nodeId %d found in group %d", req.NodeId,
 			req.GroupId)
 	}
+	if req.Learner && !member.Learner {
+		return nil, errors.Errorf("Node with nodeId %d in group %d is not a learner", req.NodeId,
+			req.GroupId)
+	}
 	if len(s.state.Groups[req.GroupId].Members) == 1 && len(s.state.Groups[req.GroupId].
 		Tablets) > 0 {
 		return nil, errors.Errorf("Move all tablets from group %d before removing the last node",
@@ -495,6 +523,58 @@ func (s *Server) RemoveNode(ctx context.Context, req *pb.RemoveNodeRequest) (*pb
 	return &pb.Status{}, nil
 }
 
+// AddLearner adds a learner to the given group, which the Alpha started with the given address
+// joins as. Learners get all the updates of the group but don't vote, so that they can serve reads
+// in other zones without slowing down the writes.
+func (s *Server) AddLearner(ctx context.Context, req *pb.AddLearnerRequest) (*pb.Status, error) {
+	if !s.Node.AmLeader() {
+		return &pb.Status{Code: 1, Msg: x.Error}, errNotLeader
+	}
+	if req.Addr == "" {
+		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest},
+			errors.Errorf("No address provided for the learner")
+	}
+	if err := x.ValidateAddress(req.Addr); err != nil {
+		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest}, err
+	}
+
+	// Connect serializes the members joining, so that the learner is added before an Alpha with
+	// its address can connect.
+	s.connectLock.Lock()
+	defer s.connectLock.Unlock()
+
+	createProposal := func() (*pb.ZeroProposal, error) {
+		s.Lock()
+		defer s.Unlock()
+
+		if _, has := s.state.Groups[req.GroupId]; !has {
+			return nil, errors.Errorf("No group with groupId %d found", req.GroupId)
+		}
+		if m := s.member(req.Addr); m != nil {
+			return nil, errors.Errorf("Found another member %d with same address: %v", m.Id, m.Addr)
+		}
+		m := &pb.Member{
+			Id:      s.nextRaftId,
+			GroupId: req.GroupId,
+			Addr:    req.Addr,
+			Learner: true,
+			Zone:    req.Zone,
+		}
+		// Like in Connect, the ID is taken even if the proposal fails, so that it isn't reused.
+		s.nextRaftId++
+		return &pb.ZeroProposal{Member: m, MaxRaftId: m.Id}, nil
+	}
+	zp, err := createProposal()
+	if err != nil {
+		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest}, err
+	}
+	if err := s.Node.proposeAndWait(ctx, zp); err != nil {
+		return &pb.Status{Code: 1, Msg: x.Error}, err
+	}
+	return &pb.Status{Msg: fmt.Sprintf("Added learner %#x to group %d at %s",
+		zp.Member.Id, req.GroupId, req.Addr)}, nil
+}
+
 // Connect is used by Alpha nodes to connect the very first time with group zero.
 func (s *Server) Connect(ctx context.Context,
 	m *pb.Member) (resp *pb.ConnectionState, err error) {
@@ -574,7 +654,12 @@ func (s *Server) Connect(ctx context.Context,
 		proposal := new(pb.ZeroProposal)
 		// Check if we already have this member.
 		for _, group := range s.state.Groups {
-			if _, has := group.Members[m.Id]; has {
+			if member, has := group.Members[m.Id]; has {
+				// Keep the role and zone of a learner added through /admin across restarts.
+				m.Learner = m.Learner || member.Learner
+				if m.Zone == "" {
+					m.Zone = member.Zone
+				}
 				return nil
 			}
 		}
@@ -611,7 +696,7 @@ func (s *Server) Connect(ctx context.Context,
 			}
 
 			// We don't have this server in the list.
-			if len(group.Members) < s.NumReplicas {
+			if numVoters(group) < s.NumReplicas {
 				// We need more servers here, so let's add it.
 				proposal.Member = m
 				return proposal
@@ -626,7 +711,7 @@ func (s *Server) Connect(ctx context.Context,
 		}
 		// Let's assign this server to a new group.
 		for gid, group := range s.state.Groups {
-			if len(group.Members) < s.NumReplicas {
+			if numVoters(group) < s.NumReplicas {
 				m.GroupId = gid
 				proposal.Member = m
 				return proposal
@@ -725,6 +810,9 @@ func (s *Server) UpdateMembership(ctx context.Context, group *pb.Group) (*api.Pa
 			s.Unlock()
 		}
 	}
+	if len(group.GetTabletLoad()) > 0 {
+		s.recordLoad(group)
+	}
 	proposals, err := s.createProposals(group)
 	if err != nil {
 		// Sleep here so the caller doesn't keep on retrying indefinitely, creating a busy
@@ -784,6 +872,12 @@ func (s *Server) deletePredicates(ctx context.Context, group *pb.Group) error {
 	if gid == 0 {
 		return errors.Errorf("Unable to find group")
 	}
+	// Other moves may go on, as long as the group doesn't take part in any of them.
+	release, err := s.reserveMove("", gid)
+	if err != nil {
+		return nil
+	}
+	defer release()
 	state, err := s.latestMembershipState(ctx)
 	if err != nil {
 		return err
@@ -800,7 +894,7 @@ func (s *Server) deletePredicates(ctx context.Context, group *pb.Group) error {
 	wc := pb.NewWorkerClient(pl.Get())
 
 	for pred := range group.Tablets {
-		if _, found := sg.Tablets[pred]; found {
+		if _, found := sg.Tablets[pred]; found || rangeServedBy(state, pred, gid) {
 			continue
 		}
 		glog.Infof("Tablet: %v does not belong to group: %d. Sending delete instruction.",
@@ -27,8 +27,10 @@ const (
 	typFunc     = "type"
 	lenFunc     = "len"
 	countFunc   = "count"
+	historyFunc = "history"
 	uidInFunc   = "uid_in"
 	similarToFn = "similar_to"
+	fuseFunc    = "fuse"
 )
 
 var (
@@ -43,6 +45,7 @@ type GraphQuery struct {
 	Langs      []string
 	Alias      string
 	IsCount    bool
+	IsHistory  bool
 	IsInternal bool
 	IsGroupby  bool
 	Var        string
@@ -59,14 +62,17 @@ type GraphQuery struct {
 	Normalize        bool
 	Recurse          bool
 	RecurseArgs      RecurseArgs
+	Algo             *AlgoArgs
 	ShortestPathArgs ShortestPathArgs
-	Cascade          []string
-	IgnoreReflex     bool
-	Facets           *pb.FacetParams
-	FacetsFilter     *FilterTree
-	GroupbyAttrs     []GroupByAttr
-	FacetVar         map[string]string
-	FacetsOrder      []*FacetOrder
+	// AsOf is the commit timestamp the block is read at if the @asof directive is specified.
+	AsOf         uint64
+	Cascade      []string
+	IgnoreReflex bool
+	Facets       *pb.FacetParams
+	FacetsFilter *FilterTree
+	GroupbyAttrs []GroupByAttr
+	FacetVar     map[string]string
+	FacetsOrder  []*FacetOrder
 
 	// Used for ACL enabled queries to curtail results to only accessible params
 	AllowedPreds []string
@@ -88,6 +94,15 @@ type RecurseArgs struct {
 	// argument in the substitution part.
 }
 
+// AlgoArgs stores the arguments needed to process the @algo directive, which runs a graph
+// algorithm over the nodes of a block and the predicates asked for in it.
+// 1. @algo(pagerank)
+// 2. @algo(pagerank, damping: 0.85, iterations: 20)
+type AlgoArgs struct {
+	Name string
+	Args map[string]string
+}
+
 // ShortestPathArgs stores the arguments needed to process the shortest path query.
 type ShortestPathArgs struct {
 	// From, To can have a uid or a uid function as the argument.
@@ -1000,6 +1015,68 @@ func parseRecurseArgs(it *lex.ItemIterator, gq *GraphQuery) error {
 	return nil
 }
 
+func parseAlgoArgs(it *lex.ItemIterator, gq *GraphQuery) error {
+	if ok := trySkipItemTyp(it, itemLeftRound); !ok {
+		return it.Errorf("Expected algorithm name inside @algo()")
+	}
+	if !it.Next() || it.Item().Typ != itemName {
+		return it.Errorf("Expected algorithm name inside @algo()")
+	}
+	gq.Algo = &AlgoArgs{
+		Name: strings.ToLower(it.Item().Val),
+		Args: make(map[string]string),
+	}
+
+	for {
+		if _, ok := tryParseItemType(it, itemRightRound); ok {
+			return nil
+		}
+		if ok := trySkipItemTyp(it, itemComma); !ok {
+			return it.Errorf("Expected comma or ) inside @algo()")
+		}
+		if !it.Next() || it.Item().Typ != itemName {
+			return it.Errorf("Expected key inside @algo()")
+		}
+		key := strings.ToLower(it.Item().Val)
+		if ok := trySkipItemTyp(it, itemColon); !ok {
+			return it.Errorf("Expected colon(:) after %s", key)
+		}
+		if !it.Next() || it.Item().Typ != itemName {
+			return it.Errorf("Expected value inside @algo() for key: %s", key)
+		}
+		if _, ok := gq.Algo.Args[key]; ok {
+			return it.Errorf("Repeated key %q inside @algo()", key)
+		}
+		gq.Algo.Args[key] = collectName(it, it.Item().Val)
+	}
+}
+
+// parseAsOf parses the arguments of the @asof directive, which reads a block as of an earlier
+// commit timestamp, e.g. @asof(ts: 1250).
+func parseAsOf(it *lex.ItemIterator, gq *GraphQuery) error {
+	if ok := trySkipItemTyp(it, itemLeftRound); !ok {
+		return it.Errorf("Expected timestamp inside @asof()")
+	}
+	if !it.Next() || it.Item().Typ != itemName || strings.ToLower(it.Item().Val) != "ts" {
+		return it.Errorf("Expected key ts inside @asof()")
+	}
+	if ok := trySkipItemTyp(it, itemColon); !ok {
+		return it.Errorf("Expected colon(:) after ts")
+	}
+	if !it.Next() || it.Item().Typ != itemName {
+		return it.Errorf("Expected timestamp inside @asof()")
+	}
+	ts, err := strconv.ParseUint(it.Item().Val, 0, 64)
+	if err != nil || ts == 0 {
+		return it.Errorf("Invalid timestamp %q inside @asof()", it.Item().Val)
+	}
+	gq.AsOf = ts
+	if ok := trySkipItemTyp(it, itemRightRound); !ok {
+		return it.Errorf("Expected ) after the timestamp inside @asof()")
+	}
+	return nil
+}
+
 // getQuery creates a GraphQuery object tree by calling getRoot
 // and goDeep functions by looking at '{'.
 func getQuery(it *lex.ItemIterator) (gq *GraphQuery, rerr error) {
@@ -1052,10 +1129,33 @@ L:
 			case "ignorereflex":
 				gq.IgnoreReflex = true
 			case "recurse":
+				if gq.Algo != nil {
+					return nil, item.Errorf("@recurse can't be used with @algo")
+				}
 				gq.Recurse = true
 				if err := parseRecurseArgs(it, gq); err != nil {
 					return nil, err
 				}
+			case "algo":
+				if gq.Algo != nil {
+					return nil, item.Errorf("Repeated algo at root")
+				}
+				if gq.Recurse {
+					return nil, item.Errorf("@algo can't be used with @recurse")
+				}
+				if gq.Alias == "shortest" {
+					return nil, item.Errorf("@algo can't be used with shortest path queries")
+				}
+				if err := parseAlgoArgs(it, gq); err != nil {
+					return nil, err
+				}
+			case "asof":
+				if gq.AsOf != 0 {
+					return nil, item.Errorf("Repeated asof at root")
+				}
+				if err := parseAsOf(it, gq); err != nil {
+					return nil, err
+				}
 			default:
 				return nil, item.Errorf("Unknown directive [%s]", item.Val)
 			}
@@ -1701,7 +1801,8 @@ func validFuncName(name string) bool {
 
 	switch name {
 	case "regexp", "anyofterms", "allofterms", "alloftext", "anyoftext",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to", "search",
+		"fuse":
 		return true
 	}
 	return false
@@ -1939,10 +2040,10 @@ L:
 				continue
 			}
 
-			// Unlike other functions, uid function has no attribute, everything is args.
+			// Unlike other functions, uid and fuse functions have no attribute, everything is args.
 			switch {
 			case len(function.Attr) == 0 && function.Name != uidFunc &&
-				function.Name != typFunc:
+				function.Name != typFunc && function.Name != fuseFunc:
 
 				if strings.ContainsRune(itemInFunc.Val, '"') {
 					return nil, itemInFunc.Errorf("Attribute in function"+
@@ -2008,14 +2109,29 @@ L:
 					Name: val,
 					Typ:  UidVar,
 				})
+			case fuseFunc:
+				// E.g. fuse(rrf, a, 0.7, b): the first argument is the method, the others are
+				// variables, each one optionally followed by its weight.
+				if _, err := strconv.ParseFloat(val, 64); err != nil && len(function.Args) > 1 {
+					function.NeedsVar = append(function.NeedsVar, VarContext{
+						Name: val,
+						Typ:  AnyVar,
+					})
+				}
 			}
 		}
 	}
 
-	if function.Name != uidFunc && function.Name != typFunc && len(function.Attr) == 0 {
+	if function.Name != uidFunc && function.Name != typFunc && function.Name != fuseFunc &&
+		len(function.Attr) == 0 {
 		return nil, it.Errorf("Got empty attr for function: [%s]", function.Name)
 	}
 
+	if function.Name == fuseFunc && len(function.NeedsVar) == 0 {
+		return nil, it.Errorf("fuse function expects a method and variables. Got: %v",
+			function.Args)
+	}
+
 	if function.Name == typFunc && len(function.Args) != 1 {
 		return nil, it.Errorf("type function only supports one argument. Got: %v", function.Args)
 	}
@@ -2691,7 +2807,8 @@ func validKeyAtRoot(k string) bool {
 	switch k {
 	case "func", "orderasc", "orderdesc", "first", "offset", "after":
 		return true
-	case "from", "to", "numpaths", "minweight", "maxweight", "maxfrontiersize":
+	case "from", "to", "numpaths", "minweight", "maxweight", "maxfrontiersize", "algorithm",
+		"weight", "heuristic":
 		// Specific to shortest path
 		return true
 	case "depth":
@@ -3266,6 +3383,34 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 					it.Next()
 				}
 				continue
+			case valLower == historyFunc:
+				peekIt, err = it.Peek(3)
+				if err != nil || peekIt[0].Typ != itemLeftRound || peekIt[1].Typ != itemName ||
+					peekIt[2].Typ == itemColon {
+					// Not history(pred), but a predicate named history.
+					goto Fall
+				}
+				if varName != "" {
+					return it.Errorf("Cannot assign a variable to history()")
+				}
+				if count == seen {
+					return it.Errorf("Count of history() is not allowed")
+				}
+				it.Next() // Consume '('
+				it.Next()
+				child := &GraphQuery{
+					Attr:      collectName(it, it.Item().Val),
+					Args:      make(map[string]string),
+					Alias:     alias,
+					IsHistory: true,
+				}
+				if _, ok := tryParseItemType(it, itemRightRound); !ok {
+					return it.Errorf("Expected ) after the predicate in history()")
+				}
+				gq.Children = append(gq.Children, child)
+				alias = ""
+				curp = nil
+				continue
 			case valLower == valueFunc:
 				if varName != "" {
 					return it.Errorf("Cannot assign a variable to val()")
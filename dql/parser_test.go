@@ -1342,6 +1342,39 @@ func TestParseShortestPathWithUidVars(t *testing.T) {
 	require.Equal(t, 1, len(q.ShortestPathArgs.To.NeedsVar))
 }
 
+func TestParseShortestPathAlgorithmArgs(t *testing.T) {
+	query := `{
+		var(func: has(cost)) {
+			w as cost
+			h as estimate
+		}
+
+		shortest(from: 0x01, to: 0x02, algorithm: astar, weight: val(w), heuristic: val(h)) {
+			road
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	q := res.Query[1]
+	require.Equal(t, "astar", q.Args["algorithm"])
+	require.Equal(t, "w", q.Args["weight"])
+	require.Equal(t, "h", q.Args["heuristic"])
+	require.Equal(t, []VarContext{{Name: "w", Typ: ValueVar}, {Name: "h", Typ: ValueVar}},
+		q.NeedsVar)
+}
+
+func TestParseShortestPathGeoHeuristic(t *testing.T) {
+	query := `{
+		shortest(from: 0x01, to: 0x02, algorithm: astar, heuristic: location) {
+			road
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "location", res.Query[0].Args["heuristic"])
+	require.Empty(t, res.Query[0].NeedsVar)
+}
+
 func TestParseShortestPathInvalidFnError(t *testing.T) {
 	query := `{
 		shortest(from: eq(a), to: uid(b)) {
@@ -2271,6 +2304,50 @@ func TestParseFuncNested(t *testing.T) {
 	require.Equal(t, res.Query[0].Func.IsCount, true)
 }
 
+func TestParseSearch(t *testing.T) {
+	query := `
+	query {
+		score as var(func: search(description@en, "quick fox"))
+		me(func: uid(score), orderdesc: val(score)) {
+			description
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.NotNil(t, res.Query[0].Func)
+	require.Equal(t, "search", res.Query[0].Func.Name)
+	require.Equal(t, "description", res.Query[0].Func.Attr)
+	require.Equal(t, "en", res.Query[0].Func.Lang)
+	require.Equal(t, "quick fox", res.Query[0].Func.Args[0].Value)
+	require.Equal(t, "score", res.Query[0].Var)
+}
+
+func TestParseFuse(t *testing.T) {
+	query := `
+	query {
+		v as var(func: similar_to(embedding, 10, "[0.1, 0.2]"))
+		t as var(func: search(description, "quick fox"))
+		fused as var(func: fuse(weighted, v, 0.7, t, -0.3))
+		me(func: uid(fused), orderdesc: val(fused)) {
+			description
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	f := res.Query[2].Func
+	require.Equal(t, "fuse", f.Name)
+	require.Empty(t, f.Attr)
+	require.Equal(t, []Arg{{Value: "weighted"}, {Value: "v"}, {Value: "0.7"}, {Value: "t"},
+		{Value: "-0.3"}}, f.Args)
+	require.Equal(t, []VarContext{{Name: "v", Typ: AnyVar}, {Name: "t", Typ: AnyVar}},
+		f.NeedsVar)
+
+	_, err = Parse(Request{Str: `{ me(func: fuse(rrf)) { uid } }`})
+	require.ErrorContains(t, err, "fuse function expects a method and variables")
+}
+
 func TestParseFuncNested2(t *testing.T) {
 	query := `
 	query {
@@ -5347,6 +5424,112 @@ func TestRecurse(t *testing.T) {
 	require.Equal(t, gq.Query[0].RecurseArgs.AllowLoop, true)
 }
 
+func TestAlgo(t *testing.T) {
+	query := `
+	{
+		me(func: has(follows)) @algo(PageRank, damping: 0.85, iterations: 20) {
+			follows
+			~likes
+		}
+	}`
+	gq, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, &AlgoArgs{
+		Name: "pagerank",
+		Args: map[string]string{"damping": "0.85", "iterations": "20"},
+	}, gq.Query[0].Algo)
+	require.Len(t, gq.Query[0].Children, 2)
+}
+
+func TestAlgoWithError(t *testing.T) {
+	tests := map[string]string{
+		`@algo {`:                          "Expected algorithm name inside @algo()",
+		`@algo(wcc depth: 1) {`:            "Expected comma or ) inside @algo()",
+		`@algo(wcc, depth 1) {`:            "Expected colon(:) after depth",
+		`@algo(wcc, depth: 1, depth: 2) {`: `Repeated key "depth" inside @algo()`,
+		`@algo(wcc) @algo(scc) {`:          "Repeated algo at root",
+		`@recurse @algo(wcc) {`:            "@algo can't be used with @recurse",
+		`@algo(wcc) @recurse(depth: 2) {`:  "@recurse can't be used with @algo",
+	}
+	for directive, msg := range tests {
+		query := `{ me(func: has(follows)) ` + directive + ` follows } }`
+		_, err := Parse(Request{Str: query})
+		require.ErrorContains(t, err, msg, directive)
+	}
+
+	query := `{ shortest(from: 0x1, to: 0x2) @algo(wcc) { follows } }`
+	_, err := Parse(Request{Str: query})
+	require.ErrorContains(t, err, "@algo can't be used with shortest path queries")
+}
+
+func TestAsOf(t *testing.T) {
+	query := `
+	{
+		me(func: uid(0x1)) @asof(ts: 1250) @filter(has(name)) {
+			name
+		}
+	}`
+	gq, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1250), gq.Query[0].AsOf)
+	require.NotNil(t, gq.Query[0].Filter)
+}
+
+func TestAsOfWithError(t *testing.T) {
+	tests := map[string]string{
+		`@asof {`:                       "Expected timestamp inside @asof()",
+		`@asof(time: 10) {`:             "Expected key ts inside @asof()",
+		`@asof(ts 10) {`:                "Expected colon(:) after ts",
+		`@asof(ts: ten) {`:              `Invalid timestamp "ten" inside @asof()`,
+		`@asof(ts: 0) {`:                `Invalid timestamp "0" inside @asof()`,
+		`@asof(ts: 10) @asof(ts: 12) {`: "Repeated asof at root",
+		`@asof(ts: 10, loop: true) {`:   "Expected ) after the timestamp inside @asof()",
+	}
+	for directive, msg := range tests {
+		query := `{ me(func: uid(0x1)) ` + directive + ` name } }`
+		_, err := Parse(Request{Str: query})
+		require.ErrorContains(t, err, msg, directive)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	query := `
+	{
+		me(func: uid(0x1)) {
+			name
+			past: history(name)
+			history(first-name)
+			history
+		}
+	}`
+	gq, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	children := gq.Query[0].Children
+	require.Len(t, children, 4)
+	require.False(t, children[0].IsHistory)
+	require.True(t, children[1].IsHistory)
+	require.Equal(t, "name", children[1].Attr)
+	require.Equal(t, "past", children[1].Alias)
+	require.True(t, children[2].IsHistory)
+	require.Equal(t, "first-name", children[2].Attr)
+	require.False(t, children[3].IsHistory)
+	require.Equal(t, "history", children[3].Attr)
+}
+
+func TestHistoryWithError(t *testing.T) {
+	tests := map[string]string{
+		`h as history(name)`:       "Cannot assign a variable to history()",
+		`history(name, age)`:       "Expected ) after the predicate in history()",
+		`history(name) @filter(a)`: "Invalid use of directive.",
+		`history(name) { uid }`:    "Query syntax invalid.",
+	}
+	for field, msg := range tests {
+		query := `{ me(func: uid(0x1)) { ` + field + ` } }`
+		_, err := Parse(Request{Str: query})
+		require.ErrorContains(t, err, msg, field)
+	}
+}
+
 func TestRecurseWithError(t *testing.T) {
 	query := `
 	{
@@ -0,0 +1,31 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"google.golang.org/grpc/status"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+)
+
+// CDCServer implements the CDC gRPC service, which streams the change data capture events of
+// the Alpha to its clients.
+type CDCServer struct {
+	pb.UnimplementedCDCServer
+}
+
+// Subscribe sends the CDC events to the client until it disconnects. The events of all the
+// namespaces are sent, so only the guardians of the galaxy are allowed to subscribe.
+func (s *CDCServer) Subscribe(req *pb.CDCSubscribeRequest, stream pb.CDC_SubscribeServer) error {
+	ctx := stream.Context()
+	if err := AuthSuperAdmin(ctx); err != nil {
+		s := status.Convert(err)
+		return status.Error(s.Code(),
+			"CDC events can only be subscribed to by the guardian of the galaxy. "+s.Message())
+	}
+	return worker.SubscribeCDC(ctx.Done(), stream.Send)
+}
@@ -510,6 +510,40 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 	return empty, nil
 }
 
+// CompactVectorIndex rebuilds the vector index of the predicate from its vectors, in the
+// background. This drops the nodes deleted from the index along with the edges to them, and
+// builds the layers again for the remaining vectors.
+func CompactVectorIndex(ctx context.Context, predicate string) error {
+	// If a background task is already running, the rebuild would have to wait for it.
+	if schema.State().IndexingInProgress() {
+		return errIndexingInProgress
+	}
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "While compacting vector index")
+	}
+	attr := x.NamespaceAttr(namespace, predicate)
+	nodes, err := worker.GetSchemaOverNetwork(ctx, &pb.SchemaRequest{
+		Predicates: []string{attr},
+		Fields:     []string{"vector_specs"},
+	})
+	if err != nil {
+		return err
+	}
+	if len(nodes) == 0 || len(nodes[0].IndexSpecs) == 0 {
+		return errors.Errorf("Predicate %s has no vector index", predicate)
+	}
+
+	glog.Infof("Compacting the vector index of %s", predicate)
+	m := &pb.Mutations{
+		StartTs:            worker.State.GetTimestamp(false),
+		Schema:             []*pb.SchemaUpdate{{Predicate: attr}},
+		RebuildVectorIndex: true,
+	}
+	_, err = query.ApplyMutations(ctx, m)
+	return err
+}
+
 func annotateNamespace(span trace.Span, ns uint64) {
 	span.SetAttributes(attribute.String("ns", fmt.Sprintf("%d", ns)))
 }
@@ -558,6 +592,9 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 	if err != nil {
 		return err
 	}
+	if edges, err = worker.AddEmbeddingEdges(ctx, edges); err != nil {
+		return err
+	}
 
 	if len(edges) > x.Config.LimitMutationsNquad {
 		return errors.Errorf("NQuad count in the request: %d, is more that threshold: %d",
@@ -584,6 +621,7 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 		Metadata: &pb.Metadata{
 			PredHints: predHints,
 		},
+		Serializable: worker.IsSerializable(ctx),
 	}
 
 	// ensure that we do not insert very large (> 64 KB) value
@@ -594,6 +632,10 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 	qc.span.AddEvent("Applying mutations",
 		trace.WithAttributes(attribute.String("m", fmt.Sprintf("%+v", m))))
 	resp.Txn, err = query.ApplyMutations(ctx, m)
+	if resp.Txn != nil {
+		// The keys read by the query of an upsert in a serializable transaction.
+		resp.Txn.Keys = append(resp.Txn.Keys, worker.ReadKeys(ctx)...)
+	}
 	qc.span.AddEvent("Txn Context",
 		trace.WithAttributes(attribute.String("txn", fmt.Sprintf("%+v", resp.Txn))))
 	if err != nil {
@@ -1092,6 +1134,7 @@ func (s *Server) Health(ctx context.Context, all bool) (*api.Response, error) {
 		Indexing:    schema.GetIndexingPredicates(),
 		EeFeatures:  worker.GetFeaturesList(),
 		MaxAssigned: posting.Oracle().MaxAssigned(),
+		LagMs:       conn.LagMs(worker.ReplicaLag()),
 	})
 
 	var err error
@@ -1181,12 +1224,41 @@ func (s *Server) QueryGraphQL(ctx context.Context, req *api.Request,
 }
 
 func (s *Server) Query(ctx context.Context, req *api.Request) (*api.Response, error) {
+	var explain *query.Explain
+	if query.IsExplainRequested(ctx) {
+		ctx, explain = query.WithExplain(ctx)
+	}
+	maxStaleness, err := worker.RequestedMaxStaleness(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if maxStaleness > 0 {
+		ctx = worker.WithMaxStaleness(ctx, maxStaleness)
+	}
+	if zone := worker.RequestedZone(ctx); zone != "" {
+		ctx = worker.WithZone(ctx, zone)
+	}
+	if worker.IsSerializableRequested(ctx) {
+		ctx = worker.WithSerializable(ctx)
+	}
 	resp, err := s.QueryNoGrpc(ctx, req)
 	if err != nil {
 		return resp, err
 	}
 	md := metadata.Pairs(x.DgraphCostHeader, fmt.Sprint(resp.Metrics.NumUids["_total"]))
+	if explain != nil {
+		// The response proto has no room for it, send the explain output as a header instead.
+		js, err := json.Marshal(explain)
+		if err != nil {
+			return resp, errors.Wrapf(err, "while marshalling explain output")
+		}
+		md.Append(x.DgraphExplainHeader, string(js))
+	}
 	if err := grpc.SendHeader(ctx, md); err != nil {
+		if explain != nil {
+			// The explain output asked for by the client would be lost.
+			return resp, errors.Wrapf(err, "while sending the explain output")
+		}
 		glog.Warningf("error in sending grpc headers: %v", err)
 	}
 	return resp, nil
@@ -1425,6 +1497,21 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 		qr.Cache = worker.NoCache
 	}
 
+	// A query which accepts stale results reads at the max assigned timestamp of this Alpha, if
+	// it is recent enough, without contacting Zero.
+	if maxStaleness := worker.MaxStaleness(ctx); maxStaleness > 0 && qc.req.StartTs == 0 {
+		if !qc.req.ReadOnly {
+			return resp, errors.Errorf("A query with a max staleness must be read-only.")
+		}
+		if qc.req.StartTs = worker.StaleReadTs(maxStaleness); qc.req.StartTs != 0 {
+			qr.Cache = worker.NoCache
+		}
+	}
+
+	if worker.IsSerializable(ctx) && qc.req.ReadOnly {
+		return resp, errors.Errorf("A serializable query can't be read-only.")
+	}
+
 	if qc.req.StartTs == 0 {
 		assignTimestampStart := time.Now()
 		qc.req.StartTs = worker.State.GetTimestamp(qc.req.ReadOnly)
@@ -1448,6 +1535,8 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 		}
 		return resp, errors.Wrap(err, "")
 	}
+	// The client sends the keys read back when committing, for Zero to check them.
+	resp.Txn.Keys = worker.ReadKeys(ctx)
 
 	if len(er.SchemaNode) > 0 || len(er.Types) > 0 {
 		if err = authorizeSchemaQuery(ctx, &er); err != nil {
@@ -164,6 +164,8 @@ const (
 		lastUpdate: UInt64
 		clusterInfoOnly: Boolean
 		forceGroupId: Boolean
+		learner: Boolean
+		zone: String
 	}
 
 	type Tablet {
@@ -195,7 +197,7 @@ const (
 
 	input ExportInput {
 		"""
-		Data format for the export, e.g. "rdf" or "json" (default: "rdf")
+		Data format for the export, one of "rdf", "json", "csv" or "parquet" (default: "rdf")
 		"""
 		format: String
 
@@ -205,6 +207,17 @@ const (
 		"""
 		namespace: Int
 
+		"""
+		If set, only the changes made after this timestamp are exported. The data that was set
+		or changed goes to the usual data file, and the data that was deleted to a separate
+		.deletes file, both in the "rdf" or "json" format. The changes are the difference between
+		the data at the timestamp and at the time of the export, so no triple is in both files:
+		the data file is applied as set mutations and the .deletes file as delete mutations, in
+		any order. The timestamp must not be older than the last snapshot of any group, as older
+		versions may have been discarded.
+		"""
+		sinceTs: UInt64
+
 		"""
 		Destination for the export: e.g. Minio or S3 bucket or /absolute/path
 		"""
@@ -312,6 +325,43 @@ const (
 		response: Response
 	}
 
+	input AddLearnerInput {
+		"""
+		ID of the group the learner replicates.
+		"""
+		groupId: UInt64!
+
+		"""
+		Address of the Alpha that joins as the learner, as given to its --my flag.
+		"""
+		addr: String!
+
+		"""
+		Zone or region the learner runs in, which reads are routed by.
+		"""
+		zone: String
+	}
+
+	type AddLearnerPayload {
+		response: Response
+	}
+
+	input RemoveLearnerInput {
+		"""
+		ID of the learner to be removed.
+		"""
+		nodeId: UInt64!
+
+		"""
+		ID of the group from which the learner is to be removed.
+		"""
+		groupId: UInt64!
+	}
+
+	type RemoveLearnerPayload {
+		response: Response
+	}
+
 	input MoveTabletInput {
 		"""
 		Namespace in which the predicate exists.
@@ -333,6 +383,41 @@ const (
 		response: Response
 	}
 
+	input ReplayCDCInput {
+		"""
+		Commit timestamp from which the CDC events are sent again. The data as of this timestamp
+		is sent first, as set mutations, followed by the events of the transactions committed
+		after it. It must not be older than the last snapshot of any group. If not set, the
+		current data is sent before the new events.
+		"""
+		sinceTs: UInt64
+	}
+
+	type ReplayCDCPayload {
+		response: Response
+
+		"""
+		Commit timestamp from which the CDC events are sent again.
+		"""
+		sinceTs: UInt64
+	}
+
+	input CompactVectorIndexInput {
+		"""
+		Namespace in which the predicate exists.
+		"""
+		namespace: UInt64
+
+		"""
+		Name of the predicate whose vector index is compacted.
+		"""
+		predicate: String!
+	}
+
+	type CompactVectorIndexPayload {
+		response: Response
+	}
+
 	enum AssignKind {
 		UID
 		TIMESTAMP
@@ -393,7 +478,8 @@ const (
 
 		"""
 		Starts an export of all data in the cluster.  Export format should be 'rdf' (the default
-		if no format is given), or 'json'.
+		if no format is given), 'json', 'csv' (node and edge files for graph import tools) or
+		'parquet' (a file per type, and one for the nodes and predicates outside of the types).
 		See : https://dgraph.io/docs/deploy/#export-database
 		"""
 		export(input: ExportInput!): ExportPayload
@@ -418,11 +504,34 @@ const (
 		"""
 		removeNode(input: RemoveNodeInput!): RemoveNodePayload
 
+		"""
+		Add a non-voting read replica to a group. The Alpha with the given address joins the group
+		as a learner when it starts.
+		"""
+		addLearner(input: AddLearnerInput!): AddLearnerPayload
+
+		"""
+		Remove a learner from its group. Fails if the node is a voting member.
+		"""
+		removeLearner(input: RemoveLearnerInput!): RemoveLearnerPayload
+
 		"""
 		Move a predicate from one group to another.
 		"""
 		moveTablet(input: MoveTabletInput!): MoveTabletPayload
 
+		"""
+		Send the CDC events again from a timestamp, starting with a backfill of the data at that
+		timestamp, so that a new consumer can catch up without an export.
+		"""
+		replayCDC(input: ReplayCDCInput!): ReplayCDCPayload
+
+		"""
+		Rebuild the vector index of a predicate from its vectors in the background, dropping
+		the deleted vectors and repairing the layers degraded by deletions.
+		"""
+		compactVectorIndex(input: CompactVectorIndexInput!): CompactVectorIndexPayload
+
 		"""
 		Lease UIDs, Timestamps or Namespace IDs in advance.
 		"""
@@ -497,20 +606,24 @@ var (
 		"getGroup":       minimalAdminQryMWs,
 	}
 	adminMutationMWConfig = map[string]resolve.MutationMiddlewares{
-		"backup":          gogMutMWs,
-		"config":          gogMutMWs,
-		"draining":        gogMutMWs,
-		"export":          stdAdminMutMWs, // dgraph handles the export for other namespaces by superadmin
-		"login":           minimalAdminMutMWs,
-		"restore":         gogMutMWs,
-		"shutdown":        gogMutMWs,
-		"removeNode":      gogMutMWs,
-		"moveTablet":      gogMutMWs,
-		"assign":          gogMutMWs,
-		"updateGQLSchema": stdAdminMutMWs,
-		"addNamespace":    gogAclMutMWs,
-		"deleteNamespace": gogAclMutMWs,
-		"resetPassword":   gogAclMutMWs,
+		"backup":             gogMutMWs,
+		"config":             gogMutMWs,
+		"draining":           gogMutMWs,
+		"export":             stdAdminMutMWs, // dgraph handles the export for other namespaces by superadmin
+		"login":              minimalAdminMutMWs,
+		"restore":            gogMutMWs,
+		"shutdown":           gogMutMWs,
+		"removeNode":         gogMutMWs,
+		"addLearner":         gogMutMWs,
+		"removeLearner":      gogMutMWs,
+		"moveTablet":         gogMutMWs,
+		"replayCDC":          gogMutMWs,
+		"compactVectorIndex": gogMutMWs,
+		"assign":             gogMutMWs,
+		"updateGQLSchema":    stdAdminMutMWs,
+		"addNamespace":       gogAclMutMWs,
+		"deleteNamespace":    gogAclMutMWs,
+		"resetPassword":      gogAclMutMWs,
 		// for queries and mutations related to User/Group, dgraph handles Guardian auth,
 		// so no need to apply GuardianAuth Middleware
 		"addUser":     minimalAdminMutMWs,
@@ -728,20 +841,24 @@ func newAdminResolver(
 
 func newAdminResolverFactory() resolve.ResolverFactory {
 	adminMutationResolvers := map[string]resolve.MutationResolverFunc{
-		"addNamespace":    resolveAddNamespace,
-		"backup":          resolveBackup,
-		"config":          resolveUpdateConfig,
-		"deleteNamespace": resolveDeleteNamespace,
-		"draining":        resolveDraining,
-		"export":          resolveExport,
-		"login":           resolveLogin,
-		"resetPassword":   resolveResetPassword,
-		"restore":         resolveRestore,
-		"shutdown":        resolveShutdown,
-		"removeNode":      resolveRemoveNode,
-		"moveTablet":      resolveMoveTablet,
-		"assign":          resolveAssign,
-		"restoreTenant":   resolveTenantRestore,
+		"addNamespace":       resolveAddNamespace,
+		"backup":             resolveBackup,
+		"config":             resolveUpdateConfig,
+		"deleteNamespace":    resolveDeleteNamespace,
+		"draining":           resolveDraining,
+		"export":             resolveExport,
+		"login":              resolveLogin,
+		"resetPassword":      resolveResetPassword,
+		"restore":            resolveRestore,
+		"shutdown":           resolveShutdown,
+		"removeNode":         resolveRemoveNode,
+		"addLearner":         resolveAddLearner,
+		"removeLearner":      resolveRemoveLearner,
+		"moveTablet":         resolveMoveTablet,
+		"replayCDC":          resolveReplayCDC,
+		"compactVectorIndex": resolveCompactVectorIndex,
+		"assign":             resolveAssign,
+		"restoreTenant":      resolveTenantRestore,
 	}
 
 	rf := resolverFactoryWithErrorMsg(errResolverNotFound).
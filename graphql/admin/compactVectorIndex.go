@@ -0,0 +1,67 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/edgraph"
+	"github.com/hypermodeinc/dgraph/v25/graphql/resolve"
+	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+type compactVectorIndexInput struct {
+	Namespace uint64
+	Predicate string
+}
+
+func resolveCompactVectorIndex(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	input, err := getCompactVectorIndexInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	ctx = x.AttachNamespace(ctx, input.Namespace)
+	if err := edgraph.CompactVectorIndex(ctx, input.Predicate); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return resolve.DataResult(m,
+		map[string]interface{}{m.Name(): response("Success",
+			fmt.Sprintf("Compacting the vector index of %s", input.Predicate))},
+		nil,
+	), true
+}
+
+func getCompactVectorIndexInput(m schema.Mutation) (*compactVectorIndexInput, error) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return nil, inputArgError(errors.Errorf("can't convert input to map"))
+	}
+
+	inputRef := &compactVectorIndexInput{}
+	// namespace is an optional parameter
+	if _, ok = inputArg["namespace"]; !ok {
+		inputRef.Namespace = x.RootNamespace
+	} else {
+		ns, err := parseAsUint64(inputArg["namespace"])
+		if err != nil {
+			return nil, inputArgError(schema.GQLWrapf(err,
+				"can't convert input.namespace to uint64"))
+		}
+		inputRef.Namespace = ns
+	}
+
+	inputRef.Predicate, ok = inputArg["predicate"].(string)
+	if !ok {
+		return nil, inputArgError(errors.Errorf("can't convert input.predicate to string"))
+	}
+	return inputRef, nil
+}
@@ -26,6 +26,8 @@ const notSet = math.MaxInt64
 type exportInput struct {
 	Format    string
 	Namespace int64
+	// SinceTs is parsed separately because UInt64 values can be passed as strings.
+	SinceTs uint64 `json:"-"`
 	DestinationFields
 }
 
@@ -76,6 +78,7 @@ func resolveExport(ctx context.Context, m schema.Mutation) (*resolve.Resolved, b
 	req := &pb.ExportRequest{
 		Format:       format,
 		Namespace:    exportNs,
+		SinceTs:      input.SinceTs,
 		Destination:  input.Destination,
 		AccessKey:    input.AccessKey,
 		SecretKey:    input.SecretKey,
@@ -112,6 +115,13 @@ func getExportInput(m schema.Mutation) (*exportInput, error) {
 		if _, ok := v["namespace"]; !ok {
 			input.Namespace = notSet
 		}
+		if sinceTs, ok := v["sinceTs"]; ok && sinceTs != nil {
+			ts, perr := parseAsUint64(sinceTs)
+			if perr != nil {
+				return nil, schema.GQLWrapf(perr, "can't convert input.sinceTs to uint64")
+			}
+			input.SinceTs = ts
+		}
 	}
 	return &input, schema.GQLWrapf(err, "couldn't get input argument")
 }
@@ -0,0 +1,92 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/graphql/resolve"
+	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+)
+
+type addLearnerInput struct {
+	GroupId uint32
+	Addr    string
+	Zone    string
+}
+
+func resolveAddLearner(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	input, err := getAddLearnerInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	// gRPC call returns a nil status if the error is non-nil
+	status, err := worker.AddLearnerOverNetwork(ctx, &pb.AddLearnerRequest{
+		GroupId: input.GroupId,
+		Addr:    input.Addr,
+		Zone:    input.Zone,
+	})
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return resolve.DataResult(m,
+		map[string]interface{}{m.Name(): response("Success", status.GetMsg())},
+		nil,
+	), true
+}
+
+func resolveRemoveLearner(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	input, err := getRemoveNodeInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	if _, err = worker.RemoveNodeOverNetwork(ctx, &pb.RemoveNodeRequest{NodeId: input.NodeId,
+		GroupId: input.GroupId, Learner: true}); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return resolve.DataResult(m,
+		map[string]interface{}{m.Name(): response("Success",
+			fmt.Sprintf("Removed learner with group: %v, idx: %v", input.GroupId, input.NodeId))},
+		nil,
+	), true
+}
+
+func getAddLearnerInput(m schema.Mutation) (*addLearnerInput, error) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return nil, inputArgError(errors.Errorf("can't convert input to map"))
+	}
+
+	inputRef := &addLearnerInput{}
+	gId, err := parseAsUint32(inputArg["groupId"])
+	if err != nil {
+		return nil, inputArgError(schema.GQLWrapf(err, "can't convert input.groupId to uint32"))
+	}
+	inputRef.GroupId = gId
+
+	inputRef.Addr, ok = inputArg["addr"].(string)
+	if !ok {
+		return nil, inputArgError(errors.Errorf("can't convert input.addr to string"))
+	}
+
+	// zone is an optional parameter
+	if zone, has := inputArg["zone"]; has && zone != nil {
+		if inputRef.Zone, ok = zone.(string); !ok {
+			return nil, inputArgError(errors.Errorf("can't convert input.zone to string"))
+		}
+	}
+
+	return inputRef, nil
+}
@@ -0,0 +1,55 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/graphql/resolve"
+	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+)
+
+func resolveReplayCDC(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	sinceTs, err := getReplayCDCInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	sinceTs, err = worker.ReplayCDCOverNetwork(ctx, sinceTs)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	data := response("Success", fmt.Sprintf("Replaying the CDC events from %d", sinceTs))
+	data["sinceTs"] = json.Number(strconv.FormatUint(sinceTs, 10))
+	return resolve.DataResult(m,
+		map[string]interface{}{m.Name(): data},
+		nil,
+	), true
+}
+
+func getReplayCDCInput(m schema.Mutation) (uint64, error) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return 0, inputArgError(errors.Errorf("can't convert input to map"))
+	}
+
+	// sinceTs is an optional parameter
+	if v, ok := inputArg["sinceTs"]; ok && v != nil {
+		ts, err := parseAsUint64(v)
+		if err != nil {
+			return 0, inputArgError(schema.GQLWrapf(err, "can't convert input.sinceTs to uint64"))
+		}
+		return ts, nil
+	}
+	return 0, nil
+}
@@ -621,9 +621,9 @@ func rewriteAsGet(
 //			    var() {
 //			        v1 as max(val(vec))
 //			    }
-//			    var(func: similar_to(Product.embedding, 8, val(v1))) {
-//			        v2 as Product.embedding
-//			        distance as math((v2 - v1) dot (v2 - v1))
+//			    score as var(func: similar_to(Product.embedding, 8, val(v1)))
+//			    var(func: uid(score)) {
+//			        distance as math(score)
 //			    }
 //			    querySimilarProductById(func: uid(distance)
 //	             @filter(Product.id != "0528012398"), orderasc: val(distance)) {
@@ -645,16 +645,6 @@ func rewriteAsSimilarByIdQuery(
 	typ := query.Type()
 	similarBy := query.ArgValue(schema.SimilarByArgName).(string)
 	pred := typ.DgraphPredicate(similarBy)
-	topK := query.ArgValue(schema.SimilarTopKArgName)
-	similarByField := typ.Field(similarBy)
-	metric := similarByField.EmbeddingSearchMetric()
-	distanceFormula := "math(sqrt((v2 - v1) dot (v2 - v1)))" // default - euclidean
-
-	if metric == schema.SimilarSearchMetricDotProduct {
-		distanceFormula = "math((1.0 - (v1 dot v2)) /2.0)"
-	} else if metric == schema.SimilarSearchMetricCosine {
-		distanceFormula = "math((1.0 - ((v1 dot v2) / sqrt( (v1 dot v1) * (v2 dot v2) ) )) / 2.0)"
-	}
 
 	// First generate the query to fetch the uid
 	// for the given id. For Example,
@@ -691,40 +681,14 @@ func rewriteAsSimilarByIdQuery(
 		},
 	}
 
-	// Similar_to query, computes the distance for
-	// ordering the result later.
+	// Similar_to query, with the distance computed from the
+	// score of the search for ordering the result later.
 	// Example:
-	// var(func: similar_to(Product.embedding, 8, val(v1))) {
-	//	  v2 as Product.embedding
-	//	  distance as math((v2 - v1) dot (v2 - v1))
-	//  }
-	similarQuery := &dql.GraphQuery{
-		Attr: "var",
-		Children: []*dql.GraphQuery{
-			{
-				Var:  "v2",
-				Attr: pred,
-			},
-			{
-				Var:  "distance",
-				Attr: distanceFormula,
-			},
-		},
-		Func: &dql.Function{
-			Name: "similar_to",
-			Args: []dql.Arg{
-				{
-					Value: pred,
-				},
-				{
-					Value: fmt.Sprintf("%v", topK),
-				},
-				{
-					Value: "val(v1)",
-				},
-			},
-		},
-	}
+	// score as var(func: similar_to(Product.embedding, 8, val(v1)))
+	// var(func: uid(score)) {
+	//	  distance as math(score)
+	// }
+	similarQuery, distanceQuery := similarToQueries(query, pred, "val(v1)")
 
 	// Rename the distance as <Type>.vector_distance
 	distance := &dql.GraphQuery{
@@ -765,7 +729,7 @@ func rewriteAsSimilarByIdQuery(
 	}
 	addArgumentsToField(sortQuery, query)
 
-	dgQuery = append(dgQuery, aggQuery, similarQuery, sortQuery)
+	dgQuery = append(dgQuery, aggQuery, similarQuery, distanceQuery, sortQuery)
 	return dgQuery
 }
 
@@ -775,9 +739,9 @@ func rewriteAsSimilarByIdQuery(
 // Example rewrittern query:
 //
 //		query gQLTodQL($search_vector: float32vector = "<json array of float>") {
-//		    var(func: similar_to(Product.embedding, 8, $search_vector)) {
-//		        v2 as Product.embedding
-//		        distance as math((v2 - $search_vector) dot (v2 - $search_vector))
+//		    score as var(func: similar_to(Product.embedding, 8, $search_vector))
+//		    var(func: uid(score)) {
+//		        distance as math(score)
 //		    }
 //		    querySimilarProductById(func: uid(distance),
 //	             @filter(Product.id != "0528012398"), orderasc: val(distance)) {
@@ -802,21 +766,9 @@ func rewriteAsSimilarByEmbeddingQuery(
 	// Get all the arguments from graphQL query
 	similarBy := query.ArgValue(schema.SimilarByArgName).(string)
 	pred := typ.DgraphPredicate(similarBy)
-	topK := query.ArgValue(schema.SimilarTopKArgName)
 	vec := query.ArgValue(schema.SimilarVectorArgName).([]interface{})
 	vecStr, _ := json.Marshal(vec)
 
-	similarByField := typ.Field(similarBy)
-	metric := similarByField.EmbeddingSearchMetric()
-	distanceFormula := "math(sqrt((v2 - $search_vector) dot (v2 - $search_vector)))" // default = euclidean
-
-	if metric == schema.SimilarSearchMetricDotProduct {
-		distanceFormula = "math(( 1.0 - (($search_vector) dot v2)) /2.0)"
-	} else if metric == schema.SimilarSearchMetricCosine {
-		distanceFormula = "math((1.0 - ( (($search_vector) dot v2) / sqrt( (($search_vector) dot ($search_vector))" +
-			" * (v2 dot v2) ) )) / 2.0)"
-	}
-
 	// Save vectorString as a query variable, $search_vector
 	queryArgs := dgQuery[0].Args
 	if queryArgs == nil {
@@ -832,35 +784,14 @@ func rewriteAsSimilarByEmbeddingQuery(
 	addToFilterTree(dgQuery[0], thisFilter)
 
 	// Create similar_to as the root function, passing $search_vector as
-	// the search vector
-	dgQuery[0].Attr = "var"
-	dgQuery[0].Func = &dql.Function{
-		Name: "similar_to",
-		Args: []dql.Arg{
-			{
-				Value: pred,
-			},
-			{
-				Value: fmt.Sprintf("%v", topK),
-			},
-			{
-				Value: "$search_vector",
-			},
-		},
-	}
-
-	// Compute the euclidean distance between the neighbor
-	// and the search vector
-	dgQuery[0].Children = []*dql.GraphQuery{
-		{
-			Var:  "v2",
-			Attr: pred,
-		},
-		{
-			Var:  "distance",
-			Attr: distanceFormula,
-		},
-	}
+	// the search vector, and compute the distance of the neighbors from
+	// the score of the search
+	similarQuery, distanceQuery := similarToQueries(query, pred, "$search_vector")
+	dgQuery[0].Attr = similarQuery.Attr
+	dgQuery[0].Var = similarQuery.Var
+	dgQuery[0].Func = similarQuery.Func
+	dgQuery[0].Children = similarQuery.Children
+	dgQuery = append(dgQuery, distanceQuery)
 
 	// Rename distance as <Type>.vector_distance
 	distance := &dql.GraphQuery{
@@ -895,6 +826,59 @@ func rewriteAsSimilarByEmbeddingQuery(
 	return dgQuery
 }
 
+// similarToQueries returns the query block searching the neighbors of vec with
+// similar_to, and the block computing their distance from the score of the
+// search. The score is the euclidean distance for the euclidean metric, and
+// the similarity for cosine and dotproduct, which are turned into a distance
+// between 0 and 1. The threshold argument, the largest distance of the
+// neighbors to return, is turned into a threshold on the score. For example:
+//
+//	score as var(func: similar_to(Product.embedding, 8, val(v1), 0.6))
+//	var(func: uid(score)) {
+//	    distance as math((1.0 - score) / 2.0)
+//	}
+func similarToQueries(query schema.Query, pred, vec string) (*dql.GraphQuery, *dql.GraphQuery) {
+	similarBy := query.ArgValue(schema.SimilarByArgName).(string)
+	metric := query.Type().Field(similarBy).EmbeddingSearchMetric()
+	topK := query.ArgValue(schema.SimilarTopKArgName)
+
+	distanceFormula := "math(score)" // default - euclidean
+	similarity := metric == schema.SimilarSearchMetricDotProduct ||
+		metric == schema.SimilarSearchMetricCosine
+	if similarity {
+		distanceFormula = "math((1.0 - score) / 2.0)"
+	}
+
+	args := []dql.Arg{{Value: pred}, {Value: fmt.Sprintf("%v", topK)}, {Value: vec}}
+	if threshold := query.ArgValue(schema.SimilarThresholdArgName); threshold != nil {
+		t, err := strconv.ParseFloat(fmt.Sprintf("%v", threshold), 64)
+		if err == nil {
+			if similarity {
+				t = 1 - 2*t
+			}
+			args = append(args, dql.Arg{Value: strconv.FormatFloat(t, 'f', -1, 64)})
+		}
+	}
+
+	similarQuery := &dql.GraphQuery{
+		Var:  "score",
+		Attr: "var",
+		Func: &dql.Function{Name: "similar_to", Args: args},
+	}
+	distanceQuery := &dql.GraphQuery{
+		Attr: "var",
+		Children: []*dql.GraphQuery{{
+			Var:  "distance",
+			Attr: distanceFormula,
+		}},
+		Func: &dql.Function{
+			Name: "uid",
+			Args: []dql.Arg{{Value: "score"}},
+		},
+	}
+	return similarQuery, distanceQuery
+}
+
 // Adds common RBAC and UID, Type rules to DQL query.
 // This function is used by rewriteAsQuery and aggregateQuery functions
 func addCommonRules(
@@ -27,6 +27,7 @@ const (
 	dgraphTypeArg      = "type"
 	dgraphPredArg      = "pred"
 	embeddingDirective = "embedding"
+	embeddingFromArg   = "from"
 
 	idDirective             = "id"
 	idDirectiveInterfaceArg = "interface"
@@ -272,7 +273,7 @@ input GenerateMutationParams {
 	directiveDefs = `
 directive @hasInverse(field: String!) on FIELD_DEFINITION
 directive @search(by: [String!]) on FIELD_DEFINITION
-directive @embedding on FIELD_DEFINITION
+directive @embedding(from: String) on FIELD_DEFINITION
 directive @dgraph(type: String, pred: String) on OBJECT | INTERFACE | FIELD_DEFINITION
 directive @id(interface: Boolean) on FIELD_DEFINITION
 directive @default(add: DgraphDefault, update: DgraphDefault) on FIELD_DEFINITION
@@ -305,7 +306,7 @@ directive @generate(
 	apolloSupportedDirectiveDefs = `
 directive @hasInverse(field: String!) on FIELD_DEFINITION
 directive @search(by: [String!]) on FIELD_DEFINITION
-directive @embedding on FIELD_DEFINITION
+directive @embedding(from: String) on FIELD_DEFINITION
 directive @dgraph(type: String, pred: String) on OBJECT | INTERFACE | FIELD_DEFINITION
 directive @id(interface: Boolean) on FIELD_DEFINITION
 directive @default(add: DgraphDefault, update: DgraphDefault) on FIELD_DEFINITION
@@ -2081,6 +2082,9 @@ func addSimilarByEmbeddingQuery(schema *ast.Schema, defn *ast.Definition) {
 			NonNull: true,
 		},
 	})
+
+	// Accept the largest vector_distance of the neighbors to return
+	addSimilarThresholdArgument(qry)
 	addFilterArgument(schema, qry)
 
 	schema.Query.Fields = append(schema.Query.Fields, qry)
@@ -2190,10 +2194,21 @@ func addSimilarByIdQuery(schema *ast.Schema, defn *ast.Definition,
 		},
 	})
 
+	// Accept the largest vector_distance of the neighbors to return
+	addSimilarThresholdArgument(qry)
 	addFilterArgument(schema, qry)
 	schema.Query.Fields = append(schema.Query.Fields, qry)
 }
 
+// addSimilarThresholdArgument adds the optional threshold argument of the similarity queries,
+// which leaves out the neighbors farther than it from the searched vector.
+func addSimilarThresholdArgument(qry *ast.FieldDefinition) {
+	qry.Arguments = append(qry.Arguments, &ast.ArgumentDefinition{
+		Name: SimilarThresholdArgName,
+		Type: &ast.Type{NamedType: "Float"},
+	})
+}
+
 func addFilterQuery(
 	schema *ast.Schema,
 	defn *ast.Definition,
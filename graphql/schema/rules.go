@@ -835,6 +835,17 @@ func embeddingValidation(sch *ast.Schema, typ *ast.Definition,
 					" to fields of type [Float!].", typ.Name, field.Name, field.Type.Name()))
 	}
 
+	if fromArg := dir.Arguments.ForName(embeddingFromArg); fromArg != nil {
+		from := typ.Fields.ForName(fromArg.Value.Raw)
+		if from == nil || from.Type.Elem != nil || from.Type.Name() != "String" {
+			errs = append(errs,
+				gqlerror.ErrorPosf(
+					dir.Position,
+					"Type %[1]s; Field %[2]s: @embedding can only be computed from a String field "+
+						"of type %[1]s, but got %[3]s.", typ.Name, field.Name, fromArg.Value.Raw))
+		}
+	}
+
 	return errs
 }
 
@@ -518,6 +518,9 @@ func genDgSchema(gqlSch *ast.Schema, definitions []string,
 		upsert  string
 		reverse string
 		lang    bool
+
+		// embedding is the @embedding directive of a vector predicate computed from another one.
+		embedding string
 	}
 
 	type field struct {
@@ -663,10 +666,15 @@ func genDgSchema(gqlSch *ast.Schema, definitions []string,
 					}
 
 					embedding := f.Directives.ForName(embeddingDirective)
+					embeddingStr := ""
 					if embedding != nil {
 						// embeddingValidation ensured GQL type is [Float]
 						// set typStr to float32vector
 						typStr = "float32vector"
+						if from := embedding.Arguments.ForName(embeddingFromArg); from != nil {
+							embeddingStr = fmt.Sprintf("@embedding(%s) ",
+								fieldName(def.Fields.ForName(from.Value.Raw), typName))
+						}
 					}
 
 					if parentInt == nil {
@@ -676,7 +684,9 @@ func genDgSchema(gqlSch *ast.Schema, definitions []string,
 							fname = strings.Split(fname, "@")[0]
 							isLang = true
 						}
-						dgPreds[fname] = getUpdatedPred(fname, typStr, upsertStr, indexes, isLang)
+						pred := getUpdatedPred(fname, typStr, upsertStr, indexes, isLang)
+						pred.embedding = embeddingStr
+						dgPreds[fname] = pred
 					}
 					typ.fields = append(typ.fields, field{fname, parentInt != nil})
 				case ast.Enum:
@@ -740,7 +750,8 @@ func genDgSchema(gqlSch *ast.Schema, definitions []string,
 				if f.lang {
 					langStr = " @lang"
 				}
-				fmt.Fprintf(&preds, "%s: %s%s%s %s%s.\n", fld.name, f.typ, indexStr, langStr, f.upsert, f.reverse)
+				fmt.Fprintf(&preds, "%s: %s%s%s %s%s%s.\n", fld.name, f.typ, indexStr, langStr, f.upsert,
+					f.embedding, f.reverse)
 				predWritten[fld.name] = true
 			}
 		}
@@ -99,6 +99,7 @@ const (
 	SimilarByArgName                           = "by"
 	SimilarTopKArgName                         = "topK"
 	SimilarVectorArgName                       = "vector"
+	SimilarThresholdArgName                    = "threshold"
 	EmbeddingEnumSuffix                        = "Embedding"
 	SimilarQueryPrefix                         = "querySimilar"
 	SimilarByIdQuerySuffix                     = "ById"
@@ -1388,7 +1389,8 @@ func (f *field) IDArgValue() (xids map[string]string, uid uint64, err error) {
 		if (idField == nil || arg.Name != idField.Name()) &&
 			(passwordField == nil || arg.Name != passwordField.Name()) &&
 			(queryType(f.field.Name, nil) != SimilarByIdQuery ||
-				(arg.Name != SimilarTopKArgName && arg.Name != SimilarByArgName && arg.Name != "filter")) {
+				(arg.Name != SimilarTopKArgName && arg.Name != SimilarByArgName &&
+					arg.Name != SimilarThresholdArgName && arg.Name != "filter")) {
 			xidArgName = arg.Name
 		}
 
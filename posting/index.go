@@ -10,15 +10,16 @@ import (
 	"context"
 	"encoding/binary"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"math"
+	"math/rand"
 	"os"
 	"strings"
 	"sync/atomic"
 	"time"
 	"unsafe"
 
+	"github.com/dgryski/go-farm"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	ostats "go.opencensus.io/stats"
@@ -33,6 +34,7 @@ import (
 	"github.com/hypermodeinc/dgraph/v25/schema"
 	"github.com/hypermodeinc/dgraph/v25/tok"
 	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/tok/index"
 	"github.com/hypermodeinc/dgraph/v25/types"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
@@ -101,6 +103,10 @@ func (txn *Txn) addIndexMutations(ctx context.Context, info *indexMutationInfo)
 	}
 
 	if len(info.factorySpecs) > 0 {
+		if info.val.Tid.IsVector() && schema.State().IsList(attr) {
+			return []*pb.DirectedEdge{}, txn.addVectorChunkMutations(ctx, info)
+		}
+
 		inKey := x.DataKey(info.edge.Attr, uid)
 		pl, err := txn.Get(inKey)
 		if err != nil {
@@ -111,45 +117,31 @@ func (txn *Txn) addIndexMutations(ctx context.Context, info *indexMutationInfo)
 			return []*pb.DirectedEdge{}, err
 		}
 
-		if info.op == pb.DirectedEdge_DEL &&
-			len(data) > 0 && data[0].Tid == types.VFloatID {
-			// TODO look into better alternatives
-			//      The issue here is that we will create dead nodes in the Vector Index
-			//      assuming an HNSW index type. What we should do instead is invoke
-			//      index.Remove(<key to dead index value>). However, we currently do
-			//      not support this in VectorIndex code!!
-			// if a delete & dealing with vfloats, add this to dead node in persistent store.
-			// What we should do instead is invoke the factory.Remove(key) operation.
-			deadAttr := hnsw.ConcatStrings(info.edge.Attr, hnsw.VecDead)
-			deadKey := x.DataKey(deadAttr, 1)
-			pl, err := txn.Get(deadKey)
+		// The value being deleted is in info.val, the data of the posting list already
+		// reflects the mutation.
+		if info.op == pb.DirectedEdge_DEL && info.val.Tid.IsVector() {
+			data, ok := info.val.Value.([]byte)
+			if !ok {
+				return []*pb.DirectedEdge{}, errors.Errorf("invalid vector for uid %#x", uid)
+			}
+			oldVec, err := types.BytesAsVector(info.val.Tid, data)
 			if err != nil {
 				return []*pb.DirectedEdge{}, err
 			}
-			var deadNodes []uint64
-			deadData, _ := pl.Value(txn.StartTs)
-			if deadData.Value == nil {
-				deadNodes = append(deadNodes, uid)
-			} else {
-				deadNodes, err = hnsw.ParseEdges(string(deadData.Value.([]byte)))
-				if err != nil {
-					return []*pb.DirectedEdge{}, err
-				}
-				deadNodes = append(deadNodes, uid)
-			}
-			deadNodesBytes, marshalErr := json.Marshal(deadNodes)
-			if marshalErr != nil {
-				return []*pb.DirectedEdge{}, marshalErr
+			tc := hnsw.NewTxnCache(NewViTxn(txn), txn.StartTs)
+			indexer, err := info.factorySpecs[0].CreateIndex(attr)
+			if err != nil {
+				return []*pb.DirectedEdge{}, err
 			}
-			edge := &pb.DirectedEdge{
-				Entity:    1,
-				Attr:      deadAttr,
-				Value:     deadNodesBytes,
-				ValueType: pb.Posting_ValType(0),
+			edges, err := indexer.Remove(ctx, tc, uid, oldVec)
+			if err != nil {
+				return []*pb.DirectedEdge{}, err
 			}
-			if err := pl.addMutation(ctx, txn, edge); err != nil {
-				return nil, err
+			pbEdges := []*pb.DirectedEdge{}
+			for _, e := range edges {
+				pbEdges = append(pbEdges, indexEdgeToPbEdge(e))
 			}
+			return pbEdges, nil
 		}
 
 		// TODO: As stated earlier, we need to validate that it is okay to assume
@@ -157,10 +149,13 @@ func (txn *Txn) addIndexMutations(ctx context.Context, info *indexMutationInfo)
 		//       Similarly, the current assumption is that we have at most one
 		//       Vector Index, but this assumption may break later.
 		if info.op != pb.DirectedEdge_DEL &&
-			len(data) > 0 && data[0].Tid == types.VFloatID &&
+			len(data) > 0 && data[0].Tid.IsVector() &&
 			len(info.factorySpecs) > 0 {
 			// retrieve vector from inUuid save as inVec
-			inVec := types.BytesAsFloatArray(data[0].Value.([]byte))
+			inVec, err := types.BytesAsVector(data[0].Tid, data[0].Value.([]byte))
+			if err != nil {
+				return []*pb.DirectedEdge{}, err
+			}
 			tc := hnsw.NewTxnCache(NewViTxn(txn), txn.StartTs)
 			indexer, err := info.factorySpecs[0].CreateIndex(attr)
 			if err != nil {
@@ -192,14 +187,243 @@ func (txn *Txn) addIndexMutations(ctx context.Context, info *indexMutationInfo)
 		Op:      info.op,
 	}
 
+	// The length of a value indexed with bm25 is the sum of the frequencies of its terms.
+	var bm25Length uint32
 	for _, token := range tokens {
 		if err := txn.addIndexMutation(ctx, edge, token); err != nil {
 			return []*pb.DirectedEdge{}, err
 		}
+		if token[0] == tok.IdentBM25 {
+			count, _ := tok.BM25Count(token)
+			bm25Length += count
+		}
+	}
+	if bm25Length > 0 {
+		if err := txn.updateBM25Lengths(ctx, attr, uid, bm25Length, info.op); err != nil {
+			return []*pb.DirectedEdge{}, err
+		}
 	}
 	return []*pb.DirectedEdge{}, nil
 }
 
+// updateBM25Lengths adds length to, or removes it from, the length of the values of uid, and
+// updates the number of documents and their total length kept by the bm25 index of attr, in the
+// shard of uid. The values are set with the attr__bm25 attribute, which has no schema, so that
+// every key holds a single value and concurrent updates of a key conflict. Only the updates of
+// the documents of the same shard conflict on the stats.
+func (txn *Txn) updateBM25Lengths(ctx context.Context, attr string, uid uint64, length uint32,
+	op pb.DirectedEdge_Op) error {
+
+	edgeAttr := attr + "__bm25"
+	update := func(key []byte, fn func(old []byte) ([]byte, error)) error {
+		pl, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+		// The lists are shared by the goroutines rebuilding the index.
+		pl.Lock()
+		defer pl.Unlock()
+		var old []byte
+		val, err := pl.ValueWithLockHeld(txn.StartTs)
+		switch {
+		case errors.Is(err, ErrNoValue):
+		case err != nil:
+			return err
+		default:
+			old = val.Value.([]byte)
+		}
+		value, err := fn(old)
+		if err != nil {
+			return err
+		}
+		edge := &pb.DirectedEdge{Attr: edgeAttr, Value: value, ValueType: pb.Posting_BINARY,
+			Op: pb.DirectedEdge_SET}
+		if value == nil {
+			edge.Op, edge.Value = pb.DirectedEdge_DEL, []byte(x.Star)
+		}
+		return pl.addMutationInternal(ctx, txn, edge)
+	}
+
+	var before, after uint32
+	err := update(x.IndexKey(attr, tok.BM25LengthToken(uid)), func(old []byte) ([]byte, error) {
+		if old != nil {
+			var err error
+			if before, err = tok.UnmarshalBM25Length(old); err != nil {
+				return nil, err
+			}
+		}
+		switch {
+		case op != pb.DirectedEdge_DEL:
+			after = before + length
+		case before > length:
+			after = before - length
+		}
+		if after == 0 {
+			return nil, nil
+		}
+		return tok.MarshalBM25Length(after), nil
+	})
+	if err != nil {
+		return err
+	}
+	statsKey := x.IndexKey(attr, tok.BM25StatsToken(tok.BM25StatsShard(uid)))
+	return update(statsKey, func(old []byte) ([]byte, error) {
+		var stats tok.BM25Stats
+		if old != nil {
+			var err error
+			if stats, err = tok.UnmarshalBM25Stats(old); err != nil {
+				return nil, err
+			}
+		}
+		switch {
+		case before == 0 && after > 0:
+			stats.N++
+		case before > 0 && after == 0 && stats.N > 0:
+			stats.N--
+		}
+		stats.Total = stats.Total - min(stats.Total, uint64(before)) + uint64(after)
+		return stats.Marshal(), nil
+	})
+}
+
+// rebuiltVectorChunk returns the chunk uid of the vector vec in the list of vectors of uid, when
+// the vector index is rebuilt. The rebuild runs on every replica, so the uid can't be leased from
+// Zero: it is derived from the node and the vector instead, with its top bit set to keep it apart
+// from the leased uids. The uids taken by the chunks of other vectors are skipped.
+func (txn *Txn) rebuiltVectorChunk(attr string, uid uint64, vec []byte) (uint64, error) {
+	ownerAttr := hnsw.ConcatStrings(attr, hnsw.VecChunkOwner)
+	buf := make([]byte, 16+len(vec))
+	binary.BigEndian.PutUint64(buf, uid)
+	copy(buf[16:], vec)
+	for seed := uint64(0); ; seed++ {
+		binary.BigEndian.PutUint64(buf[8:], seed)
+		chunk := farm.Fingerprint64(buf) | 1<<63
+		pl, err := txn.Get(x.DataKey(ownerAttr, chunk))
+		if err != nil {
+			return 0, err
+		}
+		switch _, err := pl.Value(txn.StartTs); {
+		case errors.Is(err, ErrNoValue):
+			return chunk, nil
+		case err != nil:
+			return 0, err
+		}
+	}
+}
+
+// vectorChunk returns the chunk holding the vector vec, encoded as float32 values, in the list of
+// vectors of uid, or 0 if the vector has no chunk.
+func (txn *Txn) vectorChunk(attr string, uid uint64, vec []byte) (uint64, error) {
+	pl, err := txn.Get(x.DataKey(hnsw.ConcatStrings(attr, hnsw.VecChunks), uid))
+	if err != nil {
+		return 0, err
+	}
+	chunks, err := pl.Uids(ListOptions{ReadTs: txn.StartTs})
+	if err != nil {
+		return 0, err
+	}
+	chunkAttr := hnsw.ConcatStrings(attr, hnsw.VecChunk)
+	for _, chunk := range chunks.Uids {
+		cl, err := txn.Get(x.DataKey(chunkAttr, chunk))
+		if err != nil {
+			return 0, err
+		}
+		val, err := cl.Value(txn.StartTs)
+		switch {
+		case errors.Is(err, ErrNoValue):
+			continue
+		case err != nil:
+			return 0, err
+		}
+		if stored, ok := val.Value.([]byte); ok && bytes.Equal(stored, vec) {
+			return chunk, nil
+		}
+	}
+	return 0, nil
+}
+
+// addVectorChunkMutations maintains the vector index of a list of vectors, as the value info.val
+// is added to or deleted from the list of info.edge.Entity. The vectors of the list are indexed
+// as chunks of their node: each one is stored as a float32 vector in the attr__vector_chunk
+// predicate, under the uid leased for it in info.edge.VectorChunk, its node is stored as an int
+// value under the same uid in attr__vector_chunk_owner, and the uid is added to the
+// attr__vector_chunks list of the node.
+func (txn *Txn) addVectorChunkMutations(ctx context.Context, info *indexMutationInfo) error {
+	attr, uid := info.edge.Attr, info.edge.Entity
+	data, ok := info.val.Value.([]byte)
+	if !ok {
+		return errors.Errorf("invalid vector for uid %#x", uid)
+	}
+	vec, err := types.BytesAsVector(info.val.Tid, data)
+	if err != nil {
+		return err
+	}
+	vecBytes := types.FloatArrayAsBytes(vec)
+	chunk, err := txn.vectorChunk(attr, uid, vecBytes)
+	if err != nil {
+		return err
+	}
+	switch {
+	case info.op == pb.DirectedEdge_DEL && chunk == 0:
+		// The vector was never indexed.
+		return nil
+	case info.op != pb.DirectedEdge_DEL && chunk != 0:
+		// The vector is already in the list, and indexed.
+		return nil
+	case chunk == 0:
+		chunk = info.edge.VectorChunk
+	}
+	if chunk == 0 {
+		// The chunk uids are given before the mutation is proposed, or by the rebuild, so
+		// that every replica stores the vector under the same uid.
+		return errors.Errorf("no chunk uid given to the vector of uid %#x", uid)
+	}
+
+	chunkAttr := hnsw.ConcatStrings(attr, hnsw.VecChunk)
+	indexer, err := info.factorySpecs[0].CreateIndex(chunkAttr)
+	if err != nil {
+		return err
+	}
+	tc := hnsw.NewTxnCache(NewViTxn(txn), txn.StartTs)
+
+	owner := make([]byte, 8)
+	binary.LittleEndian.PutUint64(owner, uid)
+	chunkEdges := []*pb.DirectedEdge{
+		{Entity: chunk, Attr: chunkAttr, Value: vecBytes, ValueType: pb.Posting_VFLOAT,
+			Op: info.op},
+		{Entity: chunk, Attr: hnsw.ConcatStrings(attr, hnsw.VecChunkOwner), Value: owner,
+			ValueType: pb.Posting_INT, Op: info.op},
+		{Entity: uid, Attr: hnsw.ConcatStrings(attr, hnsw.VecChunks), ValueId: chunk,
+			ValueType: pb.Posting_UID, Op: info.op},
+	}
+	addChunk := func() error {
+		for _, edge := range chunkEdges {
+			pl, err := txn.Get(x.DataKey(edge.Attr, edge.Entity))
+			if err != nil {
+				return err
+			}
+			if err := pl.addMutation(ctx, txn, edge); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// The index reads the vector of the chunk when it is inserted, and may still read it while
+	// it is removed.
+	if info.op == pb.DirectedEdge_DEL {
+		if _, err := indexer.Remove(ctx, tc, chunk, vec); err != nil {
+			return err
+		}
+		return addChunk()
+	}
+	if err := addChunk(); err != nil {
+		return err
+	}
+	_, err = indexer.Insert(ctx, tc, chunk, vec)
+	return err
+}
+
 func (txn *Txn) addIndexMutation(ctx context.Context, edge *pb.DirectedEdge, token string) error {
 	key := x.IndexKey(edge.Attr, token)
 	plist, err := txn.cache.GetFromDelta(key)
@@ -678,6 +902,8 @@ type rebuilder struct {
 	// The posting list passed here is the on disk version. It is not coming
 	// from the LRU cache.
 	fn func(uid uint64, pl *List, txn *Txn) ([]*pb.DirectedEdge, error)
+	// prepare, if set, is called by RunWithoutTemp with its txn before fn is.
+	prepare func(txn *Txn) error
 }
 
 func (r *rebuilder) RunWithoutTemp(ctx context.Context) error {
@@ -687,6 +913,11 @@ func (r *rebuilder) RunWithoutTemp(ctx context.Context) error {
 	stream.Prefix = r.prefix
 	stream.NumGo = 16
 	txn := NewTxn(r.startTs)
+	if r.prepare != nil {
+		if err := r.prepare(txn); err != nil {
+			return err
+		}
+	}
 	stream.KeyToList = func(key []byte, it *badger.Iterator) (*bpb.KVList, error) {
 		// We should return quickly if the context is no longer valid.
 		select {
@@ -925,7 +1156,7 @@ func (r *rebuilder) Run(ctx context.Context) error {
 	stream.LogPrefix = fmt.Sprintf("Rebuilding index for predicate %s (1/2):", r.attr)
 	stream.Prefix = r.prefix
 	//TODO We need to create a single transaction irrespective of the type of the predicate
-	if pred.ValueType == pb.Posting_VFLOAT {
+	if types.TypeID(pred.ValueType).IsVector() {
 		x.AssertTrue(false)
 	}
 	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
@@ -1421,6 +1652,60 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 		// 1. This can be a schema mutation where the user adds a index on existing vectors.
 		// 2. This can be a vector mutation where the user adds vectors to the DB on a
 		// predicate that is already indexed.
+		if runForVectors && rb.CurrentSchema.List {
+			// Each vector of a list is indexed as a chunk of its node, under a uid derived
+			// from the node and the vector.
+			vecType := types.TypeID(rb.CurrentSchema.ValueType)
+			var vals []types.Val
+			err := pl.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
+				val := valueToTypesVal(p)
+				if val.Tid != vecType {
+					// The list values have the fingerprint of their value, so the converted
+					// vector replaces the value instead of overwriting it.
+					sv, err := types.Convert(val, vecType)
+					if err != nil {
+						return err
+					}
+					b := types.ValueForType(types.BinaryID)
+					if err = types.Marshal(sv, &b); err != nil {
+						return err
+					}
+					inList, err := txn.Get(x.DataKey(rb.Attr, uid))
+					if err != nil {
+						return err
+					}
+					del := &pb.DirectedEdge{Attr: rb.Attr, Entity: uid, Value: p.Value,
+						ValueType: pb.Posting_ValType(p.ValType), Op: pb.DirectedEdge_DEL}
+					if err := inList.addMutation(ctx, txn, del); err != nil {
+						return err
+					}
+					val = types.Val{Tid: vecType, Value: b.Value.([]byte)}
+					set := &pb.DirectedEdge{Attr: rb.Attr, Entity: uid, Value: b.Value.([]byte),
+						ValueType: vecType.Enum(), Op: pb.DirectedEdge_SET}
+					if err := inList.addMutation(ctx, txn, set); err != nil {
+						return err
+					}
+				}
+				vals = append(vals, val)
+				return nil
+			})
+			if err != nil || len(vals) == 0 {
+				return edges, err
+			}
+			for _, val := range vals {
+				data, ok := val.Value.([]byte)
+				if !ok {
+					return edges, errors.Errorf("invalid vector for uid %#x", uid)
+				}
+				if edge.VectorChunk, err = txn.rebuiltVectorChunk(rb.Attr, uid, data); err != nil {
+					return edges, err
+				}
+				if _, err := processAddIndexMutation(&edge, val); err != nil {
+					return edges, err
+				}
+			}
+			return edges, nil
+		}
 		if runForVectors {
 			val, err := pl.Value(txn.StartTs)
 			if err != nil {
@@ -1428,10 +1713,11 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 			}
 
 			// In the first case, val.Tid is default, so we need to convert the
-			// vector into the vfloat type and re-add it to the DB.
-			if val.Tid != types.VFloatID {
-				// Here, we convert the defaultID type vector into vfloat.
-				sv, err := types.Convert(val, types.VFloatID)
+			// vector into the vector type of the predicate and re-add it to the DB.
+			vecType := types.TypeID(rb.CurrentSchema.ValueType)
+			if val.Tid != vecType {
+				// Here, we convert the defaultID type vector into the vector type.
+				sv, err := types.Convert(val, vecType)
 				if err != nil {
 					return []*pb.DirectedEdge{}, err
 				}
@@ -1440,7 +1726,7 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 					return []*pb.DirectedEdge{}, err
 				}
 				edge.Value = b.Value.([]byte)
-				edge.ValueType = types.VFloatID.Enum()
+				edge.ValueType = vecType.Enum()
 
 				inKey := x.DataKey(edge.Attr, uid)
 				p, err := txn.Get(inKey)
@@ -1484,11 +1770,89 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 	}
 
 	if runForVectors {
+		builder.prepare = func(txn *Txn) error {
+			return trainVectorIndex(ctx, rb, factorySpecs[0], txn)
+		}
 		return builder.RunWithoutTemp(ctx)
 	}
 	return builder.Run(ctx)
 }
 
+// trainVectorIndex trains the vector index of rb.Attr on a sample of its vectors, if the index
+// is trained on the vectors it indexes. The sample is drawn with a generator of fixed seed while
+// going through the keys in order, so that every replica trains its index the same way.
+func trainVectorIndex(ctx context.Context, rb *IndexRebuild, spec *tok.FactoryCreateSpec,
+	txn *Txn) error {
+	name := rb.Attr
+	if rb.CurrentSchema.List {
+		name = hnsw.ConcatStrings(rb.Attr, hnsw.VecChunk)
+	}
+	indexer, err := spec.CreateIndex(name)
+	if err != nil {
+		return err
+	}
+	trainer, ok := indexer.(index.Trainer[float32])
+	if !ok {
+		return nil
+	}
+
+	// Reservoir sampling keeps every vector with the same probability.
+	size := trainer.SampleSize()
+	rng := rand.New(rand.NewSource(1))
+	var sample [][]float32
+	var seen int
+	vecType := types.TypeID(rb.CurrentSchema.ValueType)
+	pk := x.ParsedKey{Attr: rb.Attr}
+	err = MemLayerInstance.IterateDisk(ctx, IterateDiskArgs{
+		Prefix:         pk.DataPrefix(),
+		ReadTs:         rb.StartTs,
+		AllVersions:    true,
+		CheckInclusion: func(uint64) error { return nil },
+		Function: func(l *List, _ x.ParsedKey) error {
+			return l.Iterate(rb.StartTs, 0, func(p *pb.Posting) error {
+				vec, err := vectorOf(valueToTypesVal(p), vecType)
+				if err != nil {
+					return err
+				}
+				seen++
+				switch {
+				case len(sample) < size:
+					sample = append(sample, vec)
+				case rng.Intn(seen) < size:
+					sample[rng.Intn(size)] = vec
+				}
+				return nil
+			})
+		},
+	})
+	if err != nil || len(sample) == 0 {
+		return err
+	}
+	glog.Infof("Training the vector index of %s on %d vectors", rb.Attr, len(sample))
+	_, err = trainer.Train(ctx, hnsw.NewTxnCache(NewViTxn(txn), txn.StartTs), sample)
+	return err
+}
+
+// vectorOf returns the vector held by val, converted to the vector type tid.
+func vectorOf(val types.Val, tid types.TypeID) ([]float32, error) {
+	if val.Tid != tid {
+		sv, err := types.Convert(val, tid)
+		if err != nil {
+			return nil, err
+		}
+		b := types.ValueForType(types.BinaryID)
+		if err := types.Marshal(sv, &b); err != nil {
+			return nil, err
+		}
+		val = types.Val{Tid: tid, Value: b.Value}
+	}
+	data, ok := val.Value.([]byte)
+	if !ok {
+		return nil, errors.Errorf("invalid vector of type %s", tid.Name())
+	}
+	return types.BytesAsVector(tid, data)
+}
+
 func (rb *IndexRebuild) needsCountIndexRebuild() indexOp {
 	x.AssertTruef(rb.CurrentSchema != nil, "Current schema cannot be nil.")
 
@@ -1599,9 +1963,9 @@ func (rb *IndexRebuild) needsVectorIndexEdgesRebuild() indexOp {
 	}
 
 	currIndex := rb.CurrentSchema.Directive == pb.SchemaUpdate_INDEX &&
-		rb.CurrentSchema.ValueType == pb.Posting_VFLOAT
+		types.TypeID(rb.CurrentSchema.ValueType).IsVector()
 	prevIndex := old.Directive == pb.SchemaUpdate_INDEX &&
-		old.ValueType == pb.Posting_VFLOAT
+		types.TypeID(old.ValueType).IsVector()
 
 	prevFactoryNames := make(map[string]struct{})
 	prevFactories := make(map[string]*pb.VectorIndexSpec)
@@ -1648,9 +2012,12 @@ func prefixesToDropVectorIndexEdges(ctx context.Context, rb *IndexRebuild) [][]b
 		return nil
 	}
 
-	prefixes := append([][]byte{}, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecEntry)))
-	prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecDead)))
-	prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecKeyword)))
+	// The chunks of a list of vectors are dropped too, they are stored again when the
+	// index is rebuilt.
+	var prefixes [][]byte
+	for _, pred := range hnsw.SupportingPredicates(rb.Attr, true) {
+		prefixes = append(prefixes, x.PredicatePrefix(pred))
+	}
 
 	for i := range hnsw.VectorIndexMaxLevels {
 		prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecKeyword, fmt.Sprint(i))))
@@ -1848,6 +2215,62 @@ func DeletePredicate(ctx context.Context, attr string, ts uint64) error {
 	return nil
 }
 
+// DeletePredicateUids deletes the data of attr for the uids that keep returns false for, by
+// writing empty posting lists at ts. The indexes of attr are left as they are.
+func DeletePredicateUids(ctx context.Context, attr string, ts uint64, keep func(uint64) bool) error {
+	glog.Infof("Dropping the data of a part of predicate: [%s]", attr)
+	txn := pstore.NewTransactionAt(ts, false)
+	defer txn.Discard()
+
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.PrefetchValues = false
+	iterOpts.Prefix = x.ParsedKey{Attr: attr}.DataPrefix()
+	itr := txn.NewIterator(iterOpts)
+	defer itr.Close()
+
+	writer := pstore.NewManagedWriteBatch()
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		pk, err := x.Parse(item.Key())
+		if err == nil {
+			err = ctx.Err()
+		}
+		if err != nil {
+			writer.Cancel()
+			return err
+		}
+		if item.IsDeletedOrExpired() || pk.HasStartUid || keep(pk.Uid) {
+			continue
+		}
+		e := &badger.Entry{Key: item.KeyCopy(nil), UserMeta: BitEmptyPosting}
+		if err := writer.SetEntryAt(e, ts); err != nil {
+			writer.Cancel()
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	ResetCache()
+	return nil
+}
+
+// RebuildIndexes drops the index, reverse and count keys of attr and builds them again at ts
+// from the data of attr.
+func RebuildIndexes(ctx context.Context, attr string, ts uint64) error {
+	su, ok := schema.State().Get(ctx, attr)
+	if !ok {
+		return nil
+	}
+	// Without an old schema, all the indexes of the current schema are dropped and built again.
+	rb := &IndexRebuild{Attr: attr, StartTs: ts, CurrentSchema: &su}
+	if err := rb.DropIndexes(ctx); err != nil {
+		return err
+	}
+	ResetCache()
+	return rb.BuildIndexes(ctx)
+}
+
 // DeleteNamespace bans the namespace and deletes its predicates/types from the schema.
 func DeleteNamespace(ns uint64) error {
 	// TODO: We should only delete cache for certain keys, not all the keys.
@@ -8,15 +8,20 @@ package posting
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"math"
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dgraph-io/badger/v4"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
 	"github.com/hypermodeinc/dgraph/v25/types"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
@@ -382,6 +387,58 @@ func TestRebuildTokIndex(t *testing.T) {
 	require.EqualValues(t, 91, uids2[0])
 }
 
+func TestRebuildTrainsVectorIndex(t *testing.T) {
+	require.NoError(t, pstore.DropAll())
+	MemLayerInstance.clear()
+	attr := x.AttrInRootNamespace("ivec")
+	require.NoError(t, schema.ParseBytes([]byte(`ivec: float32vector .`), 1))
+	// The first vectors are all close to (0, 0), they would make poor centroids.
+	for i := range 20 {
+		center := float32(10 * (i / 10))
+		vec := types.FloatArrayAsBytes([]float32{center + float32(i%10)/100, center})
+		l, err := GetNoStore(x.DataKey(attr, uint64(i+1)), uint64(2*i+1))
+		require.NoError(t, err)
+		edge := &pb.DirectedEdge{Attr: attr, Entity: uint64(i + 1), Value: vec,
+			ValueType: pb.Posting_VFLOAT}
+		addMutation(t, l, edge, Set, uint64(2*i+1), uint64(2*i+2), false)
+	}
+
+	require.NoError(t, schema.ParseBytes(
+		[]byte(`ivec: float32vector @index(ivfflat(nlist: "2", nprobe: "1")) .`), 1))
+	currentSchema, _ := schema.State().Get(context.Background(), attr)
+	rb := IndexRebuild{
+		Attr:          attr,
+		StartTs:       41,
+		CurrentSchema: &currentSchema,
+	}
+	// The index is committed with retries.
+	maxRetries := x.Config.MaxRetries
+	x.Config.MaxRetries = 1
+	defer func() { x.Config.MaxRetries = maxRetries }()
+	require.NoError(t, rebuildTokIndex(context.Background(), &rb))
+
+	// The centroids are the centers of the two clusters. The index is written asynchronously.
+	var data []byte
+	require.Eventually(t, func() bool {
+		l, err := GetNoStore(x.DataKey(attr+hnsw.VecEntry, 1), 42)
+		require.NoError(t, err)
+		val, err := l.Value(42)
+		if err != nil {
+			return false
+		}
+		data = val.Value.([]byte)
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, uint64(2), binary.BigEndian.Uint64(data))
+	centroids, err := types.BytesAsVector(types.VFloatID, data[8:])
+	require.NoError(t, err)
+	require.Len(t, centroids, 4)
+	xs := []float32{centroids[0], centroids[2]}
+	sort.Slice(xs, func(i, j int) bool { return xs[i] < xs[j] })
+	require.InDelta(t, 0.045, xs[0], 1e-4)
+	require.InDelta(t, 10.045, xs[1], 1e-4)
+}
+
 func TestRebuildTokIndexWithDeletion(t *testing.T) {
 	addEdgeToValue(t, x.AttrInRootNamespace("name2"), 91, "Michonne", uint64(1), uint64(2))
 	addEdgeToValue(t, x.AttrInRootNamespace("name2"), 92, "David", uint64(3), uint64(4))
@@ -614,3 +671,174 @@ func TestNeedsListTypeRebuild(t *testing.T) {
 	require.False(t, rebuild)
 	require.Error(t, err)
 }
+
+func TestVectorListIndexChunks(t *testing.T) {
+	require.NoError(t, pstore.DropAll())
+	MemLayerInstance.clear()
+	require.NoError(t, schema.ParseBytes(
+		[]byte(`vlist: [float32vector] @index(hnsw(metric: "euclidean")) .`), 1))
+	attr := x.AttrInRootNamespace("vlist")
+
+	vecs := [][]byte{types.FloatArrayAsBytes([]float32{1, 2}),
+		types.FloatArrayAsBytes([]float32{3, 4})}
+	chunks := []uint64{100, 101}
+	for i, vec := range vecs {
+		l, err := GetNoStore(x.DataKey(attr, 1), uint64(2*i+1))
+		require.NoError(t, err)
+		edge := &pb.DirectedEdge{Attr: attr, Entity: 1, Value: vec,
+			ValueType: pb.Posting_VFLOAT, VectorChunk: chunks[i]}
+		addMutation(t, l, edge, Set, uint64(2*i+1), uint64(2*i+2), true)
+	}
+
+	// Each vector of the list is stored as a chunk of the node, under its leased uid.
+	l, err := GetNoStore(x.DataKey(attr+hnsw.VecChunks, 1), 5)
+	require.NoError(t, err)
+	uids, err := l.Uids(ListOptions{ReadTs: 5})
+	require.NoError(t, err)
+	require.Equal(t, chunks, uids.Uids)
+	for i, vec := range vecs {
+		l, err := GetNoStore(x.DataKey(attr+hnsw.VecChunk, chunks[i]), 5)
+		require.NoError(t, err)
+		val, err := l.Value(5)
+		require.NoError(t, err)
+		require.Equal(t, vec, val.Value)
+
+		l, err = GetNoStore(x.DataKey(attr+hnsw.VecChunkOwner, chunks[i]), 5)
+		require.NoError(t, err)
+		val, err = l.Value(5)
+		require.NoError(t, err)
+		require.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0}, val.Value)
+	}
+
+	// Setting a vector already in the list again moves it to the chunk leased for it.
+	l, err = GetNoStore(x.DataKey(attr, 1), 5)
+	require.NoError(t, err)
+	edge := &pb.DirectedEdge{Attr: attr, Entity: 1, Value: vecs[0], ValueType: pb.Posting_VFLOAT,
+		VectorChunk: 102}
+	addMutation(t, l, edge, Set, 5, 6, true)
+	l, err = GetNoStore(x.DataKey(attr+hnsw.VecChunks, 1), 7)
+	require.NoError(t, err)
+	uids, err = l.Uids(ListOptions{ReadTs: 7})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{101, 102}, uids.Uids)
+
+	// Deleting a vector from the list deletes its chunk.
+	l, err = GetNoStore(x.DataKey(attr, 1), 7)
+	require.NoError(t, err)
+	edge = &pb.DirectedEdge{Attr: attr, Entity: 1, Value: vecs[0], ValueType: pb.Posting_VFLOAT}
+	addMutation(t, l, edge, Del, 7, 8, true)
+
+	l, err = GetNoStore(x.DataKey(attr+hnsw.VecChunk, chunks[0]), 9)
+	require.NoError(t, err)
+	_, err = l.Value(9)
+	require.ErrorIs(t, err, ErrNoValue)
+	l, err = GetNoStore(x.DataKey(attr+hnsw.VecChunk, 102), 9)
+	require.NoError(t, err)
+	_, err = l.Value(9)
+	require.ErrorIs(t, err, ErrNoValue)
+	l, err = GetNoStore(x.DataKey(attr+hnsw.VecChunk, chunks[1]), 9)
+	require.NoError(t, err)
+	_, err = l.Value(9)
+	require.NoError(t, err)
+	l, err = GetNoStore(x.DataKey(attr+hnsw.VecChunks, 1), 9)
+	require.NoError(t, err)
+	uids, err = l.Uids(ListOptions{ReadTs: 9})
+	require.NoError(t, err)
+	require.Equal(t, chunks[1:], uids.Uids)
+
+	// A vector without a chunk uid can't be indexed, as the replicas must agree on it.
+	l, err = GetNoStore(x.DataKey(attr, 2), 9)
+	require.NoError(t, err)
+	txn := Oracle().RegisterStartTs(9)
+	txn.cache.SetIfAbsent(string(l.key), l)
+	edge = &pb.DirectedEdge{Attr: attr, Entity: 2, Value: vecs[0], ValueType: pb.Posting_VFLOAT,
+		Op: pb.DirectedEdge_SET}
+	require.ErrorContains(t, l.AddMutationWithIndex(context.Background(), edge, txn),
+		"no chunk uid given")
+
+	// The rebuild derives the same chunk uid on every replica, skipping the ones taken.
+	chunk, err := txn.rebuiltVectorChunk(attr, 2, vecs[0])
+	require.NoError(t, err)
+	require.NotZero(t, chunk&(1<<63))
+	again, err := NewTxn(9).rebuiltVectorChunk(attr, 2, vecs[0])
+	require.NoError(t, err)
+	require.Equal(t, chunk, again)
+	other, err := txn.rebuiltVectorChunk(attr, 3, vecs[0])
+	require.NoError(t, err)
+	require.NotEqual(t, chunk, other)
+
+	l, err = GetNoStore(x.DataKey(attr+hnsw.VecChunkOwner, chunk), 11)
+	require.NoError(t, err)
+	addMutation(t, l, &pb.DirectedEdge{Attr: attr + hnsw.VecChunkOwner, Entity: chunk,
+		Value: []byte{4, 0, 0, 0, 0, 0, 0, 0}, ValueType: pb.Posting_INT}, Set, 11, 12, false)
+	next, err := NewTxn(13).rebuiltVectorChunk(attr, 2, vecs[0])
+	require.NoError(t, err)
+	require.NotEqual(t, chunk, next)
+}
+
+func TestBM25Lengths(t *testing.T) {
+	require.NoError(t, pstore.DropAll())
+	MemLayerInstance.clear()
+	require.NoError(t, schema.ParseBytes([]byte(`bdoc: [string] @index(bm25) .`), 1))
+	attr := x.AttrInRootNamespace("bdoc")
+
+	mutate := func(uid uint64, value string, op uint32, startTs uint64) {
+		l, err := GetNoStore(x.DataKey(attr, uid), startTs)
+		require.NoError(t, err)
+		edge := &pb.DirectedEdge{Attr: attr, Entity: uid, Value: []byte(value)}
+		addMutation(t, l, edge, op, startTs, startTs+1, true)
+	}
+	check := func(readTs uint64, lengths map[uint64]uint32, stats tok.BM25Stats) {
+		for uid, want := range lengths {
+			l, err := GetNoStore(x.IndexKey(attr, tok.BM25LengthToken(uid)), readTs)
+			require.NoError(t, err)
+			val, err := l.Value(readTs)
+			if want == 0 {
+				require.ErrorIs(t, err, ErrNoValue)
+				continue
+			}
+			require.NoError(t, err)
+			length, err := tok.UnmarshalBM25Length(val.Value.([]byte))
+			require.NoError(t, err)
+			require.Equal(t, want, length, "length of %#x", uid)
+		}
+		var got tok.BM25Stats
+		for shard := 0; shard < tok.BM25StatsShards; shard++ {
+			l, err := GetNoStore(x.IndexKey(attr, tok.BM25StatsToken(byte(shard))), readTs)
+			require.NoError(t, err)
+			val, err := l.Value(readTs)
+			if errors.Is(err, ErrNoValue) {
+				continue
+			}
+			require.NoError(t, err)
+			s, err := tok.UnmarshalBM25Stats(val.Value.([]byte))
+			require.NoError(t, err)
+			got.Add(s)
+		}
+		require.Equal(t, stats, got)
+	}
+
+	// The length of a document is the total length of the values of its node.
+	mutate(1, "quick brown fox", Set, 1)
+	mutate(1, "lazy dog", Set, 3)
+	mutate(2, "the quick dog", Set, 5)
+	check(7, map[uint64]uint32{1: 5, 2: 2}, tok.BM25Stats{N: 2, Total: 7})
+
+	mutate(2, "the quick dog", Del, 7)
+	mutate(1, "lazy dog", Del, 9)
+	check(11, map[uint64]uint32{1: 3, 2: 0}, tok.BM25Stats{N: 1, Total: 3})
+
+	// The documents of different shards are updated concurrently without conflicting.
+	conflicts := func(uid uint64) map[uint64]struct{} {
+		txn := NewTxn(11)
+		l, err := txn.Get(x.DataKey(attr, uid))
+		require.NoError(t, err)
+		edge := &pb.DirectedEdge{Attr: attr, Entity: uid, Value: []byte("brown fox"),
+			Op: pb.DirectedEdge_SET}
+		require.NoError(t, l.AddMutationWithIndex(context.Background(), edge, txn))
+		return txn.conflicts
+	}
+	for key := range conflicts(3) {
+		require.NotContains(t, conflicts(4), key)
+	}
+}
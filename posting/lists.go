@@ -10,8 +10,10 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/dgryski/go-farm"
 	ostats "go.opencensus.io/stats"
 	"go.opencensus.io/tag"
 	"google.golang.org/protobuf/proto"
@@ -20,6 +22,7 @@ import (
 	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/dgraph-io/ristretto/v2/z"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/types"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
 
@@ -79,6 +82,11 @@ type LocalCache struct {
 
 	// plists are posting lists in memory. They can be discarded to reclaim space.
 	plists map[string]*List
+
+	// reads keeps the fingerprints of the keys read by a serializable transaction. It is only
+	// allocated once tracking is set.
+	tracking atomic.Bool
+	reads    map[uint64]struct{}
 }
 
 // struct to implement LocalCache interface from vector-indexer
@@ -123,10 +131,28 @@ func (vc *viLocalCache) GetValueFromPostingList(pl *List) ([]byte, error) {
 		return nil, ErrNoValue
 	}
 
-	pl.cache = value.Postings[0].Value
+	val, err := vectorAsFloat32Bytes(value.Postings[0])
+	if err != nil {
+		return nil, err
+	}
+	pl.cache = val
 	return pl.cache, nil
 }
 
+// vectorAsFloat32Bytes returns the vector of the posting p encoded as float32 values, which is
+// how the vector indexes read them, whatever the vector type it is stored as.
+func vectorAsFloat32Bytes(p *pb.Posting) ([]byte, error) {
+	tid := types.TypeID(p.ValType)
+	if tid == types.VFloatID || !tid.IsVector() {
+		return p.Value, nil
+	}
+	vec, err := types.BytesAsVector(tid, p.Value)
+	if err != nil {
+		return nil, err
+	}
+	return types.FloatArrayAsBytes(vec), nil
+}
+
 func NewViLocalCache(delegate *LocalCache) *viLocalCache {
 	return &viLocalCache{delegate: delegate}
 }
@@ -147,6 +173,38 @@ func NoCache(startTs uint64) *LocalCache {
 	return &LocalCache{startTs: startTs}
 }
 
+// TrackReads makes the cache record the keys read through it, so that a serializable transaction
+// can send them to Zero, which checks at commit that they weren't written to since it started.
+func (lc *LocalCache) TrackReads() {
+	lc.Lock()
+	defer lc.Unlock()
+	if lc.reads == nil {
+		lc.reads = make(map[uint64]struct{})
+	}
+	lc.tracking.Store(true)
+}
+
+// addRead records a read of the key, if the reads are tracked.
+func (lc *LocalCache) addRead(key []byte) {
+	if !lc.tracking.Load() {
+		return
+	}
+	lc.Lock()
+	defer lc.Unlock()
+	lc.reads[farm.Fingerprint64(key)] = struct{}{}
+}
+
+// ReadKeys returns the fingerprints of the keys read through the cache since it tracks them.
+func (lc *LocalCache) ReadKeys() []uint64 {
+	lc.RLock()
+	defer lc.RUnlock()
+	keys := make([]uint64, 0, len(lc.reads))
+	for fp := range lc.reads {
+		keys = append(keys, fp)
+	}
+	return keys
+}
+
 func (lc *LocalCache) UpdateCommitTs(commitTs uint64) {
 	lc.Lock()
 	defer lc.Unlock()
@@ -333,6 +391,7 @@ func (lc *LocalCache) readPostingListAt(key []byte) (*pb.PostingList, error) {
 // GetSinglePosting retrieves the cached version of the first item in the list associated with the
 // given key. This is used for retrieving the value of a scalar predicats.
 func (lc *LocalCache) GetSinglePosting(key []byte) (*pb.PostingList, error) {
+	lc.addRead(key)
 	// This would return an error if there is some data in the local cache, but we couldn't read it.
 	getListFromLocalCache := func() (*pb.PostingList, error) {
 		lc.RLock()
@@ -390,10 +449,12 @@ func (lc *LocalCache) GetSinglePosting(key []byte) (*pb.PostingList, error) {
 
 // Get retrieves the cached version of the list associated with the given key.
 func (lc *LocalCache) Get(key []byte) (*List, error) {
+	lc.addRead(key)
 	return lc.getInternal(key, true, false)
 }
 
 func (lc *LocalCache) GetUids(key []byte) (*List, error) {
+	lc.addRead(key)
 	return lc.getInternal(key, true, true)
 }
 
@@ -15,6 +15,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/dgryski/go-farm"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	ostats "go.opencensus.io/stats"
@@ -262,6 +263,39 @@ func (txn *Txn) FillContext(ctx *api.TxnContext, gid uint32, isErrored bool) {
 	txn.cache.fillPreds(ctx, gid)
 }
 
+// FillWriteKeys adds the keys written by a serializable transaction to the given transaction
+// context, so that Zero can abort the serializable transactions which read them. These are the
+// keys which aren't conflict keys, like the ones of list predicates and indexes, and a key for
+// each predicate written, which conflicts with the reads iterating over the predicate.
+func (txn *Txn) FillWriteKeys(ctx *api.TxnContext) {
+	txn.Lock()
+	defer txn.Unlock()
+	txn.cache.RLock()
+	defer txn.cache.RUnlock()
+	attrs := make(map[string]struct{})
+	for key := range txn.cache.deltas {
+		if pk, err := x.Parse([]byte(key)); err == nil {
+			attrs[pk.Attr] = struct{}{}
+		}
+		fp := farm.Fingerprint64([]byte(key))
+		if _, ok := txn.conflicts[fp]; ok {
+			continue
+		}
+		ctx.Keys = append(ctx.Keys, x.WriteKeyPrefix+strconv.FormatUint(fp, 36))
+	}
+	for attr := range attrs {
+		fp := PredicateFingerprint(attr)
+		ctx.Keys = append(ctx.Keys, x.WriteKeyPrefix+strconv.FormatUint(fp, 36))
+	}
+	ctx.Keys = x.Unique(ctx.Keys)
+}
+
+// PredicateFingerprint returns the fingerprint standing for all the keys of the predicate, read
+// by a serializable transaction when it iterates over them, like has() or sorting do.
+func PredicateFingerprint(attr string) uint64 {
+	return farm.Fingerprint64(x.PredicatePrefix(attr))
+}
+
 // CommitToDisk commits a transaction to disk.
 // This function only stores deltas to the commit timestamps. It does not try to generate a state.
 // State generation is done via rollups, which happen when a snapshot is created.
@@ -10,13 +10,16 @@ import (
 	"math"
 	"math/rand"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/dgryski/go-farm"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/dgraph-io/ristretto/v2/z"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/schema"
@@ -222,3 +225,41 @@ func TestPostingListRead(t *testing.T) {
 	addEdgeToUID(t, attr, 1, 7, 15, 16)
 	assertLength(17, 3)
 }
+
+func TestSerializableTxnKeys(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte("friend: [uid] ."), 1))
+	attr := x.AttrInRootNamespace("friend")
+	key := x.DataKey(attr, 1)
+	fp := farm.Fingerprint64(key)
+
+	// The reads are only recorded once they are tracked.
+	txn := NewTxn(10)
+	_, err := txn.Get(x.DataKey(attr, 2))
+	require.NoError(t, err)
+	txn.cache.TrackReads()
+	l, err := txn.Get(key)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{fp}, txn.cache.ReadKeys())
+
+	// A query of a transaction which didn't write tracks its reads without a transaction.
+	lc := NoCache(10)
+	lc.TrackReads()
+	_, err = lc.Get(key)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{fp}, lc.ReadKeys())
+
+	// Adding to a list only conflicts on the value added.
+	addMutationHelper(t, l, &pb.DirectedEdge{Attr: attr, ValueId: 3}, Set, txn)
+	var tc api.TxnContext
+	txn.FillContext(&tc, 1, false)
+	require.ElementsMatch(t, []string{strconv.FormatUint(fp^3, 36)}, tc.Keys)
+
+	// A serializable transaction also sends the key of the list and of its predicate as write
+	// keys.
+	txn.FillWriteKeys(&tc)
+	require.ElementsMatch(t, []string{
+		strconv.FormatUint(fp^3, 36),
+		x.WriteKeyPrefix + strconv.FormatUint(fp, 36),
+		x.WriteKeyPrefix + strconv.FormatUint(PredicateFingerprint(attr), 36),
+	}, tc.Keys)
+}
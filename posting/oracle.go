@@ -106,7 +106,11 @@ func (vt *viTxn) GetValueFromPostingList(pl *List) ([]byte, error) {
 		return nil, ErrNoValue
 	}
 
-	pl.cache = value.Postings[0].Value
+	val, err := vectorAsFloat32Bytes(value.Postings[0])
+	if err != nil {
+		return nil, err
+	}
+	pl.cache = val
 	return pl.cache, nil
 }
 
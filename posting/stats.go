@@ -6,12 +6,18 @@
 package posting
 
 import (
+	"hash/fnv"
 	"math"
 	"sync"
 
 	"github.com/hypermodeinc/dgraph/v25/algo"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/x"
 )
 
+// maxHistogramBuckets is the number of buckets kept per sortable tokenizer of a predicate.
+const maxHistogramBuckets = 64
+
 type StatsHolder struct {
 	sync.RWMutex
 
@@ -30,19 +36,41 @@ type StatContainer interface {
 	Estimate([]byte) uint64
 }
 
+// PredicateStats holds the predicate level statistics collected by an EqContainer.
+type PredicateStats struct {
+	Tokens uint64 // Number of distinct index tokens seen.
+	Uids   uint64 // Sum of the posting list lengths of all the tokens seen.
+}
+
+// EqContainer summarizes the cardinality of a predicate, built from the index posting lists
+// that have been read while serving queries. Besides the per token estimates, it keeps the
+// number of distinct tokens, the total number of uids across them and, for sortable
+// tokenizers, a histogram that can be used to estimate inequality functions.
 type EqContainer struct {
 	sync.RWMutex
 
 	cmf *algo.CountMinSketch
+
+	stats PredicateStats
+	// hists holds a histogram for every sortable tokenizer, keyed by the tokenizer identifier.
+	hists map[byte]*algo.Histogram
 }
 
 func NewEqContainer() *EqContainer {
-	return &EqContainer{cmf: algo.NewCountMinSketch(0.001, 0.99)}
+	cmf := algo.NewCountMinSketch(0.001, 0.99)
+	// Index keys of sortable tokenizers often differ only in their last byte, FNV-1a mixes it
+	// into all the bits of the hash, which FNV-1 doesn't.
+	cmf.SetHash(fnv.New64a())
+	return &EqContainer{
+		cmf:   cmf,
+		hists: make(map[byte]*algo.Histogram),
+	}
 }
 
 func (eq *EqContainer) Estimate(key []byte) uint64 {
-	eq.RLock()
-	defer eq.RUnlock()
+	// Counting writes to the hash function of the sketch, so it needs the write lock.
+	eq.Lock()
+	defer eq.Unlock()
 
 	return eq.cmf.Count(key)
 }
@@ -50,18 +78,100 @@ func (eq *EqContainer) Estimate(key []byte) uint64 {
 func (eq *EqContainer) InsertRecord(key []byte, count uint64) {
 	eq.Lock()
 	defer eq.Unlock()
+
+	// The sketch keeps the maximum count seen for a key, only account for the increase so that
+	// reading the same index key multiple times doesn't inflate the totals.
+	prev := eq.cmf.Count(key)
+	if count <= prev {
+		return
+	}
 	eq.cmf.AddInt(key, count)
+
+	isNew := prev == 0
+	if isNew {
+		eq.stats.Tokens++
+	}
+	eq.stats.Uids += count - prev
+
+	if len(key) == 0 {
+		return
+	}
+	tokenizer, ok := tok.GetTokenizerByID(key[0])
+	if !ok || !tokenizer.IsSortable() {
+		return
+	}
+	hist, ok := eq.hists[key[0]]
+	if !ok {
+		hist = algo.NewHistogram(maxHistogramBuckets)
+		eq.hists[key[0]] = hist
+	}
+	hist.Add(key, count-prev, isNew)
 }
 
-func (sh *StatsHolder) InsertRecord(pred string, key []byte, count uint64) {
+// EstimateRange returns the estimated number of uids indexed with a token in [lower, upper].
+// Both bounds must be tokens of the same sortable tokenizer, a nil bound is unbounded. It
+// returns false if there is no histogram for the tokenizer.
+func (eq *EqContainer) EstimateRange(id byte, lower, upper []byte) (uint64, bool) {
+	eq.RLock()
+	defer eq.RUnlock()
+
+	hist, ok := eq.hists[id]
+	if !ok {
+		return 0, false
+	}
+	// The histogram only holds the tokens of this tokenizer, so an unbounded side is fine.
+	return hist.EstimateRange(lower, upper), true
+}
+
+// Stats returns a copy of the predicate level statistics.
+func (eq *EqContainer) Stats() PredicateStats {
+	eq.RLock()
+	defer eq.RUnlock()
+	return eq.stats
+}
+
+func (sh *StatsHolder) getOrCreate(pred string) StatContainer {
+	sh.RLock()
+	val, ok := sh.predStats[pred]
+	sh.RUnlock()
+	if ok {
+		return val
+	}
+
+	sh.Lock()
+	defer sh.Unlock()
+	if val, ok = sh.predStats[pred]; !ok {
+		val = NewEqContainer()
+		sh.predStats[pred] = val
+	}
+	return val
+}
+
+func (sh *StatsHolder) get(pred string) (*EqContainer, bool) {
 	sh.RLock()
 	val, ok := sh.predStats[pred]
 	sh.RUnlock()
 	if !ok {
-		return
+		return nil, false
 	}
+	eq, ok := val.(*EqContainer)
+	return eq, ok
+}
 
-	val.InsertRecord(key, count)
+// InsertRecord records that the index key of pred holds count uids. The statistics of every
+// predicate read are only collected for the query planner, they are otherwise kept for the
+// predicates whose estimates have been asked for.
+func (sh *StatsHolder) InsertRecord(pred string, key []byte, count uint64) {
+	if !x.Config.QueryPlanner {
+		sh.RLock()
+		val, ok := sh.predStats[pred]
+		sh.RUnlock()
+		if ok {
+			val.InsertRecord(key, count)
+		}
+		return
+	}
+	sh.getOrCreate(pred).InsertRecord(key, count)
 }
 
 func (sh *StatsHolder) ProcessEqPredicate(pred string, key []byte) uint64 {
@@ -72,9 +182,26 @@ func (sh *StatsHolder) ProcessEqPredicate(pred string, key []byte) uint64 {
 		return val.Estimate(key)
 	}
 
-	sh.Lock()
-	val = NewEqContainer()
-	sh.predStats[pred] = val
-	sh.Unlock()
+	sh.getOrCreate(pred)
 	return math.MaxUint64
 }
+
+// EstimateRange returns the estimated number of uids of pred that are indexed with a token in
+// [lower, upper] of the sortable tokenizer with the given identifier.
+func (sh *StatsHolder) EstimateRange(pred string, id byte, lower, upper []byte) (uint64, bool) {
+	eq, ok := sh.get(pred)
+	if !ok {
+		return 0, false
+	}
+	return eq.EstimateRange(id, lower, upper)
+}
+
+// PredicateStats returns the predicate level statistics of pred, if any have been collected.
+func (sh *StatsHolder) PredicateStats(pred string) (PredicateStats, bool) {
+	eq, ok := sh.get(pred)
+	if !ok {
+		return PredicateStats{}, false
+	}
+	stats := eq.Stats()
+	return stats, stats.Tokens > 0
+}
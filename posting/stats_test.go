@@ -0,0 +1,83 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package posting
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// enableQueryPlanner turns the query planner on for the duration of the test.
+func enableQueryPlanner(t *testing.T) {
+	planner := x.Config.QueryPlanner
+	x.Config.QueryPlanner = true
+	t.Cleanup(func() { x.Config.QueryPlanner = planner })
+}
+
+func TestStatsHolderPredicateStats(t *testing.T) {
+	enableQueryPlanner(t)
+	sh := NewStatsHolder()
+	_, ok := sh.PredicateStats("name")
+	require.False(t, ok)
+	require.Equal(t, uint64(math.MaxUint64), sh.ProcessEqPredicate("age", []byte("a")))
+
+	sh.InsertRecord("name", []byte("\x02alice"), 10)
+	sh.InsertRecord("name", []byte("\x02bob"), 5)
+	// Reading the same key again must not inflate the totals.
+	sh.InsertRecord("name", []byte("\x02alice"), 10)
+	sh.InsertRecord("name", []byte("\x02bob"), 7)
+
+	stats, ok := sh.PredicateStats("name")
+	require.True(t, ok)
+	require.EqualValues(t, 2, stats.Tokens)
+	require.EqualValues(t, 17, stats.Uids)
+	require.EqualValues(t, 10, sh.ProcessEqPredicate("name", []byte("\x02alice")))
+}
+
+func TestStatsHolderWithoutPlanner(t *testing.T) {
+	sh := NewStatsHolder()
+	// The predicates read aren't tracked unless their estimates have been asked for.
+	sh.InsertRecord("name", []byte("\x02alice"), 10)
+	_, ok := sh.PredicateStats("name")
+	require.False(t, ok)
+	require.Empty(t, sh.predStats)
+
+	require.Equal(t, uint64(math.MaxUint64), sh.ProcessEqPredicate("name", []byte("\x02alice")))
+	sh.InsertRecord("name", []byte("\x02alice"), 10)
+	require.EqualValues(t, 10, sh.ProcessEqPredicate("name", []byte("\x02alice")))
+}
+
+func TestStatsHolderEstimateRange(t *testing.T) {
+	enableQueryPlanner(t)
+	sh := NewStatsHolder()
+	tokenizer, ok := tok.GetTokenizer("int")
+	require.True(t, ok)
+
+	token := func(v int64) []byte {
+		toks, err := tok.BuildTokens(v, tokenizer)
+		require.NoError(t, err)
+		return []byte(toks[0])
+	}
+	for i := int64(-50); i < 50; i++ {
+		sh.InsertRecord("age", token(i), 1)
+	}
+
+	_, ok = sh.EstimateRange("age", tok.IdentExact, nil, nil)
+	require.False(t, ok)
+
+	all, ok := sh.EstimateRange("age", tokenizer.Identifier(), nil, nil)
+	require.True(t, ok)
+	require.EqualValues(t, 100, all)
+
+	est, ok := sh.EstimateRange("age", tokenizer.Identifier(), token(25), nil)
+	require.True(t, ok)
+	require.Less(t, est, all/2)
+}
@@ -306,7 +306,10 @@ const (
 	Zero_TryAbort_FullMethodName         = "/pb.Zero/TryAbort"
 	Zero_DeleteNamespace_FullMethodName  = "/pb.Zero/DeleteNamespace"
 	Zero_RemoveNode_FullMethodName       = "/pb.Zero/RemoveNode"
+	Zero_AddLearner_FullMethodName       = "/pb.Zero/AddLearner"
 	Zero_MoveTablet_FullMethodName       = "/pb.Zero/MoveTablet"
+	Zero_SplitTablet_FullMethodName      = "/pb.Zero/SplitTablet"
+	Zero_MergeTablet_FullMethodName      = "/pb.Zero/MergeTablet"
 )
 
 // ZeroClient is the client API for Zero service.
@@ -326,7 +329,10 @@ type ZeroClient interface {
 	TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*OracleDelta, error)
 	DeleteNamespace(ctx context.Context, in *DeleteNsRequest, opts ...grpc.CallOption) (*Status, error)
 	RemoveNode(ctx context.Context, in *RemoveNodeRequest, opts ...grpc.CallOption) (*Status, error)
+	AddLearner(ctx context.Context, in *AddLearnerRequest, opts ...grpc.CallOption) (*Status, error)
 	MoveTablet(ctx context.Context, in *MoveTabletRequest, opts ...grpc.CallOption) (*Status, error)
+	SplitTablet(ctx context.Context, in *SplitTabletRequest, opts ...grpc.CallOption) (*Status, error)
+	MergeTablet(ctx context.Context, in *MergeTabletRequest, opts ...grpc.CallOption) (*Status, error)
 }
 
 type zeroClient struct {
@@ -491,6 +497,15 @@ func (c *zeroClient) RemoveNode(ctx context.Context, in *RemoveNodeRequest, opts
 	return out, nil
 }
 
+func (c *zeroClient) AddLearner(ctx context.Context, in *AddLearnerRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Zero_AddLearner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *zeroClient) MoveTablet(ctx context.Context, in *MoveTabletRequest, opts ...grpc.CallOption) (*Status, error) {
 	out := new(Status)
 	err := c.cc.Invoke(ctx, Zero_MoveTablet_FullMethodName, in, out, opts...)
@@ -500,6 +515,24 @@ func (c *zeroClient) MoveTablet(ctx context.Context, in *MoveTabletRequest, opts
 	return out, nil
 }
 
+func (c *zeroClient) SplitTablet(ctx context.Context, in *SplitTabletRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Zero_SplitTablet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zeroClient) MergeTablet(ctx context.Context, in *MergeTabletRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Zero_MergeTablet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ZeroServer is the server API for Zero service.
 // All implementations must embed UnimplementedZeroServer
 // for forward compatibility
@@ -517,7 +550,10 @@ type ZeroServer interface {
 	TryAbort(context.Context, *TxnTimestamps) (*OracleDelta, error)
 	DeleteNamespace(context.Context, *DeleteNsRequest) (*Status, error)
 	RemoveNode(context.Context, *RemoveNodeRequest) (*Status, error)
+	AddLearner(context.Context, *AddLearnerRequest) (*Status, error)
 	MoveTablet(context.Context, *MoveTabletRequest) (*Status, error)
+	SplitTablet(context.Context, *SplitTabletRequest) (*Status, error)
+	MergeTablet(context.Context, *MergeTabletRequest) (*Status, error)
 	mustEmbedUnimplementedZeroServer()
 }
 
@@ -561,9 +597,18 @@ func (UnimplementedZeroServer) DeleteNamespace(context.Context, *DeleteNsRequest
 func (UnimplementedZeroServer) RemoveNode(context.Context, *RemoveNodeRequest) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveNode not implemented")
 }
+func (UnimplementedZeroServer) AddLearner(context.Context, *AddLearnerRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLearner not implemented")
+}
 func (UnimplementedZeroServer) MoveTablet(context.Context, *MoveTabletRequest) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MoveTablet not implemented")
 }
+func (UnimplementedZeroServer) SplitTablet(context.Context, *SplitTabletRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitTablet not implemented")
+}
+func (UnimplementedZeroServer) MergeTablet(context.Context, *MergeTabletRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTablet not implemented")
+}
 func (UnimplementedZeroServer) mustEmbedUnimplementedZeroServer() {}
 
 // UnsafeZeroServer may be embedded to opt out of forward compatibility for this service.
@@ -799,6 +844,24 @@ func _Zero_RemoveNode_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Zero_AddLearner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLearnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).AddLearner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zero_AddLearner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).AddLearner(ctx, req.(*AddLearnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Zero_MoveTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MoveTabletRequest)
 	if err := dec(in); err != nil {
@@ -817,6 +880,42 @@ func _Zero_MoveTablet_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Zero_SplitTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitTabletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).SplitTablet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zero_SplitTablet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).SplitTablet(ctx, req.(*SplitTabletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_MergeTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTabletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).MergeTablet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zero_MergeTablet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).MergeTablet(ctx, req.(*MergeTabletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Zero_ServiceDesc is the grpc.ServiceDesc for Zero service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -864,10 +963,22 @@ var Zero_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveNode",
 			Handler:    _Zero_RemoveNode_Handler,
 		},
+		{
+			MethodName: "AddLearner",
+			Handler:    _Zero_AddLearner_Handler,
+		},
 		{
 			MethodName: "MoveTablet",
 			Handler:    _Zero_MoveTablet_Handler,
 		},
+		{
+			MethodName: "SplitTablet",
+			Handler:    _Zero_SplitTablet_Handler,
+		},
+		{
+			MethodName: "MergeTablet",
+			Handler:    _Zero_MergeTablet_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -893,6 +1004,7 @@ const (
 	Worker_Backup_FullMethodName                          = "/pb.Worker/Backup"
 	Worker_Restore_FullMethodName                         = "/pb.Worker/Restore"
 	Worker_Export_FullMethodName                          = "/pb.Worker/Export"
+	Worker_ExportTabular_FullMethodName                   = "/pb.Worker/ExportTabular"
 	Worker_ReceivePredicate_FullMethodName                = "/pb.Worker/ReceivePredicate"
 	Worker_MovePredicate_FullMethodName                   = "/pb.Worker/MovePredicate"
 	Worker_Subscribe_FullMethodName                       = "/pb.Worker/Subscribe"
@@ -901,6 +1013,7 @@ const (
 	Worker_TaskStatus_FullMethodName                      = "/pb.Worker/TaskStatus"
 	Worker_UpdateExtSnapshotStreamingState_FullMethodName = "/pb.Worker/UpdateExtSnapshotStreamingState"
 	Worker_StreamExtSnapshot_FullMethodName               = "/pb.Worker/StreamExtSnapshot"
+	Worker_ReplayCDC_FullMethodName                       = "/pb.Worker/ReplayCDC"
 )
 
 // WorkerClient is the client API for Worker service.
@@ -916,6 +1029,9 @@ type WorkerClient interface {
 	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error)
 	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*Status, error)
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	// ExportTabular streams the data of a group in the csv and parquet formats to the alpha
+	// coordinating the export, which writes the data of all the groups together.
+	ExportTabular(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Worker_ExportTabularClient, error)
 	ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error)
 	MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
 	Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error)
@@ -924,6 +1040,7 @@ type WorkerClient interface {
 	TaskStatus(ctx context.Context, in *TaskStatusRequest, opts ...grpc.CallOption) (*TaskStatusResponse, error)
 	UpdateExtSnapshotStreamingState(ctx context.Context, in *api_v2.UpdateExtSnapshotStreamingStateRequest, opts ...grpc.CallOption) (*Status, error)
 	StreamExtSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamExtSnapshotClient, error)
+	ReplayCDC(ctx context.Context, in *CDCReplayRequest, opts ...grpc.CallOption) (*Status, error)
 }
 
 type workerClient struct {
@@ -1028,8 +1145,40 @@ func (c *workerClient) Export(ctx context.Context, in *ExportRequest, opts ...gr
 	return out, nil
 }
 
+func (c *workerClient) ExportTabular(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Worker_ExportTabularClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[1], Worker_ExportTabular_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerExportTabularClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Worker_ExportTabularClient interface {
+	Recv() (*KVS, error)
+	grpc.ClientStream
+}
+
+type workerExportTabularClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerExportTabularClient) Recv() (*KVS, error) {
+	m := new(KVS)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *workerClient) ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[1], Worker_ReceivePredicate_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[2], Worker_ReceivePredicate_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1072,7 +1221,7 @@ func (c *workerClient) MovePredicate(ctx context.Context, in *MovePredicatePaylo
 }
 
 func (c *workerClient) Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[2], Worker_Subscribe_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[3], Worker_Subscribe_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1140,7 +1289,7 @@ func (c *workerClient) UpdateExtSnapshotStreamingState(ctx context.Context, in *
 }
 
 func (c *workerClient) StreamExtSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamExtSnapshotClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[3], Worker_StreamExtSnapshot_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[4], Worker_StreamExtSnapshot_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1173,6 +1322,15 @@ func (x *workerStreamExtSnapshotClient) CloseAndRecv() (*api_v2.StreamExtSnapsho
 	return m, nil
 }
 
+func (c *workerClient) ReplayCDC(ctx context.Context, in *CDCReplayRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Worker_ReplayCDC_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkerServer is the server API for Worker service.
 // All implementations must embed UnimplementedWorkerServer
 // for forward compatibility
@@ -1186,6 +1344,9 @@ type WorkerServer interface {
 	Backup(context.Context, *BackupRequest) (*BackupResponse, error)
 	Restore(context.Context, *RestoreRequest) (*Status, error)
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	// ExportTabular streams the data of a group in the csv and parquet formats to the alpha
+	// coordinating the export, which writes the data of all the groups together.
+	ExportTabular(*ExportRequest, Worker_ExportTabularServer) error
 	ReceivePredicate(Worker_ReceivePredicateServer) error
 	MovePredicate(context.Context, *MovePredicatePayload) (*api.Payload, error)
 	Subscribe(*SubscriptionRequest, Worker_SubscribeServer) error
@@ -1194,6 +1355,7 @@ type WorkerServer interface {
 	TaskStatus(context.Context, *TaskStatusRequest) (*TaskStatusResponse, error)
 	UpdateExtSnapshotStreamingState(context.Context, *api_v2.UpdateExtSnapshotStreamingStateRequest) (*Status, error)
 	StreamExtSnapshot(Worker_StreamExtSnapshotServer) error
+	ReplayCDC(context.Context, *CDCReplayRequest) (*Status, error)
 	mustEmbedUnimplementedWorkerServer()
 }
 
@@ -1225,6 +1387,9 @@ func (UnimplementedWorkerServer) Restore(context.Context, *RestoreRequest) (*Sta
 func (UnimplementedWorkerServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
+func (UnimplementedWorkerServer) ExportTabular(*ExportRequest, Worker_ExportTabularServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTabular not implemented")
+}
 func (UnimplementedWorkerServer) ReceivePredicate(Worker_ReceivePredicateServer) error {
 	return status.Errorf(codes.Unimplemented, "method ReceivePredicate not implemented")
 }
@@ -1249,6 +1414,9 @@ func (UnimplementedWorkerServer) UpdateExtSnapshotStreamingState(context.Context
 func (UnimplementedWorkerServer) StreamExtSnapshot(Worker_StreamExtSnapshotServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamExtSnapshot not implemented")
 }
+func (UnimplementedWorkerServer) ReplayCDC(context.Context, *CDCReplayRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayCDC not implemented")
+}
 func (UnimplementedWorkerServer) mustEmbedUnimplementedWorkerServer() {}
 
 // UnsafeWorkerServer may be embedded to opt out of forward compatibility for this service.
@@ -1414,6 +1582,27 @@ func _Worker_Export_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Worker_ExportTabular_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkerServer).ExportTabular(m, &workerExportTabularServer{stream})
+}
+
+type Worker_ExportTabularServer interface {
+	Send(*KVS) error
+	grpc.ServerStream
+}
+
+type workerExportTabularServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerExportTabularServer) Send(m *KVS) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Worker_ReceivePredicate_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(WorkerServer).ReceivePredicate(&workerReceivePredicateServer{stream})
 }
@@ -1577,6 +1766,24 @@ func (x *workerStreamExtSnapshotServer) Recv() (*api_v2.StreamExtSnapshotRequest
 	return m, nil
 }
 
+func _Worker_ReplayCDC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CDCReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).ReplayCDC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Worker_ReplayCDC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).ReplayCDC(ctx, req.(*CDCReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Worker_ServiceDesc is the grpc.ServiceDesc for Worker service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1632,6 +1839,10 @@ var Worker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateExtSnapshotStreamingState",
 			Handler:    _Worker_UpdateExtSnapshotStreamingState_Handler,
 		},
+		{
+			MethodName: "ReplayCDC",
+			Handler:    _Worker_ReplayCDC_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1640,6 +1851,11 @@ var Worker_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ExportTabular",
+			Handler:       _Worker_ExportTabular_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ReceivePredicate",
 			Handler:       _Worker_ReceivePredicate_Handler,
@@ -1658,3 +1874,120 @@ var Worker_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "pb.proto",
 }
+
+const (
+	CDC_Subscribe_FullMethodName = "/pb.CDC/Subscribe"
+)
+
+// CDCClient is the client API for CDC service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CDCClient interface {
+	Subscribe(ctx context.Context, in *CDCSubscribeRequest, opts ...grpc.CallOption) (CDC_SubscribeClient, error)
+}
+
+type cDCClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCDCClient(cc grpc.ClientConnInterface) CDCClient {
+	return &cDCClient{cc}
+}
+
+func (c *cDCClient) Subscribe(ctx context.Context, in *CDCSubscribeRequest, opts ...grpc.CallOption) (CDC_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CDC_ServiceDesc.Streams[0], CDC_Subscribe_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cDCSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CDC_SubscribeClient interface {
+	Recv() (*CDCEvents, error)
+	grpc.ClientStream
+}
+
+type cDCSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *cDCSubscribeClient) Recv() (*CDCEvents, error) {
+	m := new(CDCEvents)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CDCServer is the server API for CDC service.
+// All implementations must embed UnimplementedCDCServer
+// for forward compatibility
+type CDCServer interface {
+	Subscribe(*CDCSubscribeRequest, CDC_SubscribeServer) error
+	mustEmbedUnimplementedCDCServer()
+}
+
+// UnimplementedCDCServer must be embedded to have forward compatible implementations.
+type UnimplementedCDCServer struct {
+}
+
+func (UnimplementedCDCServer) Subscribe(*CDCSubscribeRequest, CDC_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedCDCServer) mustEmbedUnimplementedCDCServer() {}
+
+// UnsafeCDCServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CDCServer will
+// result in compilation errors.
+type UnsafeCDCServer interface {
+	mustEmbedUnimplementedCDCServer()
+}
+
+func RegisterCDCServer(s grpc.ServiceRegistrar, srv CDCServer) {
+	s.RegisterService(&CDC_ServiceDesc, srv)
+}
+
+func _CDC_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CDCSubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CDCServer).Subscribe(m, &cDCSubscribeServer{stream})
+}
+
+type CDC_SubscribeServer interface {
+	Send(*CDCEvents) error
+	grpc.ServerStream
+}
+
+type cDCSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *cDCSubscribeServer) Send(m *CDCEvents) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// CDC_ServiceDesc is the grpc.ServiceDesc for CDC service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CDC_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.CDC",
+	HandlerType: (*CDCServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _CDC_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pb.proto",
+}
@@ -633,7 +633,7 @@ func getValType(v *types.Val) valType {
 		vBase = INT
 	case types.FloatID:
 		vBase = FLOAT
-	case types.VFloatID:
+	case types.VFloatID, types.VFloat16ID, types.VInt8ID, types.VBinaryID:
 		vBase = VFLOAT
 	case types.BigFloatID:
 		vBase = BIGFLOAT
@@ -793,8 +793,9 @@ func (ag *aggregator) ApplyVal(v types.Val) error {
 
 func (ag *aggregator) Apply(val types.Val) error {
 	if ag.result.Value == nil {
-		if val.Tid == types.VFloatID {
-			// Copy array if it's VFloat, otherwise we overwrite value.
+		if val.Tid.IsVector() {
+			// Copy array if it's a vector, otherwise we overwrite value. The sum of vectors of
+			// any type is a float32 vector.
 			va := val.Value.([]float32)
 			res := make([]float32, len(va))
 			copy(res, va)
@@ -830,7 +831,7 @@ func (ag *aggregator) Apply(val types.Val) error {
 			va.Value = va.Value.(int64) + vb.Value.(int64)
 		case va.Tid == types.FloatID && vb.Tid == types.FloatID:
 			va.Value = va.Value.(float64) + vb.Value.(float64)
-		case va.Tid == types.VFloatID && vb.Tid == types.VFloatID:
+		case va.Tid == types.VFloatID && vb.Tid.IsVector():
 			accumVal := va.Value.([]float32)
 			bVal := vb.Value.([]float32)
 			if len(bVal) != len(accumVal) {
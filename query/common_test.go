@@ -332,6 +332,7 @@ tweet-a                        : string @index(trigram) .
 tweet-b                        : string @index(term) .
 tweet-c                        : string @index(fulltext) .
 tweet-d                        : string @index(trigram) .
+tweet-e                        : string @index(bm25) .
 name2                          : string @index(term)  .
 age2                           : int @index(int) .
 
@@ -892,6 +893,11 @@ func populateCluster(dc dgraphapi.Cluster) {
 		<62> <tweet-d> "aaacdxx" .
 		<63> <tweet-d> "aaabcd" .
 
+		<61> <tweet-e> "the fox jumps" .
+		<62> <tweet-e> "the quick brown fox and the quick dog" .
+		<63> <tweet-e> "a lazy dog sleeps" .
+		<64> <tweet-e> "quick quick quick" .
+
 		<40> <name2> "Alice" .
 		<41> <age2> "20" .
 
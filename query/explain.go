@@ -0,0 +1,153 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// Explain holds the plan and the execution profile of a query. It is filled in when the query
+// is run with a context returned by WithExplain.
+type Explain struct {
+	Blocks []*ExplainNode `json:"blocks"`
+}
+
+// ExplainNode describes how a single SubGraph was executed.
+type ExplainNode struct {
+	Attr     string `json:"attr,omitempty"`
+	Alias    string `json:"alias,omitempty"`
+	Func     string `json:"func,omitempty"`
+	FilterOp string `json:"filter_op,omitempty"`
+	// Access tells whether the function used the index, looked up the values of the uids coming
+	// from the parent, or scanned the predicate, as reported by the workers that processed it.
+	Access string `json:"access,omitempty"`
+	// EstimatedUids is the number of uids the planner expected, if statistics were available.
+	EstimatedUids *uint64 `json:"estimated_uids,omitempty"`
+	SrcUids       int     `json:"src_uids"`
+	DestUids      int     `json:"dest_uids"`
+	// DurationNs is the time spent processing this SubGraph, including its filters and children.
+	DurationNs uint64         `json:"duration_ns"`
+	Tasks      []*TaskProfile `json:"tasks,omitempty"`
+	Filters    []*ExplainNode `json:"filters,omitempty"`
+	Children   []*ExplainNode `json:"children,omitempty"`
+}
+
+// TaskProfile holds the profile of one call to worker.ProcessTaskOverNetwork.
+type TaskProfile struct {
+	DurationNs  uint64 `json:"duration_ns"`
+	ResultBytes int    `json:"result_bytes"`
+	ResultUids  int    `json:"result_uids"`
+	Access      string `json:"access,omitempty"`
+}
+
+// sgProfile is collected while processing a SubGraph in explain mode.
+type sgProfile struct {
+	duration time.Duration
+	tasks    []*TaskProfile
+}
+
+// WithExplain returns a context that makes the query processing collect an execution profile
+// into the returned Explain.
+func WithExplain(ctx context.Context) (context.Context, *Explain) {
+	e := &Explain{}
+	return context.WithValue(ctx, ExplainKey, e), e
+}
+
+// IsExplainRequested returns true if a gRPC client asked for the query to be explained by
+// passing explain as metadata.
+func IsExplainRequested(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["explain"]) == 0 {
+		return false
+	}
+	explain, _ := strconv.ParseBool(md["explain"][0])
+	return explain
+}
+
+func explainFromContext(ctx context.Context) *Explain {
+	e, _ := ctx.Value(ExplainKey).(*Explain)
+	return e
+}
+
+// processTask sends the task to the worker, recording its profile if the query is explained.
+func (sg *SubGraph) processTask(ctx context.Context, q *pb.Query) (*pb.Result, error) {
+	if sg.profile == nil {
+		return worker.ProcessTaskOverNetwork(ctx, q)
+	}
+	start := time.Now()
+	result, err := worker.ProcessTaskOverNetwork(ctx, q)
+	tp := &TaskProfile{DurationNs: uint64(time.Since(start).Nanoseconds())}
+	if result != nil {
+		tp.ResultBytes = proto.Size(result)
+		tp.Access = result.Access
+		for _, l := range result.UidMatrix {
+			tp.ResultUids += len(l.Uids)
+		}
+	}
+	sg.profile.tasks = append(sg.profile.tasks, tp)
+	return result, err
+}
+
+func (e *Explain) build(ctx context.Context, sgs []*SubGraph) {
+	for _, sg := range sgs {
+		e.Blocks = append(e.Blocks, sg.explainNode(ctx))
+	}
+}
+
+func (sg *SubGraph) explainNode(ctx context.Context) *ExplainNode {
+	node := &ExplainNode{
+		Attr:     x.ParseAttr(sg.Attr),
+		Alias:    sg.Params.Alias,
+		FilterOp: sg.FilterOp,
+	}
+	if sg.SrcUIDs != nil {
+		node.SrcUids = len(sg.SrcUIDs.Uids)
+	}
+	if sg.DestUIDs != nil {
+		node.DestUids = len(sg.DestUIDs.Uids)
+	}
+	if sg.profile != nil {
+		node.DurationNs = uint64(sg.profile.duration.Nanoseconds())
+		node.Tasks = sg.profile.tasks
+		for _, tp := range sg.profile.tasks {
+			node.Access = worker.MergeAccess(node.Access, tp.Access)
+		}
+	}
+
+	if sg.SrcFunc != nil {
+		args := make([]string, 0, len(sg.SrcFunc.Args)+1)
+		if sg.Attr != "" {
+			args = append(args, node.Attr)
+		}
+		for _, arg := range sg.SrcFunc.Args {
+			args = append(args, arg.Value)
+		}
+		node.Func = fmt.Sprintf("%s(%s)", sg.SrcFunc.Name, strings.Join(args, ", "))
+
+		if est := estimateCost(ctx, sg); est != unknownCost {
+			node.EstimatedUids = &est
+		}
+	}
+
+	for _, f := range sg.Filters {
+		node.Filters = append(node.Filters, f.explainNode(ctx))
+	}
+	for _, c := range sg.Children {
+		node.Children = append(node.Children, c.explainNode(ctx))
+	}
+	return node
+}
@@ -0,0 +1,164 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package query
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/dql"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/types"
+)
+
+const (
+	fuseFn = "fuse"
+	// fuseRRF ranks the uids by the sum of the reciprocal of their rank in every variable.
+	fuseRRF = "rrf"
+	// fuseWeighted ranks the uids by the weighted sum of their normalized value in every variable.
+	fuseWeighted = "weighted"
+	// rrfK is the constant added to the ranks by reciprocal rank fusion, so that the first ranks
+	// of a variable don't outweigh the other variables.
+	rrfK = 60
+)
+
+// fuseVar is a variable of the fuse function along with its weight. A negative weight ranks
+// the smaller values of the variable first, like distances.
+type fuseVar struct {
+	name   string
+	weight float64
+}
+
+// parseFuseArgs parses the arguments of fuse(method, var [, weight], var [, weight] ...), a
+// variable without weight having a weight of 1.
+func parseFuseArgs(args []dql.Arg) (string, []fuseVar, error) {
+	if len(args) == 0 {
+		return "", nil, errors.Errorf("fuse function expects a method and variables")
+	}
+	method := strings.ToLower(args[0].Value)
+	if method != fuseRRF && method != fuseWeighted {
+		return "", nil, errors.Errorf("Invalid method %q in fuse function, expected %s or %s",
+			args[0].Value, fuseRRF, fuseWeighted)
+	}
+	var vars []fuseVar
+	var weighted bool
+	for _, arg := range args[1:] {
+		w, err := strconv.ParseFloat(arg.Value, 64)
+		switch {
+		case err != nil:
+			vars = append(vars, fuseVar{name: arg.Value, weight: 1})
+			weighted = false
+		case len(vars) == 0 || weighted:
+			return "", nil, errors.Errorf("Weight %s in fuse function doesn't follow a variable",
+				arg.Value)
+		case w == 0 || math.IsInf(w, 0) || math.IsNaN(w):
+			return "", nil, errors.Errorf("Invalid weight %s for var(%s) in fuse function",
+				arg.Value, vars[len(vars)-1].name)
+		default:
+			vars[len(vars)-1].weight = w
+			weighted = true
+		}
+	}
+	if len(vars) == 0 {
+		return "", nil, errors.Errorf("fuse function expects variables after the method")
+	}
+	return method, vars, nil
+}
+
+// rankedValue is the value of a uid in a variable of the fuse function.
+type rankedValue struct {
+	uid uint64
+	val float64
+}
+
+// fuseValues returns the values of the variable v, best first. The uids of a uid variable all
+// have the same value, as they aren't ranked.
+func fuseValues(v fuseVar, l varValue) ([]rankedValue, error) {
+	var vals []rankedValue
+	if l.Vals.Len() == 0 {
+		for _, uid := range l.Uids.GetUids() {
+			vals = append(vals, rankedValue{uid: uid})
+		}
+		return vals, nil
+	}
+	err := l.Vals.Iterate(func(uid uint64, val types.Val) error {
+		fv, err := floatValue(val)
+		if err != nil {
+			return errors.Wrapf(err, "while ranking the value of uid %#x in var(%s)", uid, v.name)
+		}
+		vals = append(vals, rankedValue{uid: uid, val: fv})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(vals, func(i, j int) bool {
+		if vals[i].val != vals[j].val {
+			return (vals[i].val > vals[j].val) == (v.weight > 0)
+		}
+		return vals[i].uid < vals[j].uid
+	})
+	return vals, nil
+}
+
+// fillFuseScores fuses the variables of the fuse function into a score for every uid they hold,
+// which is stored in sg.fnScores, the uids being stored in sg.DestUIDs.
+func (sg *SubGraph) fillFuseScores(mp map[string]varValue) error {
+	method, vars, err := parseFuseArgs(sg.SrcFunc.Args)
+	if err != nil {
+		return err
+	}
+	fused := make(map[uint64]float64)
+	for _, v := range vars {
+		l, ok := mp[v.name]
+		if !ok {
+			continue
+		}
+		vals, err := fuseValues(v, l)
+		if err != nil {
+			return err
+		}
+		weight := math.Abs(v.weight)
+		switch method {
+		case fuseRRF:
+			// Equal values share the rank of the first of them.
+			rank := 1
+			for i, rv := range vals {
+				if i > 0 && rv.val != vals[i-1].val {
+					rank = i + 1
+				}
+				fused[rv.uid] += weight / float64(rrfK+rank)
+			}
+		case fuseWeighted:
+			if len(vals) == 0 {
+				continue
+			}
+			// The values are scaled between 0 for the worst one and 1 for the best one.
+			best, worst := vals[0].val, vals[len(vals)-1].val
+			for _, rv := range vals {
+				norm := 1.0
+				if best != worst {
+					norm = (rv.val - worst) / (best - worst)
+				}
+				fused[rv.uid] += weight * norm
+			}
+		}
+	}
+
+	sg.fnScores = types.NewShardedMap()
+	uids := make([]uint64, 0, len(fused))
+	for uid, score := range fused {
+		sg.fnScores.Set(uid, types.Val{Tid: types.FloatID, Value: score})
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	sg.DestUIDs = &pb.List{Uids: uids}
+	return nil
+}
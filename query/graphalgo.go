@@ -0,0 +1,247 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package query
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/algo"
+	"github.com/hypermodeinc/dgraph/v25/dql"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// Graph algorithms that can be run with the @algo directive.
+const (
+	algoPageRank         = "pagerank"
+	algoWCC              = "wcc"
+	algoSCC              = "scc"
+	algoLabelPropagation = "labelpropagation"
+	algoTriangleCount    = "trianglecount"
+)
+
+// algoArgs lists the arguments accepted by every algorithm, besides depth.
+var algoArgs = map[string][]string{
+	algoPageRank:         {"damping", "iterations", "tolerance"},
+	algoWCC:              nil,
+	algoSCC:              nil,
+	algoLabelPropagation: {"iterations"},
+	algoTriangleCount:    nil,
+}
+
+func validateAlgoArgs(args *dql.AlgoArgs) error {
+	allowed, ok := algoArgs[args.Name]
+	if !ok {
+		return errors.Errorf("Unknown algorithm %q in @algo. Expected one of %s, %s, %s, %s or %s",
+			args.Name, algoPageRank, algoWCC, algoSCC, algoLabelPropagation, algoTriangleCount)
+	}
+	for key := range args.Args {
+		if key == "depth" {
+			continue
+		}
+		valid := false
+		for _, a := range allowed {
+			valid = valid || a == key
+		}
+		if !valid {
+			return errors.Errorf("Unexpected argument %q for algorithm %s in @algo", key, args.Name)
+		}
+	}
+	return nil
+}
+
+func algoFloatArg(args *dql.AlgoArgs, key string, def, lo, hi float64) (float64, error) {
+	v, ok := args.Args[key]
+	if !ok {
+		return def, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < lo || f > hi {
+		return 0, errors.Errorf("%s inside @algo should be a number between %v and %v. Got: %s",
+			key, lo, hi, v)
+	}
+	return f, nil
+}
+
+func algoIntArg(args *dql.AlgoArgs, key string, def uint64) (uint64, error) {
+	v, ok := args.Args[key]
+	if !ok {
+		return def, nil
+	}
+	n, err := strconv.ParseUint(v, 0, 64)
+	if err != nil || n == 0 {
+		return 0, errors.Errorf("%s inside @algo should be a positive integer. Got: %s", key, v)
+	}
+	return n, nil
+}
+
+// runGraphAlgo runs the graph algorithm of the @algo directive. The graph is made of the nodes
+// returned by the root of sg, along with the nodes reachable from them through the predicates
+// asked for in sg, up to depth levels. The score of every node of the graph is stored in
+// sg.Params.UidToVal, so that the block can be used as a value variable, and the nodes
+// themselves in sg.DestUIDs.
+func runGraphAlgo(ctx context.Context, sg *SubGraph) error {
+	args := sg.Params.Algo
+	if err := validateAlgoArgs(args); err != nil {
+		return err
+	}
+	depth, err := algoIntArg(args, "depth", math.MaxUint64)
+	if err != nil {
+		return err
+	}
+	for _, child := range sg.Children {
+		if len(child.Children) > 0 {
+			return errors.Errorf("@algo queries require that all predicates are specified in " +
+				"one level")
+		}
+	}
+
+	g, err := sg.loadGraph(ctx, depth)
+	if err != nil {
+		return err
+	}
+
+	vals := types.NewShardedMap()
+	setInts := func(labels []int) {
+		for i, l := range labels {
+			vals.Set(g.Uids[i], types.Val{Tid: types.IntID, Value: int64(g.Uids[l])})
+		}
+	}
+	switch args.Name {
+	case algoPageRank:
+		damping, err := algoFloatArg(args, "damping", 0.85, 0, 1)
+		if err != nil {
+			return err
+		}
+		iterations, err := algoIntArg(args, "iterations", 20)
+		if err != nil {
+			return err
+		}
+		tolerance, err := algoFloatArg(args, "tolerance", 1e-6, 0, math.MaxFloat64)
+		if err != nil {
+			return err
+		}
+		for i, rank := range algo.PageRank(g, damping, int(iterations), tolerance) {
+			vals.Set(g.Uids[i], types.Val{Tid: types.FloatID, Value: rank})
+		}
+	case algoWCC:
+		setInts(algo.WeaklyConnectedComponents(g))
+	case algoSCC:
+		setInts(algo.StronglyConnectedComponents(g))
+	case algoLabelPropagation:
+		iterations, err := algoIntArg(args, "iterations", 10)
+		if err != nil {
+			return err
+		}
+		setInts(algo.LabelPropagation(g, int(iterations)))
+	case algoTriangleCount:
+		for i, count := range algo.TriangleCount(g) {
+			vals.Set(g.Uids[i], types.Val{Tid: types.IntID, Value: count})
+		}
+	}
+
+	sg.Params.UidToVal = vals
+	sg.DestUIDs = &pb.List{Uids: g.Uids}
+	sg.uidMatrix = []*pb.List{sg.DestUIDs}
+	return nil
+}
+
+// loadGraph processes the root of sg and then follows the children of sg level by level, like
+// recurse does, collecting the edges between the nodes into an algo.Graph.
+func (sg *SubGraph) loadGraph(ctx context.Context, depth uint64) (*algo.Graph, error) {
+	children := sg.Children
+	// The children only tell which edges to follow, they aren't part of the result.
+	sg.Children = nil
+
+	rch := make(chan error, 1)
+	ProcessGraph(ctx, sg, nil, rch)
+	if err := <-rch; err != nil {
+		return nil, err
+	}
+
+	edges := make(map[uint64][]uint64)
+	seen := make(map[uint64]struct{})
+	for _, uid := range sg.DestUIDs.GetUids() {
+		seen[uid] = struct{}{}
+	}
+	frontier := sg.DestUIDs.GetUids()
+	var numEdges uint64
+	dummy := &SubGraph{}
+	for level := uint64(0); level < depth && len(frontier) > 0; level++ {
+		exec := make([]*SubGraph, 0, len(children))
+		for _, child := range children {
+			c := new(SubGraph)
+			c.copyFiltersRecurse(child)
+			c.SrcUIDs = &pb.List{Uids: frontier}
+			exec = append(exec, c)
+		}
+		rrch := make(chan error, len(exec))
+		for _, c := range exec {
+			go ProcessGraph(ctx, c, dummy, rrch)
+		}
+		var processErr error
+		for range exec {
+			select {
+			case err := <-rrch:
+				if err != nil && processErr == nil {
+					processErr = err
+				}
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		if processErr != nil {
+			return nil, processErr
+		}
+
+		var next []uint64
+		for _, c := range exec {
+			if c.UnknownAttr {
+				continue
+			}
+			c.updateUidMatrix()
+			for mIdx, from := range c.SrcUIDs.Uids {
+				if mIdx >= len(c.uidMatrix) {
+					continue
+				}
+				for _, to := range c.uidMatrix[mIdx].Uids {
+					edges[from] = append(edges[from], to)
+					numEdges++
+					if _, ok := seen[to]; !ok {
+						seen[to] = struct{}{}
+						next = append(next, to)
+					}
+				}
+			}
+		}
+		if numEdges > x.Config.LimitQueryEdge {
+			return nil, errors.Errorf("Exceeded query edge limit = %v. Found %v edges.",
+				x.Config.LimitQueryEdge, numEdges)
+		}
+		sort.Slice(next, func(i, j int) bool { return next[i] < next[j] })
+		frontier = next
+	}
+
+	uids := make([]uint64, 0, len(seen))
+	for uid := range seen {
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	g := algo.NewGraph(uids)
+	for from, out := range edges {
+		fi := g.Index(from)
+		for _, to := range out {
+			g.AddEdge(fi, g.Index(to))
+		}
+	}
+	return g, nil
+}
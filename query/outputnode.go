@@ -471,7 +471,9 @@ func (enc *encoder) AddValue(fj fastJsonNode, attr uint16, v types.Val) error {
 }
 
 func (enc *encoder) AddListValue(fj fastJsonNode, attr uint16, v types.Val, list bool) error {
-	if v.Tid == types.VFloatID {
+	// A vector is encoded as a list of floats, but each vector of a list of vectors is encoded
+	// as a whole.
+	if v.Tid.IsVector() && !list {
 		for _, f := range v.Value.([]float32) {
 			bs := []byte(strconv.FormatFloat(float64(f), 'E', -1, 32))
 			sn, err := enc.makeScalarNode(attr, bs, true)
@@ -688,7 +690,7 @@ func valToBytes(v types.Val) ([]byte, error) {
 		return []byte(fmt.Sprintf("\"%#x\"", v.Value)), nil
 	case types.PasswordID:
 		return []byte(fmt.Sprintf("%q", v.Value.(string))), nil
-	case types.VFloatID:
+	case types.VFloatID, types.VFloat16ID, types.VInt8ID, types.VBinaryID:
 		return json.Marshal(v.Value.([]float32))
 	default:
 		return nil, errors.New("Unsupported types.Val.Tid")
@@ -1175,6 +1177,7 @@ type Extensions struct {
 	Latency *api.Latency    `json:"server_latency,omitempty"`
 	Txn     *api.TxnContext `json:"txn,omitempty"`
 	Metrics *api.Metrics    `json:"metrics,omitempty"`
+	Explain *Explain        `json:"explain,omitempty"`
 }
 
 func (sg *SubGraph) toFastJSON(ctx context.Context, l *Latency, field gqlSchema.Field) ([]byte,
@@ -1288,6 +1291,38 @@ func (sg *SubGraph) addCount(enc *encoder, count uint64, dst fastJsonNode) error
 	return enc.AddValue(dst, enc.idForAttr(fieldName), c)
 }
 
+// addHistory adds the value timeline of history(pred), one object per version holding its commit
+// timestamp and the values of the predicate after it.
+func (sg *SubGraph) addHistory(enc *encoder, h *pb.ValueHistory, dst fastJsonNode) error {
+	if sg.Params.Normalize && sg.Params.Alias == "" {
+		return nil
+	}
+	fieldName := sg.Params.Alias
+	if fieldName == "" {
+		fieldName = fmt.Sprintf("history(%s)", sg.Attr)
+	}
+	fieldID := enc.idForAttr(fieldName)
+	for _, v := range h.Versions {
+		n := enc.newNode(fieldID)
+		ts := types.ValueForType(types.IntID)
+		ts.Value = int64(v.CommitTs)
+		if err := enc.AddValue(n, enc.idForAttr("ts"), ts); err != nil {
+			return err
+		}
+		for _, tv := range v.Values {
+			sv, err := convertWithBestEffort(tv, sg.Attr)
+			if err != nil {
+				return err
+			}
+			if err := enc.AddListValue(n, enc.idForAttr("value"), sv, sg.List); err != nil {
+				return err
+			}
+		}
+		enc.AddListChild(dst, n)
+	}
+	return nil
+}
+
 func (sg *SubGraph) aggWithVarFieldName() string {
 	if sg.Params.Alias != "" {
 		return sg.Params.Alias
@@ -1403,6 +1438,13 @@ func (sg *SubGraph) preTraverse(enc *encoder, uid uint64, dst fastJsonNode) erro
 				return err
 			}
 
+		case pc.Params.DoHistory:
+			if idx < len(pc.historyMatrix) {
+				if err := pc.addHistory(enc, pc.historyMatrix[idx], dst); err != nil {
+					return err
+				}
+			}
+
 		case pc.SrcFunc != nil && pc.SrcFunc.Name == "checkpwd":
 			if err := pc.addCheckPwd(enc, pc.valueMatrix[idx].Values, dst); err != nil {
 				return err
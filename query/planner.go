@@ -0,0 +1,194 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package query
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/hypermodeinc/dgraph/v25/algo"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// unknownCost is used for the SubGraphs for which we couldn't get an estimate.
+const unknownCost = math.MaxUint64
+
+// estimateCost returns the estimated number of uids that the SubGraph would return. For filter
+// SubGraphs that only hold an operator, the estimates of the children are combined.
+func estimateCost(ctx context.Context, sg *SubGraph) uint64 {
+	if sg.SrcFunc == nil {
+		return estimateOpCost(ctx, sg)
+	}
+	if sg.SrcFunc.Name == "uid" && len(sg.Params.NeedsVar) == 0 && sg.SrcUIDs != nil {
+		return uint64(len(sg.SrcUIDs.Uids))
+	}
+	if sg.SrcFunc.IsValueVar || sg.SrcFunc.IsLenVar || len(sg.Params.NeedsVar) > 0 {
+		return unknownCost
+	}
+	taskQuery, err := createTaskQuery(ctx, sg)
+	if err != nil {
+		return unknownCost
+	}
+	// The uid list isn't needed to estimate the function and might not be populated yet.
+	taskQuery.UidList = nil
+	if est, ok := worker.EstimateCost(ctx, taskQuery); ok {
+		return est
+	}
+	return unknownCost
+}
+
+func estimateOpCost(ctx context.Context, sg *SubGraph) uint64 {
+	if len(sg.Filters) == 0 {
+		return unknownCost
+	}
+	switch sg.FilterOp {
+	case "and", "":
+		// A conjunction can't return more than its most selective filter.
+		cost := uint64(unknownCost)
+		for _, f := range sg.Filters {
+			if c := estimateCost(ctx, f); c < cost {
+				cost = c
+			}
+		}
+		return cost
+	case "or":
+		var cost uint64
+		for _, f := range sg.Filters {
+			c := estimateCost(ctx, f)
+			if c == unknownCost {
+				return unknownCost
+			}
+			cost += c
+		}
+		return cost
+	}
+	return unknownCost
+}
+
+// planFilters returns the filters of sg ordered from the most to the least selective one, based
+// on the predicate statistics. It returns nil if the filters should be run in parallel as
+// written, that is when the planner is disabled, the filters are not a conjunction or there
+// isn't enough information to order them.
+func (sg *SubGraph) planFilters(ctx context.Context) []*SubGraph {
+	if !x.Config.QueryPlanner || sg.FilterOp != "and" || len(sg.Filters) < 2 {
+		return nil
+	}
+
+	type plannedFilter struct {
+		sg   *SubGraph
+		cost uint64
+	}
+	planned := make([]plannedFilter, 0, len(sg.Filters))
+	known := 0
+	for _, f := range sg.Filters {
+		cost := estimateCost(ctx, f)
+		if cost != unknownCost {
+			known++
+		}
+		planned = append(planned, plannedFilter{sg: f, cost: cost})
+	}
+	if known == 0 {
+		return nil
+	}
+
+	// Filters without an estimate keep their relative order and are run last.
+	sort.SliceStable(planned, func(i, j int) bool {
+		return planned[i].cost < planned[j].cost
+	})
+	out := make([]*SubGraph, 0, len(planned))
+	for _, p := range planned {
+		out = append(out, p.sg)
+	}
+	return out
+}
+
+// processFiltersInOrder runs the given conjunctive filters one after the other, each filter
+// only looking at the uids that passed the previous ones. It stops early once no uid is left.
+func (sg *SubGraph) processFiltersInOrder(ctx context.Context, filters []*SubGraph) error {
+	cur := sg.DestUIDs
+	for _, filter := range filters {
+		if len(cur.Uids) == 0 {
+			filter.DestUIDs = &pb.List{}
+			continue
+		}
+		isUidFuncWithoutVar := filter.SrcFunc != nil && filter.SrcFunc.Name == "uid" &&
+			len(filter.Params.NeedsVar) == 0
+		if isUidFuncWithoutVar {
+			filter.DestUIDs = filter.SrcUIDs
+		} else {
+			filter.SrcUIDs = cur
+			filter.Params.ParentVars = sg.Params.ParentVars
+			filterChan := make(chan error, 1)
+			ProcessGraph(ctx, filter, sg, filterChan)
+			if err := <-filterChan; err != nil {
+				return err
+			}
+		}
+		cur = algo.IntersectSorted([]*pb.List{cur, filter.DestUIDs})
+	}
+	sg.DestUIDs = cur
+	return nil
+}
+
+// planRootFunction swaps the root function of sg with one of its conjunctive filters if the
+// statistics say that the filter is more selective. Both the function and the filter are
+// evaluated anyway and their results intersected, so the results don't change, but starting
+// from the smaller set means that the filter has less uids to look at.
+func (sg *SubGraph) planRootFunction(ctx context.Context) {
+	if !x.Config.QueryPlanner || !isPlannableFunc(sg) || sg.Params.DoCount {
+		return
+	}
+
+	var candidates []*SubGraph
+	switch {
+	case len(sg.Filters) != 1:
+		return
+	case sg.Filters[0].SrcFunc != nil:
+		candidates = sg.Filters
+	case sg.Filters[0].FilterOp == "and":
+		candidates = sg.Filters[0].Filters
+	}
+
+	rootCost := estimateCost(ctx, sg)
+	if rootCost == unknownCost {
+		return
+	}
+	var best *SubGraph
+	bestCost := rootCost
+	for _, c := range candidates {
+		if !isPlannableFunc(c) || len(c.Filters) > 0 {
+			continue
+		}
+		if cost := estimateCost(ctx, c); cost < bestCost {
+			best, bestCost = c, cost
+		}
+	}
+	if best == nil {
+		return
+	}
+
+	sg.Attr, best.Attr = best.Attr, sg.Attr
+	sg.SrcFunc, best.SrcFunc = best.SrcFunc, sg.SrcFunc
+	sg.Params.Langs, best.Params.Langs = best.Params.Langs, sg.Params.Langs
+}
+
+// isPlannableFunc tells whether the function of sg can be moved between the root and a filter.
+func isPlannableFunc(sg *SubGraph) bool {
+	f := sg.SrcFunc
+	if f == nil || f.IsCount || f.IsValueVar || f.IsLenVar || len(sg.Params.NeedsVar) > 0 ||
+		len(sg.Attr) == 0 || sg.Attr[0] == '~' {
+		return false
+	}
+	switch f.Name {
+	case "eq", "le", "ge", "lt", "gt", "between",
+		"anyofterms", "allofterms", "anyoftext", "alloftext":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/algo"
+	"github.com/hypermodeinc/dgraph/v25/dql"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// initPlanner enables the planner with the statistics saying that each value of planName matches
+// as many uids as its length, and each term of planBio 100 uids.
+func initPlanner(t *testing.T) context.Context {
+	ps, err := badger.OpenManaged(badger.DefaultOptions(t.TempDir()).WithLogger(nil))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, ps.Close()) })
+	posting.Init(ps, 0, false)
+	require.NoError(t, schema.ParseBytes([]byte(`
+		planName: string @index(exact) .
+		planBio: string @index(term) .`), 1))
+
+	record := func(attr, name, val string, count uint64) {
+		tokenizer, ok := tok.GetTokenizer(name)
+		require.True(t, ok)
+		tokens, err := tok.BuildTokens(val, tokenizer)
+		require.NoError(t, err)
+		for _, token := range tokens {
+			posting.GetStatsHolder().InsertRecord(x.AttrInRootNamespace(attr), []byte(token), count)
+		}
+	}
+	for _, name := range []string{"al", "bob", "carol", "dorothy"} {
+		record("planName", "exact", name, uint64(len(name)))
+	}
+	record("planBio", "term", "graph", 100)
+
+	planner := x.Config.QueryPlanner
+	x.Config.QueryPlanner = true
+	t.Cleanup(func() { x.Config.QueryPlanner = planner })
+	return x.AttachNamespace(context.Background(), x.RootNamespace)
+}
+
+func eqName(name string) *SubGraph {
+	return &SubGraph{Attr: "planName", ReadTs: 1,
+		SrcFunc: &Function{Name: "eq", Args: []dql.Arg{{Value: name}}}}
+}
+
+func uidFunc(uids ...uint64) *SubGraph {
+	return &SubGraph{Attr: "uid", SrcFunc: &Function{Name: "uid"}, SrcUIDs: &pb.List{Uids: uids}}
+}
+
+func TestPlanFilters(t *testing.T) {
+	ctx := initPlanner(t)
+
+	unknown := eqName("eve")
+	carol, al, few := eqName("carol"), eqName("al"), uidFunc(1, 2, 3, 4)
+	sg := &SubGraph{FilterOp: "and", Filters: []*SubGraph{unknown, carol, al, few}}
+	// The filters without an estimate are run last.
+	require.Equal(t, []*SubGraph{al, few, carol, unknown}, sg.planFilters(ctx))
+
+	// Without any estimate, the filters run in parallel as written.
+	require.Nil(t, (&SubGraph{FilterOp: "and",
+		Filters: []*SubGraph{unknown, eqName("frank")}}).planFilters(ctx))
+	// Only a conjunction can stop early.
+	require.Nil(t, (&SubGraph{FilterOp: "or", Filters: sg.Filters}).planFilters(ctx))
+
+	x.Config.QueryPlanner = false
+	require.Nil(t, sg.planFilters(ctx))
+}
+
+func TestProcessFiltersInOrder(t *testing.T) {
+	ctx := initPlanner(t)
+
+	filters := func() []*SubGraph {
+		return []*SubGraph{uidFunc(1, 2, 3, 5, 8), uidFunc(2, 3, 5, 7), uidFunc(3, 5, 7, 9)}
+	}
+	// The unplanned path intersects the results of all the filters.
+	var results []*pb.List
+	for _, f := range filters() {
+		results = append(results, f.SrcUIDs)
+	}
+	want := algo.IntersectSorted(append(results, &pb.List{Uids: []uint64{1, 2, 3, 4, 5}}))
+
+	sg := &SubGraph{FilterOp: "and", Filters: filters(),
+		DestUIDs: &pb.List{Uids: []uint64{1, 2, 3, 4, 5}}}
+	planned := sg.planFilters(ctx)
+	require.Equal(t, []uint64{2, 3, 5, 7}, planned[0].SrcUIDs.Uids)
+	require.NoError(t, sg.processFiltersInOrder(ctx, planned))
+	require.Equal(t, want.Uids, sg.DestUIDs.Uids)
+	require.Equal(t, []uint64{3, 5}, sg.DestUIDs.Uids)
+
+	// Once no uid is left, the next filters aren't run.
+	sg = &SubGraph{FilterOp: "and", DestUIDs: &pb.List{Uids: []uint64{1, 4}}}
+	last := eqName("al")
+	require.NoError(t, sg.processFiltersInOrder(ctx, []*SubGraph{uidFunc(2, 3), last}))
+	require.Empty(t, sg.DestUIDs.Uids)
+	require.Empty(t, last.DestUIDs.Uids)
+	require.Nil(t, last.SrcUIDs)
+}
+
+func TestPlanRootFunction(t *testing.T) {
+	ctx := initPlanner(t)
+
+	bio := func() *SubGraph {
+		return &SubGraph{Attr: "planBio", ReadTs: 1, Params: params{Langs: []string{"en"}},
+			SrcFunc: &Function{Name: "anyofterms", Args: []dql.Arg{{Value: "graph"}}}}
+	}
+
+	// The root starts from the most selective function, the other one filters its uids. Both
+	// are still evaluated, so the results are the same.
+	sg, filter := bio(), eqName("bob")
+	sg.Filters = []*SubGraph{filter}
+	sg.planRootFunction(ctx)
+	require.Equal(t, "planName", sg.Attr)
+	require.Equal(t, "eq", sg.SrcFunc.Name)
+	require.Equal(t, "bob", sg.SrcFunc.Args[0].Value)
+	require.Empty(t, sg.Params.Langs)
+	require.Equal(t, "planBio", filter.Attr)
+	require.Equal(t, "anyofterms", filter.SrcFunc.Name)
+	require.Equal(t, []string{"en"}, filter.Params.Langs)
+
+	// The most selective filter of a conjunction is picked.
+	sg, bob, al := bio(), eqName("bob"), eqName("al")
+	sg.Filters = []*SubGraph{{FilterOp: "and", Filters: []*SubGraph{bob, al}}}
+	sg.planRootFunction(ctx)
+	require.Equal(t, "al", sg.SrcFunc.Args[0].Value)
+	require.Equal(t, "bob", bob.SrcFunc.Args[0].Value)
+	require.Equal(t, "planBio", al.Attr)
+
+	// The root function is already the most selective one.
+	sg, filter = eqName("al"), bio()
+	sg.Filters = []*SubGraph{filter}
+	sg.planRootFunction(ctx)
+	require.Equal(t, "planName", sg.Attr)
+	require.Equal(t, "planBio", filter.Attr)
+
+	// The filters of a disjunction don't restrict the root.
+	sg = bio()
+	sg.Filters = []*SubGraph{{FilterOp: "or", Filters: []*SubGraph{eqName("al"), eqName("bob")}}}
+	sg.planRootFunction(ctx)
+	require.Equal(t, "planBio", sg.Attr)
+
+	// A count at the root counts the uids of the root function.
+	sg = bio()
+	sg.Params.DoCount = true
+	sg.Filters = []*SubGraph{eqName("al")}
+	sg.planRootFunction(ctx)
+	require.Equal(t, "planBio", sg.Attr)
+}
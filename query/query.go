@@ -108,6 +108,8 @@ type params struct {
 	AfterUID uint64
 	// DoCount is true if the count of the predicate is requested instead of its value.
 	DoCount bool
+	// DoHistory is true if the timeline of the values of the predicate is requested.
+	DoHistory bool
 	// GetUid is true if the uid should be returned. Used for debug requests.
 	GetUid bool
 	// Order is the list of predicates to sort by and their sort order.
@@ -154,6 +156,8 @@ type params struct {
 	Cascade *CascadeArgs
 	// IgnoreReflex is true if the @ignorereflex directive is specified.
 	IgnoreReflex bool
+	// Algo stores the graph algorithm to run if the @algo directive is specified.
+	Algo *dql.AlgoArgs
 
 	// ShortestPathArgs contains the from and to functions to execute a shortest path query.
 	ShortestPathArgs dql.ShortestPathArgs
@@ -171,6 +175,20 @@ type params struct {
 	// During shortest path computation. This prevents out-of-memory errors on large graphs
 	// but may affect solution optimality if set too low.
 	MaxFrontierSize int64
+	// ShortestAlgorithm is the algorithm used to find the shortest path, one of dijkstra (the
+	// default), bidirectional or astar.
+	ShortestAlgorithm string
+	// ShortestWeightVar is the value variable holding the cost of reaching each node. When set, it
+	// is used as the cost of the edges instead of their facets. Reaching a node without a value
+	// costs 1, like following an edge without a facet.
+	ShortestWeightVar string
+	// ShortestWeights holds the values of ShortestWeightVar.
+	ShortestWeights map[uint64]float64
+	// ShortestHeuristic is the value variable or the geo predicate used by the A* algorithm to
+	// estimate the remaining cost from a node to the destination.
+	ShortestHeuristic string
+	// ShortestHeuristicVals holds the values of ShortestHeuristic if it is a value variable.
+	ShortestHeuristicVals map[uint64]float64
 
 	// ExploreDepth is used by recurse and shortest path queries to specify the maximum graph
 	// depth to explore.
@@ -248,6 +266,7 @@ type Function struct {
 //	    valueMatrix: [["Foo"]]
 type SubGraph struct {
 	ReadTs      uint64
+	AsOf        bool // Set if ReadTs is the timestamp of an @asof directive.
 	Cache       int
 	Attr        string
 	UnknownAttr bool
@@ -258,6 +277,9 @@ type SubGraph struct {
 	// count stores the count of an edge (predicate). There would be one value corresponding to each
 	// uid in SrcUIDs.
 	counts []uint32
+	// historyMatrix stores the timeline of the values of the predicate asked for with history().
+	// There would be one entry corresponding to each uid in SrcUIDs.
+	historyMatrix []*pb.ValueHistory
 	// valueMatrix is a slice of ValueList. If this SubGraph is for a scalar predicate type, then
 	// there would be one list for each uid in SrcUIDs storing the value of the predicate.
 	// The individual elements of the slice are a ValueList because we support scalar predicates
@@ -295,6 +317,12 @@ type SubGraph struct {
 	pathMeta *pathMetadata
 
 	vectorMetrics map[string]uint64
+	// fnScores holds the score of every uid returned by a scoring function, like the BM25 score
+	// of search, so that the block can be used as a value variable.
+	fnScores *types.ShardedMap
+
+	// profile is only set when the query is being explained.
+	profile *sgProfile
 }
 
 func (sg *SubGraph) recurse(set func(sg *SubGraph)) {
@@ -464,6 +492,9 @@ func filterCopy(sg *SubGraph, ft *dql.FilterTree) error {
 		if !isValidFuncName(ft.Func.Name) {
 			return errors.Errorf("Invalid function name: %s", ft.Func.Name)
 		}
+		if ft.Func.Name == fuseFn {
+			return errors.Errorf("fuse function can only be used at root")
+		}
 
 		if isUidFnWithoutVar(ft.Func) {
 			sg.SrcFunc = &Function{Name: ft.Func.Name}
@@ -590,6 +621,7 @@ func treeCopy(gq *dql.GraphQuery, sg *SubGraph) error {
 			}
 			args.DoCount = true
 		}
+		args.DoHistory = gchild.IsHistory
 
 		for argk := range gchild.Args {
 			if !isValidArg(argk) {
@@ -730,6 +762,29 @@ func (args *params) fill(gq *dql.GraphQuery) error {
 			args.MaxFrontierSize = math.MaxInt64
 		}
 
+		switch v := gq.Args["algorithm"]; v {
+		case "", shortestDijkstra, shortestBidirectional, shortestAStar:
+			args.ShortestAlgorithm = v
+		default:
+			return errors.Errorf("Invalid shortest path algorithm: %q. Expected one of %s, %s or %s",
+				v, shortestDijkstra, shortestBidirectional, shortestAStar)
+		}
+		if v, ok := gq.Args["weight"]; ok {
+			if !isValueVarArg(gq.NeedsVar, v) {
+				return errors.Errorf("weight for shortest path should be a value variable, "+
+					"like weight: val(%s)", v)
+			}
+			args.ShortestWeightVar = v
+		}
+		if v, ok := gq.Args["heuristic"]; ok {
+			if args.ShortestAlgorithm != shortestAStar {
+				return errors.Errorf("heuristic can only be used with algorithm: %s", shortestAStar)
+			}
+			args.ShortestHeuristic = v
+		} else if args.ShortestAlgorithm == shortestAStar {
+			return errors.Errorf("algorithm: %s needs a heuristic", shortestAStar)
+		}
+
 		if gq.ShortestPathArgs.From == nil || gq.ShortestPathArgs.To == nil {
 			return errors.Errorf("from/to can't be nil for shortest path")
 		}
@@ -770,6 +825,8 @@ type ContextKey int
 const (
 	// DebugKey is the key used to toggle debug mode.
 	DebugKey ContextKey = iota
+	// ExplainKey is the key used to attach an *Explain to be filled while processing a query.
+	ExplainKey
 )
 
 func isDebug(ctx context.Context) bool {
@@ -818,6 +875,7 @@ func newGraph(ctx context.Context, gq *dql.GraphQuery) (*SubGraph, error) {
 		Order:            gq.Order,
 		ParentVars:       make(map[string]varValue),
 		Recurse:          gq.Recurse,
+		Algo:             gq.Algo,
 		RecurseArgs:      gq.RecurseArgs,
 		ShortestPathArgs: gq.ShortestPathArgs,
 		Var:              gq.Var,
@@ -955,10 +1013,12 @@ func createTaskQuery(ctx context.Context, sg *SubGraph) (*pb.Query, error) {
 		Offset:    int32(sg.Params.Offset),
 		Count:     int32(sg.Params.Count),
 		ReadTs:    sg.ReadTs,
+		AsOf:      sg.AsOf,
 	}
 
 	out := &pb.Query{
 		ReadTs:       sg.ReadTs,
+		AsOf:         sg.AsOf,
 		Cache:        int32(sg.Cache),
 		Attr:         x.NamespaceAttr(namespace, attr),
 		Langs:        sg.Params.Langs,
@@ -966,6 +1026,7 @@ func createTaskQuery(ctx context.Context, sg *SubGraph) (*pb.Query, error) {
 		SrcFunc:      srcFunc,
 		AfterUid:     sg.Params.AfterUID,
 		DoCount:      len(sg.Filters) == 0 && sg.Params.DoCount,
+		DoHistory:    sg.Params.DoHistory,
 		FacetParam:   sg.Params.Facet,
 		FacetsFilter: sg.facetsFilter,
 		ExpandAll:    sg.Params.ExpandAll,
@@ -1552,6 +1613,22 @@ func (sg *SubGraph) populateUidValVar(doneVars map[string]varValue, sgPath []*Su
 	var ok bool
 
 	switch {
+	case sg.Params.Algo != nil && sg.Params.UidToVal != nil:
+		// The result of a graph algorithm is both a uid and a value variable, holding the nodes
+		// the algorithm ran over and their score.
+		doneVars[sg.Params.Var] = varValue{
+			Uids: sg.DestUIDs,
+			Vals: sg.Params.UidToVal,
+			path: sgPath,
+		}
+	case sg.fnScores != nil:
+		// The result of a scoring function is both a uid and a value variable, holding the
+		// nodes that matched and their score.
+		doneVars[sg.Params.Var] = varValue{
+			Uids: sg.DestUIDs,
+			Vals: sg.fnScores,
+			path: sgPath,
+		}
 	case len(sg.counts) > 0:
 		// 1. When count of a predicate is assigned a variable, we store the mapping of uid =>
 		// count(predicate).
@@ -1794,9 +1871,58 @@ func (sg *SubGraph) fillShortestPathVars(mp map[string]varValue) error {
 			sg.Params.To = uidVar.Uids.Uids[0]
 		}
 	}
+
+	if sg.Params.ShortestWeightVar != "" {
+		weights, err := shortestPathCosts(mp, sg.Params.ShortestWeightVar)
+		if err != nil {
+			return err
+		}
+		sg.Params.ShortestWeights = weights
+	}
+	if h := sg.Params.ShortestHeuristic; h != "" && isValueVarArg(sg.Params.NeedsVar, h) {
+		vals, err := shortestPathCosts(mp, h)
+		if err != nil {
+			return err
+		}
+		sg.Params.ShortestHeuristicVals = vals
+	}
 	return nil
 }
 
+// shortestPathCosts reads the values of the value variable name as costs, which can't be negative.
+func shortestPathCosts(mp map[string]varValue, name string) (map[uint64]float64, error) {
+	costs := make(map[uint64]float64)
+	l, ok := mp[name]
+	if !ok {
+		return nil, errors.Errorf("value of var(%s) should have already been populated", name)
+	}
+	err := l.Vals.Iterate(func(uid uint64, v types.Val) error {
+		cost, err := floatValue(v)
+		if err != nil {
+			return errors.Wrapf(err, "while reading the cost of uid %#x from var(%s)", uid, name)
+		}
+		if cost < 0 || math.IsNaN(cost) {
+			return errors.Errorf("cost of uid %#x in var(%s) should be a non-negative number. Got: %v",
+				uid, name, cost)
+		}
+		costs[uid] = cost
+		return nil
+	})
+	return costs, err
+}
+
+// floatValue returns the number held by the value of a variable, which is already converted to
+// its type, unlike the values read from the store.
+func floatValue(v types.Val) (float64, error) {
+	switch v.Tid {
+	case types.IntID:
+		return float64(v.Value.(int64)), nil
+	case types.FloatID:
+		return v.Value.(float64), nil
+	}
+	return 0, errors.Errorf("Expected a number, got a value of type %s", v.Tid.Name())
+}
+
 // fillVars reads the value corresponding to a variable from the map mp and stores it inside
 // SubGraph. This value is then later used for execution of the SubGraph.
 func (sg *SubGraph) fillVars(mp map[string]varValue) error {
@@ -1805,6 +1931,9 @@ func (sg *SubGraph) fillVars(mp map[string]varValue) error {
 			return err
 		}
 	}
+	if sg.SrcFunc != nil && sg.SrcFunc.Name == fuseFn {
+		return sg.fillFuseScores(mp)
+	}
 
 	var lists []*pb.List
 	// Go through all the variables in NeedsVar and see if we have a value for them in the map. If
@@ -2023,6 +2152,7 @@ func recursiveCopy(dst *SubGraph, src *SubGraph) {
 
 	dst.copyFiltersRecurse(src)
 	dst.ReadTs = src.ReadTs
+	dst.AsOf = src.AsOf
 
 	for _, c := range src.Children {
 		copyChild := new(SubGraph)
@@ -2108,6 +2238,7 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 			// Convert attribute name for the given namespace.
 			temp := &SubGraph{
 				ReadTs: sg.ReadTs,
+				AsOf:   sg.AsOf,
 				Attr:   x.ParseAttr(pred),
 			}
 			temp.Params = child.Params
@@ -2161,6 +2292,22 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 	stop := x.SpanTimer(span, "query.ProcessGraph"+suffix)
 	defer stop()
 
+	if explainFromContext(ctx) != nil {
+		sg.profile = &sgProfile{}
+		start := time.Now()
+		// Record the duration before the parent gets to know that we are done.
+		out := rch
+		rch = make(chan error, 1)
+		defer func() {
+			select {
+			case err := <-rch:
+				sg.profile.duration = time.Since(start)
+				out <- err
+			default:
+			}
+		}()
+	}
+
 	if sg.Attr == "uid" {
 		// We dont need to call ProcessGraph for uid, as we already have uids
 		// populated from parent and there is nothing to process but uidMatrix
@@ -2171,6 +2318,10 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 	}
 	var err error
 	switch {
+	case parent == nil && sg.SrcFunc != nil && sg.SrcFunc.Name == fuseFn:
+		// I'm root and the variables I fuse have been ranked into my scores by fillVars.
+		sg.uidMatrix = []*pb.List{{Uids: append(sg.DestUIDs.GetUids()[:0:0],
+			sg.DestUIDs.GetUids()...)}}
 	case parent == nil && sg.SrcFunc != nil && sg.SrcFunc.Name == "uid":
 		// I'm root and I'm using some variable that has been populated.
 		// Retain the actual order in uidMatrix. But sort the destUids.
@@ -2247,12 +2398,18 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 				sg.DestUIDs.Uids = nil
 			}
 		default:
+			if parent == nil {
+				sg.planRootFunction(ctx)
+			}
 			taskQuery, err := createTaskQuery(ctx, sg)
 			if err != nil {
 				rch <- err
 				return
 			}
-			result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
+			if parent == nil {
+				sg.pushDownVectorFilter(ctx, taskQuery)
+			}
+			result, err := sg.processTask(ctx, taskQuery)
 			switch {
 			case err != nil && strings.Contains(err.Error(), worker.ErrNonExistentTabletMessage):
 				sg.UnknownAttr = true
@@ -2270,6 +2427,20 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			sg.LangTags = result.LangMatrix
 			sg.List = result.List
 			sg.vectorMetrics = result.VectorMetrics
+			sg.historyMatrix = result.HistoryMatrix
+			if sg.SrcFunc != nil && isScoringFunc(sg.SrcFunc.Name) {
+				// The values are the scores of the uids, not values of the predicate.
+				if sg.fnScores, err = scoresOf(result); err != nil {
+					rch <- err
+					return
+				}
+				sg.valueMatrix = nil
+			}
+
+			if sg.Params.DoHistory {
+				rch <- nil
+				return
+			}
 
 			if sg.Params.DoCount {
 				if len(sg.Filters) == 0 {
@@ -2303,8 +2474,13 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		}
 	}
 
-	// Run filters if any.
-	if len(sg.Filters) > 0 {
+	// Run the filters one after the other if the planner could order them by selectivity.
+	if planned := sg.planFilters(ctx); len(planned) > 0 {
+		if err = sg.processFiltersInOrder(ctx, planned); err != nil {
+			rch <- err
+			return
+		}
+	} else if len(sg.Filters) > 0 {
 		// Run all filters in parallel.
 		filterChan := make(chan error, len(sg.Filters))
 		for _, filter := range sg.Filters {
@@ -2420,6 +2596,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			sg.Children = append(sg.Children, &SubGraph{
 				Attr:   it.Attr,
 				ReadTs: sg.ReadTs,
+				AsOf:   sg.AsOf,
 				Params: params{
 					Alias:        it.Alias,
 					IgnoreResult: true,
@@ -2544,6 +2721,7 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 		Offset:    int32(sg.Params.Offset),
 		Count:     int32(sg.Params.Count),
 		ReadTs:    sg.ReadTs,
+		AsOf:      sg.AsOf,
 	}
 	result, err := worker.SortOverNetwork(ctx, sortMsg)
 	if err != nil {
@@ -2737,22 +2915,60 @@ func (sg *SubGraph) sortAndPaginateUsingVar(ctx context.Context) error {
 func isValidArg(a string) bool {
 	switch a {
 	case "numpaths", "from", "to", "orderasc", "orderdesc", "first", "offset", "after", "depth",
-		"minweight", "maxweight", "maxfrontiersize":
+		"minweight", "maxweight", "maxfrontiersize", "algorithm", "weight", "heuristic":
 		return true
 	}
 	return false
 }
 
+// isValueVarArg tells whether the argument value v was given as val(v).
+func isValueVarArg(needsVar []dql.VarContext, v string) bool {
+	for _, nv := range needsVar {
+		if nv.Name == v && nv.Typ == dql.ValueVar {
+			return true
+		}
+	}
+	return false
+}
+
 // isValidFuncName checks if fn passed is valid keyword.
 func isValidFuncName(f string) bool {
 	switch f {
 	case "anyofterms", "allofterms", "val", "regexp", "anyoftext", "alloftext",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to", "search",
+		fuseFn:
 		return true
 	}
 	return isInequalityFn(f) || types.IsGeoFunc(f)
 }
 
+// isScoringFunc tells whether the function f returns a score for every uid, along with the uids.
+func isScoringFunc(f string) bool {
+	return f == "search" || f == "similar_to"
+}
+
+// scoresOf returns the scores returned by a scoring function, which come as one value for every
+// uid of the first list of the UidMatrix.
+func scoresOf(result *pb.Result) (*types.ShardedMap, error) {
+	scores := types.NewShardedMap()
+	if len(result.UidMatrix) == 0 || len(result.ValueMatrix) == 0 {
+		return scores, nil
+	}
+	uids, vals := result.UidMatrix[0].Uids, result.ValueMatrix[0].Values
+	if len(uids) != len(vals) {
+		return nil, errors.Errorf("Got %d scores for %d uids", len(vals), len(uids))
+	}
+	for i, tv := range vals {
+		v, err := types.Convert(types.Val{Tid: types.BinaryID, Value: tv.Val},
+			types.TypeID(tv.ValType))
+		if err != nil {
+			return nil, err
+		}
+		scores.Set(uids[i], v)
+	}
+	return scores, nil
+}
+
 func isInequalityFn(f string) bool {
 	switch f {
 	case "eq", "le", "ge", "gt", "lt", "between":
@@ -2778,6 +2994,7 @@ func getNodeTypes(ctx context.Context, sg *SubGraph) ([]string, error) {
 		Attr:    "dgraph.type",
 		SrcUIDs: sg.DestUIDs,
 		ReadTs:  sg.ReadTs,
+		AsOf:    sg.AsOf,
 	}
 	taskQuery, err := createTaskQuery(ctx, temp)
 	if err != nil {
@@ -2838,6 +3055,17 @@ func UidToHex(uid uint64) string {
 	return fmt.Sprintf("%#x", uid)
 }
 
+// asOfTs checks that the timestamp of an @asof directive can be read at by a query reading at
+// readTs, and returns it. Whether it's within the history retention window is checked by the
+// alphas serving the predicates read.
+func asOfTs(ts, readTs uint64) (uint64, error) {
+	if ts > readTs {
+		return 0, errors.Errorf("@asof timestamp %d is newer than the read timestamp %d of the query",
+			ts, readTs)
+	}
+	return ts, nil
+}
+
 // Request wraps the state that is used when executing query.
 // Initially ReadTs, Cache and DQLQuery are set.
 // Subgraphs, Vars and Latency are filled when processing query.
@@ -2877,8 +3105,15 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 		if err != nil {
 			return errors.Wrapf(err, "while converting to subgraph")
 		}
+		readTs := req.ReadTs
+		if gq.AsOf != 0 {
+			if readTs, err = asOfTs(gq.AsOf, req.ReadTs); err != nil {
+				return err
+			}
+		}
 		sg.recurse(func(sg *SubGraph) {
-			sg.ReadTs = req.ReadTs
+			sg.ReadTs = readTs
+			sg.AsOf = gq.AsOf != 0
 			sg.Cache = req.Cache
 		})
 		span.AddEvent("Query parsed")
@@ -2966,6 +3201,10 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 				go func() {
 					errChan <- recurse(ctx, sg)
 				}()
+			case sg.Params.Algo != nil:
+				go func() {
+					errChan <- runGraphAlgo(ctx, sg)
+				}()
 			default:
 				go ProcessGraph(ctx, sg, nil, errChan)
 			}
@@ -3051,6 +3290,9 @@ func (req *Request) Process(ctx context.Context) (er ExecutionResult, err error)
 		calculateMetrics(sg, metrics)
 	}
 	er.Metrics = metrics
+	if e := explainFromContext(ctx); e != nil {
+		e.build(ctx, er.Subgraphs)
+	}
 	namespace, err := x.ExtractNamespace(ctx)
 	if err != nil {
 		return er, errors.Wrapf(err, "While processing query")
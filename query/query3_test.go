@@ -1775,6 +1775,158 @@ func TestShortestPath_filter2(t *testing.T) {
 	require.JSONEq(t, `{"data": { "me": []}}`, js)
 }
 
+func TestShortestPathBidirectional(t *testing.T) {
+	query := `
+		{
+			A as shortest(from:23, to:24, algorithm: bidirectional) {
+				friend
+			}
+
+			me(func: uid(A)) {
+				name
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `
+		{
+			"data": {
+				"_path_": [{
+					"uid": "0x17",
+					"_weight_": 2,
+					"friend": {"uid": "0x1", "friend": {"uid": "0x18"}}
+				}],
+				"me": [{"name": "Rick Grimes"}, {"name": "Michonne"}, {"name": "Glenn Rhee"}]
+			}
+		}`, js)
+}
+
+func TestShortestPathBidirectionalNeedsReverse(t *testing.T) {
+	query := `
+		{
+			shortest(from:1, to:1002, algorithm: bidirectional) {
+				path
+			}
+		}`
+	_, err := processQuery(context.Background(), t, query)
+	require.ErrorContains(t, err, "needs predicate path to have @reverse")
+}
+
+func TestShortestPathBidirectionalNumPaths(t *testing.T) {
+	query := `
+		{
+			shortest(from:1, to:24, numpaths: 2, algorithm: bidirectional) {
+				friend
+			}
+		}`
+	_, err := processQuery(context.Background(), t, query)
+	require.ErrorContains(t, err, "numpaths > 1 isn't supported")
+}
+
+func TestShortestPathInvalidAlgorithm(t *testing.T) {
+	query := `
+		{
+			shortest(from:1, to:24, algorithm: bfs) {
+				friend
+			}
+		}`
+	_, err := processQuery(context.Background(), t, query)
+	require.ErrorContains(t, err, "Invalid shortest path algorithm")
+}
+
+func TestShortestPathWeightVar(t *testing.T) {
+	query := `
+		{
+			var(func: has(age)) {
+				a as age
+			}
+
+			shortest(from:1, to:24, weight: val(a)) {
+				friend
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[{"uid":"0x1","_weight_":15,"friend":{"uid":"0x18"}}]}}`, js)
+}
+
+func TestShortestPathWeightVarDefaultCost(t *testing.T) {
+	// 0x65 has no age, so reaching it costs 1.
+	query := `
+		{
+			var(func: has(age)) {
+				a as age
+			}
+
+			shortest(from:1, to:101, weight: val(a)) {
+				friend
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[{"uid":"0x1","_weight_":1,"friend":{"uid":"0x65"}}]}}`, js)
+}
+
+func TestShortestPathNegativeWeightVar(t *testing.T) {
+	query := `
+		{
+			var(func: has(age)) {
+				a as age
+				n as math(-a)
+			}
+
+			shortest(from:1, to:24, weight: val(n)) {
+				friend
+			}
+		}`
+	_, err := processQuery(context.Background(), t, query)
+	require.ErrorContains(t, err, "should be a non-negative number")
+}
+
+func TestShortestPathAStarGeoHeuristic(t *testing.T) {
+	query := `
+		{
+			var(func: has(age)) {
+				a as age
+			}
+
+			shortest(from:1, to:24, algorithm: astar, heuristic: loc, weight: val(a)) {
+				friend
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[{"uid":"0x1","_weight_":15,"friend":{"uid":"0x18"}}]}}`, js)
+}
+
+func TestShortestPathAStarVarHeuristic(t *testing.T) {
+	query := `
+		{
+			var(func: has(age)) {
+				a as age
+				h as math(a - a)
+			}
+
+			shortest(from:23, to:24, algorithm: astar, heuristic: val(h)) {
+				friend
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[{"uid":"0x17","_weight_":2,"friend":{"uid":"0x1","friend":{"uid":"0x18"}}}]}}`,
+		js)
+}
+
+func TestShortestPathAStarNeedsHeuristic(t *testing.T) {
+	query := `
+		{
+			shortest(from:1, to:24, algorithm: astar) {
+				friend
+			}
+		}`
+	_, err := processQuery(context.Background(), t, query)
+	require.ErrorContains(t, err, "needs a heuristic")
+}
+
 func TestTwoShortestPathVariable(t *testing.T) {
 
 	query := `
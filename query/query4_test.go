@@ -1900,3 +1900,210 @@ func TestMultiplesSortingOrderWithVarAndPredicate(t *testing.T) {
 	_, err := processQuery(context.Background(), t, query)
 	require.ErrorContains(t, err, "Val() is not allowed in multiple sorting. Got: [SECTIONS_COUNT]")
 }
+
+func TestAlgoConnectedComponents(t *testing.T) {
+	query := `{
+		wcc as var(func: uid(23)) @algo(wcc) {
+			friend
+		}
+		scc as var(func: uid(23)) @algo(scc) {
+			friend
+		}
+
+		me(func: uid(wcc)) {
+			uid
+			wcc: val(wcc)
+			scc: val(scc)
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [
+		{"uid": "0x1", "wcc": 1, "scc": 1},
+		{"uid": "0x17", "wcc": 1, "scc": 1},
+		{"uid": "0x18", "wcc": 1, "scc": 24},
+		{"uid": "0x19", "wcc": 1, "scc": 25},
+		{"uid": "0x1f", "wcc": 1, "scc": 31},
+		{"uid": "0x65", "wcc": 1, "scc": 101}
+	]}}`, js)
+}
+
+func TestAlgoTriangleCountDepth(t *testing.T) {
+	query := `{
+		tc as var(func: uid(1)) @algo(trianglecount, depth: 2) {
+			friend
+		}
+
+		me(func: uid(tc)) @filter(gt(val(tc), 0)) {
+			name
+			val(tc)
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [
+		{"name": "Michonne", "val(tc)": 1},
+		{"name": "Glenn Rhee", "val(tc)": 1},
+		{"name": "Andrea", "val(tc)": 1}
+	]}}`, js)
+}
+
+func TestAlgoPageRank(t *testing.T) {
+	query := `{
+		rank as var(func: uid(1)) @algo(pagerank, damping: 0.85, iterations: 50) {
+			friend
+		}
+
+		me(func: uid(rank), orderdesc: val(rank), first: 1) {
+			name
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [{"name": "Glenn Rhee"}]}}`, js)
+}
+
+func TestAlgoInvalidArgs(t *testing.T) {
+	tests := []struct {
+		algo string
+		err  string
+	}{
+		{"closeness", `Unknown algorithm "closeness"`},
+		{"wcc, damping: 0.5", `Unexpected argument "damping"`},
+		{"pagerank, damping: 1.5", "damping inside @algo should be a number between 0 and 1"},
+		{"labelpropagation, iterations: 0", "iterations inside @algo should be a positive integer"},
+	}
+	for _, tc := range tests {
+		query := fmt.Sprintf(`{
+			r as var(func: uid(1)) @algo(%s) {
+				friend
+			}
+			me(func: uid(r)) {
+				uid
+			}
+		}`, tc.algo)
+		_, err := processQuery(context.Background(), t, query)
+		require.ErrorContains(t, err, tc.err, tc.algo)
+	}
+}
+
+func TestSearchBM25(t *testing.T) {
+	query := `{
+		score as var(func: search(tweet-e, "quick fox"))
+
+		me(func: uid(score), orderdesc: val(score)) {
+			uid
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [{"uid": "0x3e"}, {"uid": "0x40"}, {"uid": "0x3d"}]}}`, js)
+}
+
+func TestSearchBM25Filter(t *testing.T) {
+	query := `{
+		me(func: has(tweet-e)) @filter(search(tweet-e, "lazy dogs")) {
+			tweet-e
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [
+		{"tweet-e": "the quick brown fox and the quick dog"},
+		{"tweet-e": "a lazy dog sleeps"}
+	]}}`, js)
+}
+
+func TestSearchNotIndexed(t *testing.T) {
+	query := `{
+		me(func: search(tweet-c, "citizen")) {
+			uid
+		}
+	}`
+	_, err := processQuery(context.Background(), t, query)
+	require.ErrorContains(t, err, "Attribute tweet-c is not indexed with type bm25")
+}
+
+func TestFuseRRF(t *testing.T) {
+	query := `{
+		text as var(func: search(tweet-e, "quick fox"))
+		graph as var(func: uid(61, 63))
+		fused as var(func: fuse(rrf, text, graph, 0.5))
+
+		me(func: uid(fused), orderdesc: val(fused)) {
+			uid
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [{"uid": "0x3d"}, {"uid": "0x3e"}, {"uid": "0x40"},
+		{"uid": "0x3f"}]}}`, js)
+}
+
+func TestFuseWeighted(t *testing.T) {
+	// A negative weight ranks the smallest scores first.
+	query := `{
+		text as var(func: search(tweet-e, "quick fox"))
+		fused as var(func: fuse(weighted, text, -1))
+
+		me(func: uid(fused), orderdesc: val(fused)) {
+			uid
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [{"uid": "0x3d"}, {"uid": "0x40"}, {"uid": "0x3e"}]}}`, js)
+}
+
+func TestFuseInvalid(t *testing.T) {
+	query := `{
+		text as var(func: search(tweet-e, "quick fox"))
+		me(func: fuse(borda, text)) {
+			uid
+		}
+	}`
+	_, err := processQuery(context.Background(), t, query)
+	require.ErrorContains(t, err, `Invalid method "borda" in fuse function`)
+}
+
+func TestHistoryAndAsOf(t *testing.T) {
+	setSchema(testSchema + "\n audit-status: string .\n")
+	ctx := context.Background()
+	mutate := func(mu *api.Mutation) *api.Response {
+		mu.CommitNow = true
+		txn := client.NewTxn()
+		defer func() { _ = txn.Discard(ctx) }()
+		resp, err := txn.Mutate(ctx, mu)
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := mutate(&api.Mutation{SetNquads: []byte(`_:doc <audit-status> "draft" .`)})
+	uid, ts1 := resp.Uids["doc"], resp.Txn.CommitTs
+	ts2 := mutate(&api.Mutation{
+		SetNquads: []byte(fmt.Sprintf(`<%s> <audit-status> "approved" .`, uid)),
+	}).Txn.CommitTs
+	ts3 := mutate(&api.Mutation{
+		DelNquads: []byte(fmt.Sprintf(`<%s> <audit-status> * .`, uid)),
+	}).Txn.CommitTs
+
+	query := fmt.Sprintf(`{
+		then(func: uid(%s)) @asof(ts: %d) {
+			audit-status
+		}
+		now(func: uid(%s)) {
+			audit-status
+			history(audit-status)
+		}
+	}`, uid, ts1, uid)
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, fmt.Sprintf(`{"data": {
+		"then": [{"audit-status": "draft"}],
+		"now": [{"history(audit-status)": [
+			{"ts": %d, "value": "draft"},
+			{"ts": %d, "value": "approved"},
+			{"ts": %d}
+		]}]
+	}}`, ts1, ts2, ts3), js)
+
+	query = fmt.Sprintf(`{
+		me(func: uid(%s)) @asof(ts: 18446744073709551615) {
+			audit-status
+		}
+	}`, uid)
+	_, err := processQuery(ctx, t, query)
+	require.ErrorContains(t, err, "is newer than the read timestamp")
+}
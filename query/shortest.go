@@ -9,14 +9,20 @@ import (
 	"container/heap"
 	"context"
 	"math"
+	"sort"
+	"strings"
 	"sync"
 
+	"github.com/golang/geo/s2"
 	"github.com/pkg/errors"
+	"github.com/twpayne/go-geom"
 
 	"github.com/hypermodeinc/dgraph/v25/algo"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
 	"github.com/hypermodeinc/dgraph/v25/types"
 	"github.com/hypermodeinc/dgraph/v25/types/facets"
+	"github.com/hypermodeinc/dgraph/v25/worker"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
 
@@ -48,6 +54,13 @@ var pathPool = sync.Pool{
 	},
 }
 
+// Algorithms that can be used by a shortest path query through its algorithm argument.
+const (
+	shortestDijkstra      = "dijkstra"
+	shortestBidirectional = "bidirectional"
+	shortestAStar         = "astar"
+)
+
 var errStop = errors.Errorf("STOP")
 var errFacet = errors.Errorf("Skip the edge")
 
@@ -105,7 +118,7 @@ type nodeInfo struct {
 func (sg *SubGraph) getCost(matrix, list int) (cost float64,
 	fcs *pb.Facets, rerr error) {
 
-	cost = 1.0
+	cost = defaultShortestCost
 	if len(sg.facetsMatrix) <= matrix {
 		return cost, fcs, rerr
 	}
@@ -138,8 +151,16 @@ func (sg *SubGraph) getCost(matrix, list int) (cost float64,
 	return cost, fcs, rerr
 }
 
+// defaultShortestCost is the cost of an edge without a facet, or pointing to a node without a
+// value in the weight variable.
+const defaultShortestCost = 1.0
+
+// expandOut expands the graph one level at a time from sg.Params.From, every time a value is
+// received on next, filling the edges and their costs in adjacencyMap. If backward is set, the
+// children of sg follow the edges in reverse and an edge in adjacencyMap goes from the node closer
+// to the destination to the node closer to the source.
 func (sg *SubGraph) expandOut(ctx context.Context,
-	adjacencyMap map[uint64]map[uint64]mapItem, next chan bool, rch chan error) {
+	adjacencyMap map[uint64]map[uint64]mapItem, next chan bool, rch chan error, backward bool) {
 
 	var numEdges uint64
 	var exec []*SubGraph
@@ -214,6 +235,23 @@ func (sg *SubGraph) expandOut(ctx context.Context,
 							rch <- err
 							return
 						}
+						if sg.Params.ShortestWeights != nil {
+							// The cost of an edge is the cost of reaching the node it points to.
+							target := toUID
+							if backward {
+								target = fromUID
+							}
+							var ok bool
+							if cost, ok = sg.Params.ShortestWeights[target]; !ok {
+								cost = defaultShortestCost
+							}
+						}
+						if cost < 0 && sg.Params.ShortestAlgorithm != "" &&
+							sg.Params.ShortestAlgorithm != shortestDijkstra {
+							rch <- errors.Errorf("%s shortest path doesn't support negative weights."+
+								" Got: %v on predicate %s", sg.Params.ShortestAlgorithm, cost, subgraph.Attr)
+							return
+						}
 
 						// TODO - This simplify overrides the adjacency matrix. What happens if the
 						// cost along the second attribute is more than that along the first.
@@ -316,7 +354,7 @@ func runKShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	next := make(chan bool, 2)
 	expandErr := make(chan error, 2)
 	adjacencyMap := make(map[uint64]map[uint64]mapItem)
-	go sg.expandOut(ctx, adjacencyMap, next, expandErr)
+	go sg.expandOut(ctx, adjacencyMap, next, expandErr, false)
 
 	// In k shortest path we can't have this. We store the path till a node in every
 	// node.
@@ -469,8 +507,17 @@ func shortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	}
 
 	if numPaths > 1 {
+		if alg := sg.Params.ShortestAlgorithm; alg != "" && alg != shortestDijkstra {
+			return nil, errors.Errorf("numpaths > 1 isn't supported by the %s algorithm", alg)
+		}
 		return runKShortestPaths(ctx, sg)
 	}
+	switch sg.Params.ShortestAlgorithm {
+	case shortestBidirectional:
+		return bidirectionalShortestPath(ctx, sg)
+	case shortestAStar:
+		return astarShortestPath(ctx, sg)
+	}
 	pq := make(priorityQueue, 0)
 
 	// Initialize and push the source node.
@@ -496,7 +543,7 @@ func shortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	adjacencyMap := make(map[uint64]map[uint64]mapItem)
 	// TODO - Check if this goroutine actually improves performance. It doesn't look like it
 	// because we need to fill the adjacency map before we can make progress.
-	go sg.expandOut(ctx, adjacencyMap, next, expandErr)
+	go sg.expandOut(ctx, adjacencyMap, next, expandErr, false)
 
 	// map to store the min cost and parent of nodes.
 	dist := make(map[uint64]nodeInfo)
@@ -719,3 +766,421 @@ func createkroutesubgraph(ctx context.Context, kroutes []route) []*SubGraph {
 	}
 	return res
 }
+
+// searchFrontier is one side of a best-first search over the graph. Like shortestPath, it only
+// expands the next level of the graph once the search pops a node from the deepest level
+// expanded so far.
+type searchFrontier struct {
+	pq           priorityQueue
+	dist         map[uint64]nodeInfo
+	adjacencyMap map[uint64]map[uint64]mapItem
+	next         chan bool
+	expandErr    chan error
+
+	numHops       int
+	maxHops       int
+	stopExpansion bool
+	maxSize       int64
+}
+
+// newSearchFrontier starts expanding sg from sg.Params.From, see expandOut for backward.
+func newSearchFrontier(ctx context.Context, sg *SubGraph, maxHops int,
+	backward bool) *searchFrontier {
+
+	f := &searchFrontier{
+		dist:         make(map[uint64]nodeInfo),
+		adjacencyMap: make(map[uint64]map[uint64]mapItem),
+		next:         make(chan bool, 2),
+		expandErr:    make(chan error, 2),
+		maxHops:      maxHops,
+		maxSize:      sg.Params.MaxFrontierSize,
+	}
+	src := &queueItem{uid: sg.Params.From}
+	heap.Push(&f.pq, src)
+	f.dist[src.uid] = nodeInfo{node: src}
+	go sg.expandOut(ctx, f.adjacencyMap, f.next, f.expandErr, backward)
+	return f
+}
+
+// close makes the expandOut goroutine exit.
+func (f *searchFrontier) close() {
+	f.next <- false
+}
+
+// expand fetches the next level of the graph if the neighbours of item aren't known yet. It
+// returns true if a level was expanded.
+func (f *searchFrontier) expand(ctx context.Context, item *queueItem) (bool, error) {
+	if f.stopExpansion || f.numHops >= f.maxHops || item.hop < f.numHops {
+		return false, nil
+	}
+	f.next <- true
+	select {
+	case err := <-f.expandErr:
+		switch {
+		case err == errStop:
+			f.stopExpansion = true
+		case err != nil:
+			return false, err
+		}
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+	f.numHops++
+	return true, nil
+}
+
+// relax records that uid can be reached through the edge from parent with a cost of g, unless it
+// can already be reached with a lower or equal cost. priority is the position of uid in the
+// queue, which is g itself for Dijkstra. It returns true if the cost of uid was updated.
+func (f *searchFrontier) relax(parent uint64, hop int, uid uint64, g, priority float64,
+	edge mapItem) bool {
+
+	d, ok := f.dist[uid]
+	if ok && d.cost <= g {
+		return false
+	}
+	var node *queueItem
+	if ok && d.node.index >= 0 {
+		node = d.node
+		node.cost = priority
+		node.hop = hop
+		heap.Fix(&f.pq, node.index)
+	} else {
+		// Either a new node or a node that left the queue and is reached again with a lower cost.
+		node = &queueItem{uid: uid, cost: priority, hop: hop}
+		if int64(f.pq.Len()) > f.maxSize {
+			f.pq.Pop()
+		}
+		heap.Push(&f.pq, node)
+	}
+	f.dist[uid] = nodeInfo{
+		parent: parent,
+		node:   node,
+		mapItem: mapItem{
+			cost:  g,
+			attr:  edge.attr,
+			facet: edge.facet,
+		},
+	}
+	return true
+}
+
+// minCost returns the lowest priority in the queue, 0 if it is empty.
+func (f *searchFrontier) minCost() float64 {
+	if f.pq.Len() == 0 {
+		return 0
+	}
+	return f.pq[0].cost
+}
+
+// tracePath follows the parents in dist from to back to from, and returns the uids in between
+// in order. It returns nil if from can't be reached.
+func tracePath(dist map[uint64]nodeInfo, from, to uint64) []uint64 {
+	var result []uint64
+	cur := to
+	for range len(dist) {
+		if _, ok := dist[cur]; !ok {
+			return nil
+		}
+		result = append(result, cur)
+		if cur == from {
+			break
+		}
+		cur = dist[cur].parent
+	}
+	if cur != from {
+		return nil
+	}
+	l := len(result)
+	for i := range l / 2 {
+		result[i], result[l-i-1] = result[l-i-1], result[i]
+	}
+	return result
+}
+
+func shortestMaxHops(sg *SubGraph) int {
+	if sg.Params.ExploreDepth != nil {
+		return int(*sg.Params.ExploreDepth)
+	}
+	return math.MaxInt32
+}
+
+// reverseAttr returns the predicate that follows the edges of attr in the opposite direction.
+func reverseAttr(attr string) string {
+	if strings.HasPrefix(attr, "~") {
+		return strings.TrimPrefix(attr, "~")
+	}
+	return "~" + attr
+}
+
+// reverseForShortestPath returns a copy of sg that starts at the destination node and follows the
+// edges of the children of sg backwards. The uid predicates need to have the @reverse directive.
+func (sg *SubGraph) reverseForShortestPath(ctx context.Context) (*SubGraph, error) {
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rsg := new(SubGraph)
+	rsg.copyFiltersRecurse(sg)
+	rsg.Params.From = sg.Params.To
+	for _, child := range sg.Children {
+		if !strings.HasPrefix(child.Attr, "~") {
+			attr := x.NamespaceAttr(ns, child.Attr)
+			if typ, err := schema.State().TypeOf(attr); err != nil || typ != types.UidID {
+				// Scalar predicates don't lead to other nodes.
+				continue
+			}
+			if !schema.State().IsReversed(ctx, attr) {
+				return nil, errors.Errorf("%s shortest path needs predicate %s to have @reverse",
+					shortestBidirectional, child.Attr)
+			}
+		}
+		rchild := new(SubGraph)
+		rchild.copyFiltersRecurse(child)
+		rchild.Attr = reverseAttr(child.Attr)
+		rsg.Children = append(rsg.Children, rchild)
+	}
+	return rsg, nil
+}
+
+// bidirectionalShortestPath runs Dijkstra from both the source and the destination, following
+// the edges backwards from the destination, until the two searches meet. Each search only has to
+// go about half as deep as a search from the source alone would, which keeps the frontiers small
+// on large graphs. Every expanded side gets its own maxfrontiersize.
+func bidirectionalShortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
+	maxHops := shortestMaxHops(sg)
+	if maxHops == 0 {
+		return nil, nil
+	}
+	rsg, err := sg.reverseForShortestPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Split the depth so that the two halves of a path can't be longer than maxHops together.
+	fwd := newSearchFrontier(ctx, sg, maxHops-maxHops/2, false)
+	defer fwd.close()
+	bwd := newSearchFrontier(ctx, rsg, maxHops/2, true)
+	defer bwd.close()
+
+	best := math.Inf(1)
+	var meet uint64
+	if sg.Params.From == sg.Params.To {
+		best, meet = 0, sg.Params.From
+	}
+	for fwd.pq.Len() > 0 || bwd.pq.Len() > 0 {
+		// No path through the nodes left in the queues can be cheaper than the best one found.
+		if fwd.minCost()+bwd.minCost() >= best {
+			break
+		}
+		// Advance the side with the smaller frontier.
+		cur, other := fwd, bwd
+		if cur.pq.Len() == 0 || (other.pq.Len() > 0 && other.pq.Len() < cur.pq.Len()) {
+			cur, other = bwd, fwd
+		}
+
+		item := heap.Pop(&cur.pq).(*queueItem)
+		if _, err := cur.expand(ctx, item); err != nil {
+			return nil, err
+		}
+		for toUID, edge := range cur.adjacencyMap[item.uid] {
+			g := item.cost + edge.cost
+			if !cur.relax(item.uid, item.hop+1, toUID, g, g, edge) {
+				continue
+			}
+			if d, ok := other.dist[toUID]; ok && g+d.cost < best {
+				best, meet = g+d.cost, toUID
+			}
+		}
+	}
+
+	if math.IsInf(best, 1) {
+		sg.DestUIDs = &pb.List{}
+		return nil, nil
+	}
+
+	// Join the path from the source to the meeting node with the one from the meeting node to
+	// the destination. The edges of the latter were found backwards, so flip them.
+	result := tracePath(fwd.dist, sg.Params.From, meet)
+	path := make(map[uint64]nodeInfo, len(result))
+	for _, uid := range result {
+		path[uid] = fwd.dist[uid]
+	}
+	for cur := meet; cur != sg.Params.To; {
+		d, ok := bwd.dist[cur]
+		if !ok || len(result) > len(bwd.dist)+len(fwd.dist) {
+			return nil, errors.Errorf("Unable to find the path from %#x to %#x", meet, sg.Params.To)
+		}
+		path[d.parent] = nodeInfo{
+			parent:  cur,
+			mapItem: mapItem{attr: reverseAttr(d.attr), facet: d.facet},
+		}
+		result = append(result, d.parent)
+		cur = d.parent
+	}
+
+	sg.DestUIDs.Uids = result
+	return []*SubGraph{createPathSubgraph(ctx, path, best, result)}, nil
+}
+
+// shortestHeuristic estimates the cost of the cheapest path from a node to the destination for
+// A*. It comes either from a value variable or from the distance in meters between the geo points
+// of a node and the destination. It should never overestimate the actual cost, otherwise the
+// path found might not be the shortest one.
+type shortestHeuristic struct {
+	vals map[uint64]float64
+
+	pred   string
+	target *s2.LatLng
+	// scanned holds the nodes whose neighbours already have a distance in vals.
+	scanned map[uint64]struct{}
+}
+
+func newShortestHeuristic(ctx context.Context, sg *SubGraph) (*shortestHeuristic, error) {
+	if sg.Params.ShortestHeuristicVals != nil {
+		return &shortestHeuristic{vals: sg.Params.ShortestHeuristicVals}, nil
+	}
+	h := &shortestHeuristic{
+		vals:    make(map[uint64]float64),
+		pred:    sg.Params.ShortestHeuristic,
+		scanned: make(map[uint64]struct{}),
+	}
+	points, err := sg.fetchGeoPoints(ctx, h.pred, []uint64{sg.Params.To})
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := points[sg.Params.To]; ok {
+		h.target = &p
+	}
+	return h, nil
+}
+
+// estimate returns the estimated cost from uid to the destination. Nodes without a value get 0,
+// which never overestimates.
+func (h *shortestHeuristic) estimate(uid uint64) float64 {
+	return h.vals[uid]
+}
+
+// update computes the distances to the destination of the nodes that were added to
+// adjacencyMap since the last call.
+func (h *shortestHeuristic) update(ctx context.Context, sg *SubGraph,
+	adjacencyMap map[uint64]map[uint64]mapItem) error {
+
+	if h.target == nil {
+		return nil
+	}
+	var uids []uint64
+	for from, neighbours := range adjacencyMap {
+		if _, ok := h.scanned[from]; ok {
+			continue
+		}
+		h.scanned[from] = struct{}{}
+		for to := range neighbours {
+			if _, ok := h.vals[to]; !ok {
+				h.vals[to] = 0
+				uids = append(uids, to)
+			}
+		}
+	}
+	if len(uids) == 0 {
+		return nil
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	points, err := sg.fetchGeoPoints(ctx, h.pred, uids)
+	if err != nil {
+		return err
+	}
+	for uid, p := range points {
+		h.vals[uid] = float64(types.EarthDistance(h.target.Distance(p)))
+	}
+	return nil
+}
+
+// fetchGeoPoints returns the points stored in the geo predicate pred for the given sorted uids.
+// Nodes without a value or with a geometry other than a point are left out.
+func (sg *SubGraph) fetchGeoPoints(ctx context.Context, pred string,
+	uids []uint64) (map[uint64]s2.LatLng, error) {
+
+	psg := &SubGraph{
+		Attr:    pred,
+		ReadTs:  sg.ReadTs,
+		AsOf:    sg.AsOf,
+		Cache:   sg.Cache,
+		SrcUIDs: &pb.List{Uids: uids},
+	}
+	taskQuery, err := createTaskQuery(ctx, psg)
+	if err != nil {
+		return nil, err
+	}
+	result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make(map[uint64]s2.LatLng)
+	for i, uid := range uids {
+		if i >= len(result.ValueMatrix) || len(result.ValueMatrix[i].Values) == 0 {
+			continue
+		}
+		val, err := convertWithBestEffort(result.ValueMatrix[i].Values[0], pred)
+		if err != nil {
+			return nil, err
+		}
+		if val.Tid != types.GeoID {
+			return nil, errors.Errorf("heuristic predicate %s should be of type geo. Got: %s",
+				pred, val.Tid.Name())
+		}
+		if p, ok := val.Value.(*geom.Point); ok {
+			points[uid] = s2.LatLngFromDegrees(p.Y(), p.X())
+		}
+	}
+	return points, nil
+}
+
+// astarShortestPath finds the shortest path with A*, which visits the nodes in the order of their
+// cost from the source plus the estimated cost to the destination given by the heuristic. With a
+// good heuristic, this expands much less of the graph than Dijkstra.
+func astarShortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
+	maxHops := shortestMaxHops(sg)
+	if maxHops == 0 {
+		return nil, nil
+	}
+	h, err := newShortestHeuristic(ctx, sg)
+	if err != nil {
+		return nil, err
+	}
+
+	f := newSearchFrontier(ctx, sg, maxHops, false)
+	defer f.close()
+	for f.pq.Len() > 0 {
+		item := heap.Pop(&f.pq).(*queueItem)
+		if item.uid == sg.Params.To {
+			break
+		}
+		expanded, err := f.expand(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		if expanded {
+			if err := h.update(ctx, sg, f.adjacencyMap); err != nil {
+				return nil, err
+			}
+		}
+
+		// The cost of item in the queue includes the heuristic, the one in dist doesn't.
+		cost := f.dist[item.uid].cost
+		for toUID, edge := range f.adjacencyMap[item.uid] {
+			g := cost + edge.cost
+			f.relax(item.uid, item.hop+1, toUID, g, g+h.estimate(toUID), edge)
+		}
+	}
+
+	result := tracePath(f.dist, sg.Params.From, sg.Params.To)
+	if len(result) == 0 {
+		sg.DestUIDs = &pb.List{}
+		return nil, nil
+	}
+	sg.DestUIDs.Uids = result
+	totalWeight := f.dist[sg.Params.To].cost
+	return []*SubGraph{createPathSubgraph(ctx, f.dist, totalWeight, result)}, nil
+}
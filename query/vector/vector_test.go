@@ -845,3 +845,211 @@ func TestDotProductWithConstantVector(t *testing.T) {
 		`{"data":{"q":[{"vec452":[1,1,2,2],"distance":10},{"vec452":[2,1,2,2],"distance":13}]} }`,
 		processQueryNoErr(t, query))
 }
+
+func TestVectorSearchWithFilter(t *testing.T) {
+	dropPredicate("vfilter")
+	dropPredicate("vlabel")
+	setSchema(fmt.Sprintf(vectorSchemaWithIndex, "vfilter", "4", "euclidean") + `
+		vlabel: string @index(exact) .`)
+
+	var rdfs strings.Builder
+	for i := 1; i <= 100; i++ {
+		fmt.Fprintf(&rdfs, "<%d> <vfilter> \"[%d.0, 1.0]\" .\n", i, i)
+		if i%10 == 0 {
+			fmt.Fprintf(&rdfs, "<%d> <vlabel> \"tenth\" .\n", i)
+		}
+	}
+	require.NoError(t, addTriplesToCluster(rdfs.String()))
+
+	// The closest vectors don't pass the filter: the search has to go further to return
+	// the requested number of results.
+	query := `{
+		q(func: similar_to(vfilter, 3, "[1.0, 1.0]")) @filter(eq(vlabel, "tenth")) {
+			uid
+		}
+	}`
+	require.JSONEq(t, `{"data":{"q":[{"uid":"0xa"},{"uid":"0x14"},{"uid":"0x1e"}]}}`,
+		processQueryNoErr(t, query))
+
+	query = `{
+		q(func: similar_to(vfilter, 2, "[1.0, 1.0]")) @filter(uid(0x5, 0x32, 0x63)) {
+			uid
+		}
+	}`
+	require.JSONEq(t, `{"data":{"q":[{"uid":"0x5"},{"uid":"0x32"}]}}`,
+		processQueryNoErr(t, query))
+}
+
+func TestVectorDeleteAndCompact(t *testing.T) {
+	dropPredicate("vcompact")
+	setSchema(fmt.Sprintf(vectorSchemaWithIndex, "vcompact", "4", "euclidean"))
+
+	var rdfs strings.Builder
+	for i := 1; i <= 50; i++ {
+		fmt.Fprintf(&rdfs, "<%d> <vcompact> \"[%d.0, 1.0]\" .\n", i, i)
+	}
+	require.NoError(t, addTriplesToCluster(rdfs.String()))
+	deleteTriplesInCluster("<10> <vcompact> * .\n<11> <vcompact> * .")
+	require.NoError(t, addTriplesToCluster(`<12> <vcompact> "[100.0, 1.0]" .`))
+
+	near := `{
+		q(func: similar_to(vcompact, 2, "[10.0, 1.0]")) {
+			uid
+		}
+	}`
+	far := `{
+		q(func: similar_to(vcompact, 1, "[100.0, 1.0]")) {
+			uid
+		}
+	}`
+	require.JSONEq(t, `{"data":{"q":[{"uid":"0x8"},{"uid":"0x9"}]}}`, processQueryNoErr(t, near))
+	require.JSONEq(t, `{"data":{"q":[{"uid":"0xc"}]}}`, processQueryNoErr(t, far))
+
+	hc, err := dc.HTTPClient()
+	require.NoError(t, err)
+	hc.LoginIntoNamespace(dgraphapi.DefaultUser, dgraphapi.DefaultPassword, x.RootNamespace)
+	params := dgraphapi.GraphQLParams{
+		Query: `mutation {
+			compactVectorIndex(input: {predicate: "vcompact"}) {
+				response { code }
+			}
+		}`,
+	}
+	resp, err := hc.RunGraphqlQuery(params, true)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"compactVectorIndex":{"response":{"code":"Success"}}}`, string(resp))
+
+	// The index is rebuilt in the background from the vectors left.
+	require.Eventually(t, func() bool {
+		res, err := processQuery(context.Background(), t, near)
+		return err == nil && res == `{"data":{"q":[{"uid":"0x8"},{"uid":"0x9"}]}}`
+	}, 30*time.Second, time.Second)
+	require.JSONEq(t, `{"data":{"q":[{"uid":"0xc"}]}}`, processQueryNoErr(t, far))
+}
+
+func TestVectorSimilarToScores(t *testing.T) {
+	dropPredicate("vscore")
+	setSchema(fmt.Sprintf(vectorSchemaWithIndex, "vscore", "4", "euclidean"))
+
+	var rdfs strings.Builder
+	for i := 1; i <= 10; i++ {
+		fmt.Fprintf(&rdfs, "<%d> <vscore> \"[%d.0, 0.0]\" .\n", i, i)
+	}
+	require.NoError(t, addTriplesToCluster(rdfs.String()))
+
+	// The scores are the euclidean distances of the neighbors to the query vector.
+	query := `{
+		score as var(func: similar_to(vscore, 3, "[2.0, 0.0]"))
+
+		q(func: uid(score), orderasc: val(score)) {
+			uid
+			score: val(score)
+		}
+	}`
+	require.JSONEq(t, `{"data":{"q":[{"uid":"0x2","score":0},{"uid":"0x1","score":1},
+		{"uid":"0x3","score":1}]}}`, processQueryNoErr(t, query))
+
+	// The neighbors farther than the threshold are left out.
+	query = `{
+		score as var(func: similar_to(vscore, 5, "[2.0, 0.0]", 1.5))
+
+		q(func: uid(score), orderasc: val(score)) {
+			uid
+		}
+	}`
+	require.JSONEq(t, `{"data":{"q":[{"uid":"0x2"},{"uid":"0x1"},{"uid":"0x3"}]}}`,
+		processQueryNoErr(t, query))
+}
+
+func TestVectorListSimilarTo(t *testing.T) {
+	dropPredicate("vchunks")
+	setSchema(`vchunks: [float32vector] @index(hnsw(metric: "euclidean")) .`)
+	require.NoError(t, addTriplesToCluster(`
+		<1> <vchunks> "[0.0, 0.0]" .
+		<1> <vchunks> "[10.0, 0.0]" .
+		<2> <vchunks> "[5.0, 0.0]" .
+		<3> <vchunks> "[9.0, 0.0]" .
+		<3> <vchunks> "[20.0, 0.0]" .`))
+
+	// Each vector of the list is returned as a whole.
+	var res struct {
+		Data struct {
+			Q []struct {
+				Vchunks [][]float32 `json:"vchunks"`
+			} `json:"q"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(processQueryNoErr(t,
+		`{ q(func: uid(1)) { vchunks } }`)), &res))
+	require.Len(t, res.Data.Q, 1)
+	require.ElementsMatch(t, [][]float32{{0, 0}, {10, 0}}, res.Data.Q[0].Vchunks)
+
+	// A node is scored with the distance of its closest vector.
+	query := `{
+		score as var(func: similar_to(vchunks, 2, "[10.0, 0.0]"))
+
+		q(func: uid(score), orderasc: val(score)) {
+			uid
+			score: val(score)
+		}
+	}`
+	require.JSONEq(t, `{"data":{"q":[{"uid":"0x1","score":0},{"uid":"0x3","score":1}]}}`,
+		processQueryNoErr(t, query))
+
+	// With several query vectors, the distances of the closest vector of the node to each of
+	// them are added up.
+	query = `{
+		score as var(func: similar_to(vchunks, 1, "[[0.0, 0.0], [20.0, 0.0]]", "maxsim"))
+
+		q(func: uid(score)) {
+			uid
+			score: val(score)
+		}
+	}`
+	require.JSONEq(t, `{"data":{"q":[{"uid":"0x3","score":9}]}}`, processQueryNoErr(t, query))
+
+	// The vectors of a query uid are all used.
+	query = `{
+		q(func: similar_to(vchunks, 1, "0x3")) {
+			uid
+		}
+	}`
+	require.JSONEq(t, `{"data":{"q":[{"uid":"0x3"}]}}`, processQueryNoErr(t, query))
+
+	deleteTriplesInCluster(`<1> <vchunks> "[0.0, 0.0]" .`)
+	query = `{
+		q(func: similar_to(vchunks, 1, "[0.0, 0.0]")) {
+			uid
+		}
+	}`
+	require.JSONEq(t, `{"data":{"q":[{"uid":"0x2"}]}}`, processQueryNoErr(t, query))
+
+	// Distances can't be summed.
+	_, err := processQuery(context.Background(), t,
+		`{ q(func: similar_to(vchunks, 1, "[0.0, 0.0]", "sum")) { uid } }`)
+	require.ErrorContains(t, err, "with the cosine or dotproduct metric")
+}
+
+func TestVectorListSimilarToSum(t *testing.T) {
+	dropPredicate("vsum")
+	setSchema(`vsum: [float32vector] @index(hnsw(metric: "dotproduct")) .`)
+	require.NoError(t, addTriplesToCluster(`
+		<1> <vsum> "[1.0, 0.0]" .
+		<1> <vsum> "[0.5, 0.0]" .
+		<2> <vsum> "[1.25, 0.0]" .`))
+
+	query := `{
+		score as var(func: similar_to(vsum, 1, "[1.0, 0.0]", "%s"))
+
+		q(func: uid(score)) {
+			uid
+			score: val(score)
+		}
+	}`
+	// The node with the best vector is the best under maxsim, but the sum of the similarities
+	// of its vectors favors the node matching twice.
+	require.JSONEq(t, `{"data":{"q":[{"uid":"0x2","score":1.25}]}}`,
+		processQueryNoErr(t, fmt.Sprintf(query, "maxsim")))
+	require.JSONEq(t, `{"data":{"q":[{"uid":"0x1","score":1.5}]}}`,
+		processQueryNoErr(t, fmt.Sprintf(query, "sum")))
+}
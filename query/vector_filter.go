@@ -0,0 +1,110 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package query
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+
+	"github.com/hypermodeinc/dgraph/v25/algo"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// pushDownVectorFilter restricts the similar_to search of a root block to the uids matching the
+// filter of the block, so that the search returns the nearest vectors among the ones matching
+// it, instead of the filter being applied to the nearest vectors only. This is only done when the
+// filter can be evaluated on its own: uid sets, eq on indexed predicates and has, combined with
+// and/or. The filter is still applied to the results of the search afterwards.
+func (sg *SubGraph) pushDownVectorFilter(ctx context.Context, q *pb.Query) {
+	if sg.SrcFunc == nil || sg.SrcFunc.Name != "similar_to" || len(sg.Filters) != 1 {
+		return
+	}
+	uids, ok, err := sg.filterUids(ctx, sg.Filters[0])
+	switch {
+	case err != nil:
+		glog.V(2).Infof("Unable to evaluate the filter of similar_to ahead of the search: %v",
+			err)
+	case ok:
+		q.UidList = uids
+	}
+}
+
+// filterUids returns the uids matching the filter f, and whether f could be evaluated.
+func (sg *SubGraph) filterUids(ctx context.Context, f *SubGraph) (*pb.List, bool, error) {
+	switch {
+	case f.FilterOp == "and" || f.FilterOp == "or":
+		lists := make([]*pb.List, 0, len(f.Filters))
+		for _, child := range f.Filters {
+			l, ok, err := sg.filterUids(ctx, child)
+			if !ok || err != nil {
+				return nil, false, err
+			}
+			lists = append(lists, l)
+		}
+		if f.FilterOp == "and" {
+			return algo.IntersectSorted(lists), true, nil
+		}
+		return algo.MergeSorted(lists), true, nil
+
+	case f.FilterOp != "" || f.SrcFunc == nil:
+		return nil, false, nil
+
+	case f.SrcFunc.Name == "uid":
+		if len(f.Params.NeedsVar) == 0 {
+			return &pb.List{Uids: f.SrcUIDs.GetUids()}, true, nil
+		}
+		// The uids of the variables have been filled in before processing the query.
+		return &pb.List{Uids: f.DestUIDs.GetUids()}, true, nil
+	}
+
+	if !isPushableFunc(f) {
+		return nil, false, nil
+	}
+	if f.SrcFunc.Name == "eq" {
+		ns, err := x.ExtractNamespace(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		if !schema.State().IsIndexed(ctx, x.NamespaceAttr(ns, f.Attr)) {
+			return nil, false, nil
+		}
+	}
+	leaf := &SubGraph{
+		Attr:    f.Attr,
+		SrcFunc: f.SrcFunc,
+		ReadTs:  sg.ReadTs,
+		AsOf:    sg.AsOf,
+		Cache:   sg.Cache,
+		Params:  params{Langs: f.Params.Langs},
+	}
+	q, err := createTaskQuery(ctx, leaf)
+	if err != nil {
+		return nil, false, err
+	}
+	result, err := sg.processTask(ctx, q)
+	if err != nil {
+		return nil, false, err
+	}
+	return algo.MergeSorted(result.UidMatrix), true, nil
+}
+
+// isPushableFunc tells whether the function of the filter f can be evaluated as a root function.
+func isPushableFunc(f *SubGraph) bool {
+	fn := f.SrcFunc
+	if fn.IsCount || fn.IsValueVar || fn.IsLenVar || len(f.Params.NeedsVar) > 0 ||
+		len(f.Attr) == 0 || f.Attr[0] == '~' {
+		return false
+	}
+	for _, arg := range fn.Args {
+		if arg.IsValueVar {
+			return false
+		}
+	}
+	return fn.Name == "eq" || fn.Name == "has"
+}
@@ -73,6 +73,19 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 				" Got: [%v] for attr: [%v]", t.Name(), schema.Predicate)
 		}
 		schema.Lang = true
+	case "embedding":
+		if !t.IsVector() || schema.List {
+			return next.Errorf("@embedding directive can only be specified for vector types."+
+				" Got: [%v] for attr: [%v]", t.Name(), x.ParseAttr(schema.Predicate))
+		}
+		source, err := parseEmbeddingDirective(it)
+		if err != nil {
+			return err
+		}
+		if source == x.ParseAttr(schema.Predicate) {
+			return it.Item().Errorf("Predicate [%v] can't be the embedding of itself", source)
+		}
+		schema.EmbeddingSource = source
 	default:
 		return next.Errorf("Invalid index specification")
 	}
@@ -81,6 +94,22 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 	return nil
 }
 
+// parseEmbeddingDirective works on "@embedding(source)", where source is the predicate whose
+// values are embedded. We assume that the "@embedding" has already been found.
+func parseEmbeddingDirective(it *lex.ItemIterator) (string, error) {
+	if !it.Next() || it.Item().Typ != itemLeftRound {
+		return "", it.Item().Errorf("Expected ( after @embedding")
+	}
+	if !it.Next() || it.Item().Typ != itemText {
+		return "", it.Item().Errorf("Expected the predicate to embed in @embedding")
+	}
+	source := it.Item().Val
+	if !it.Next() || it.Item().Typ != itemRightRound {
+		return "", it.Item().Errorf("Expected ) after the predicate of @embedding")
+	}
+	return source, nil
+}
+
 func parseScalarPair(it *lex.ItemIterator, predicate string, ns uint64) (*pb.SchemaUpdate, error) {
 	it.Next()
 	next := it.Item()
@@ -277,7 +306,7 @@ func parseTokenOrVectorIndexSpec(
 	if found {
 		// TODO: Consider allowing IndexFactory types not related to
 		//       VectorIndex objects.
-		if typ != types.VFloatID {
+		if !typ.IsVector() {
 			return "", nil, false,
 				next.Errorf("IndexFactory: %s isn't valid for predicate: %s of type: %s",
 					factory.Name(), x.ParseAttr(predicate), typ.Name())
@@ -108,6 +108,64 @@ func TestSchema(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSchemaIVFIndex(t *testing.T) {
+	require.NoError(t, ParseBytes([]byte(
+		`ivfvector: float32vector @index(ivfpq(nlist:"1024", subvectors:"16")) .`), 1))
+	su, ok := State().Get(context.Background(), x.AttrInRootNamespace("ivfvector"))
+	require.True(t, ok)
+	require.Equal(t, []*pb.VectorIndexSpec{{
+		Name: "ivfpq",
+		Options: []*pb.OptionPair{
+			{Key: "nlist", Value: "1024"},
+			{Key: "subvectors", Value: "16"},
+		},
+	}}, su.IndexSpecs)
+
+	require.Error(t, ParseBytes([]byte(
+		`ivfvector: float32vector @index(ivfpq(codebookSize:"512")) .`), 1))
+	require.Error(t, ParseBytes([]byte(
+		`ivfvector: float32vector @index(ivfflat(subvectors:"16")) .`), 1))
+}
+
+func TestSchemaVectorTypes(t *testing.T) {
+	require.NoError(t, ParseBytes([]byte(`
+		halfvector: float16vector @index(hnsw(metric:"cosine")) .
+		bytevector: int8vector @index(hnsw(metric:"euclidean")) .
+		bitvector: binaryvector @index(hnsw(metric:"hamming")) .`), 1))
+	for pred, tid := range map[string]types.TypeID{
+		"halfvector": types.VFloat16ID,
+		"bytevector": types.VInt8ID,
+		"bitvector":  types.VBinaryID,
+	} {
+		typ, err := State().TypeOf(x.AttrInRootNamespace(pred))
+		require.NoError(t, err)
+		require.Equal(t, tid, typ)
+		require.True(t, State().IsIndexed(context.Background(), x.AttrInRootNamespace(pred)))
+	}
+
+	require.Error(t, ParseBytes([]byte(`bitvector: binaryvector @index(hnsw(metric:"l1")) .`), 1))
+}
+
+func TestSchemaEmbedding(t *testing.T) {
+	require.NoError(t, ParseBytes([]byte(`
+		description: string .
+		description_vec: float32vector @index(hnsw) @embedding(description) .`), 1))
+	require.Equal(t, []string{x.AttrInRootNamespace("description_vec")},
+		State().EmbeddedBy(x.AttrInRootNamespace("description")))
+	require.Empty(t, State().EmbeddedBy(x.NamespaceAttr(1, "description")))
+	require.True(t, State().HasEmbeddings())
+
+	// Dropping the directive drops the predicate from the ones embedded from its source.
+	State().Set(x.AttrInRootNamespace("description_vec"),
+		&pb.SchemaUpdate{ValueType: pb.Posting_VFLOAT})
+	require.Empty(t, State().EmbeddedBy(x.AttrInRootNamespace("description")))
+	require.False(t, State().HasEmbeddings())
+
+	require.Error(t, ParseBytes([]byte(`v: string @embedding(description) .`), 1))
+	require.Error(t, ParseBytes([]byte(`v: float32vector @embedding(v) .`), 1))
+	require.Error(t, ParseBytes([]byte(`v: float32vector @embedding .`), 1))
+}
+
 var schemaVal1 = `
 age:int .
 
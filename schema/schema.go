@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"slices"
+	"sort"
 	"sync"
 
 	"github.com/golang/glog"
@@ -54,6 +56,7 @@ func (s *state) init() {
 	s.types = make(map[string]*pb.TypeUpdate)
 	s.elog = trace.NewEventLog("Dgraph", "Schema")
 	s.mutSchema = make(map[string]*pb.SchemaUpdate)
+	s.embeddedBy = make(map[string][]string)
 }
 
 type state struct {
@@ -64,6 +67,9 @@ type state struct {
 	elog      trace.EventLog
 	// mutSchema holds the schema update that is being applied in the background.
 	mutSchema map[string]*pb.SchemaUpdate
+	// embeddedBy maps a predicate to the vector predicates computed from it by the @embedding
+	// directive, sorted. It is kept up to date with the schema of the predicates.
+	embeddedBy map[string][]string
 }
 
 // State returns the struct holding the current schema.
@@ -79,6 +85,10 @@ func (s *state) DeleteAll() {
 		delete(s.predicate, pred)
 	}
 
+	for pred := range s.embeddedBy {
+		delete(s.embeddedBy, pred)
+	}
+
 	for typ := range s.types {
 		delete(s.types, typ)
 	}
@@ -103,6 +113,7 @@ func (s *state) Delete(attr string, ts uint64) error {
 		return err
 	}
 
+	s.unsetEmbedding(attr)
 	delete(s.predicate, attr)
 	delete(s.mutSchema, attr)
 	return nil
@@ -157,6 +168,7 @@ func (s *state) DeletePredsForNs(delNs uint64) {
 	for pred := range s.predicate {
 		ns := x.ParseNamespace(pred)
 		if ns == delNs {
+			s.unsetEmbedding(pred)
 			delete(s.predicate, pred)
 			delete(s.mutSchema, pred)
 		}
@@ -195,10 +207,36 @@ func (s *state) Set(pred string, schema *pb.SchemaUpdate) {
 
 	s.Lock()
 	defer s.Unlock()
+	s.unsetEmbedding(pred)
 	s.predicate[pred] = schema
+	if src := schema.GetEmbeddingSource(); src != "" {
+		key := x.NamespaceAttr(x.ParseNamespace(pred), src)
+		preds := append(slices.Clone(s.embeddedBy[key]), pred)
+		sort.Strings(preds)
+		s.embeddedBy[key] = preds
+	}
 	s.elog.Printf(logUpdate(schema, pred))
 }
 
+// unsetEmbedding removes pred from the vector predicates computed from the source of its
+// @embedding directive. The lists are replaced rather than changed, as they are handed out by
+// EmbeddedBy.
+func (s *state) unsetEmbedding(pred string) {
+	src := s.predicate[pred].GetEmbeddingSource()
+	if src == "" {
+		return
+	}
+	key := x.NamespaceAttr(x.ParseNamespace(pred), src)
+	preds := slices.DeleteFunc(slices.Clone(s.embeddedBy[key]), func(p string) bool {
+		return p == pred
+	})
+	if len(preds) == 0 {
+		delete(s.embeddedBy, key)
+		return
+	}
+	s.embeddedBy[key] = preds
+}
+
 // SetMutSchema sets the mutation schema for the given predicate.
 func (s *state) SetMutSchema(pred string, schema *pb.SchemaUpdate) {
 	s.Lock()
@@ -469,10 +507,8 @@ func (s *state) PredicatesToDelete(pred string) []string {
 	if schema, ok := s.predicate[pred]; ok {
 		preds = append(preds, pred)
 
-		if schema.ValueType == pb.Posting_VFLOAT && len(schema.IndexSpecs) != 0 {
-			preds = append(preds, pred+hnsw.VecEntry)
-			preds = append(preds, pred+hnsw.VecKeyword)
-			preds = append(preds, pred+hnsw.VecDead)
+		if types.TypeID(schema.ValueType).IsVector() && len(schema.IndexSpecs) != 0 {
+			preds = append(preds, hnsw.SupportingPredicates(pred, schema.List)...)
 		}
 	}
 	return preds
@@ -512,6 +548,21 @@ func (s *state) HasNoConflict(pred string) bool {
 	return s.predicate[pred].GetNoConflict()
 }
 
+// EmbeddedBy returns the vector predicates, in the namespace of pred, whose values are computed
+// from the values of pred by the @embedding directive. The returned slice must not be modified.
+func (s *state) EmbeddedBy(pred string) []string {
+	s.RLock()
+	defer s.RUnlock()
+	return s.embeddedBy[pred]
+}
+
+// HasEmbeddings returns whether any predicate has an @embedding directive.
+func (s *state) HasEmbeddings() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return len(s.embeddedBy) > 0
+}
+
 // IndexingInProgress checks whether indexing is going on for a given predicate.
 func (s *state) IndexingInProgress() bool {
 	s.RLock()
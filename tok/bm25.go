@@ -0,0 +1,202 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tok
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/blevesearch/bleve/v2/analysis"
+	"github.com/pkg/errors"
+)
+
+// BM25Tokenizer generates the tokens needed to rank full-text matches with BM25. The terms are
+// found like with the fulltext tokenizer, but every term is stored along with its frequency in
+// the value:
+//
+//	<term><IdentDelimiter><term frequency>
+//
+// The frequencies are 4 bytes big endian. The lengths of the values, which are the sums of the
+// frequencies of their terms, are kept by the index in two more kinds of keys:
+//
+//	<IdentDelimiter><uid>	the length of the values of uid, as BM25 documents.
+//	<IdentDelimiter><IdentDelimiter><shard>	the number of documents and their total length.
+//
+// The number of documents and their total length are spread over BM25StatsShards keys, by uid,
+// so that the transactions changing the lengths of different documents seldom conflict. As terms
+// are never empty, these keys can't be mistaken for the token of a term.
+type BM25Tokenizer struct{ lang string }
+
+const bm25Delimiter = string(rune(IdentDelimiter))
+
+func (t BM25Tokenizer) Name() string { return "bm25" }
+func (t BM25Tokenizer) Type() string { return "string" }
+func (t BM25Tokenizer) Tokens(v interface{}) ([]string, error) {
+	str, ok := v.(string)
+	if !ok || str == "" {
+		return []string{}, nil
+	}
+	terms := bm25Terms(str, t.lang)
+	freqs := make(map[string]uint32, len(terms))
+	var order []string
+	for _, term := range terms {
+		if _, ok := freqs[term]; !ok {
+			order = append(order, term)
+		}
+		freqs[term]++
+	}
+	tokens := make([]string, 0, len(order))
+	for _, term := range order {
+		tokens = append(tokens, string(appendCount([]byte(term+bm25Delimiter),
+			freqs[term])))
+	}
+	return tokens, nil
+}
+func (t BM25Tokenizer) Identifier() byte { return IdentBM25 }
+func (t BM25Tokenizer) IsSortable() bool { return false }
+func (t BM25Tokenizer) IsLossy() bool    { return true }
+
+// bm25Terms returns all the terms of str, including the repeated ones.
+func bm25Terms(str, lang string) []string {
+	lang = LangBase(lang)
+	tokens := fulltextAnalyzer.Analyze([]byte(str))
+	tokens = filterStopwords(lang, tokens)
+	tokens = filterStemmers(lang, tokens)
+	return termsOf(tokens)
+}
+
+func termsOf(tokens analysis.TokenStream) []string {
+	terms := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		terms = append(terms, string(t.Term))
+	}
+	return terms
+}
+
+func appendCount(b []byte, n uint32) []byte {
+	return binary.BigEndian.AppendUint32(b, n)
+}
+
+// GetBM25Terms returns the unique terms of the query of a BM25 search.
+func GetBM25Terms(query, lang string) []string {
+	terms := bm25Terms(query, lang)
+	seen := make(map[string]struct{}, len(terms))
+	res := terms[:0]
+	for _, t := range terms {
+		if _, ok := seen[t]; !ok {
+			seen[t] = struct{}{}
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+// BM25TermPrefix returns the prefix of the encoded tokens holding the frequencies of term.
+func BM25TermPrefix(term string) string {
+	return encodeToken(term+bm25Delimiter, IdentBM25)
+}
+
+// BM25LengthToken returns the encoded token of the key holding the length of the values of uid.
+func BM25LengthToken(uid uint64) string {
+	return encodeToken(string(binary.BigEndian.AppendUint64([]byte{IdentDelimiter}, uid)),
+		IdentBM25)
+}
+
+// BM25StatsShards is the number of keys holding the number of documents and their total length.
+const BM25StatsShards = 256
+
+// BM25StatsShard returns the shard of the number of documents and their total length that counts
+// the document of uid.
+func BM25StatsShard(uid uint64) byte {
+	return byte(uid % BM25StatsShards)
+}
+
+// BM25StatsToken returns the encoded token of the key holding the number of documents of shard
+// and their total length.
+func BM25StatsToken(shard byte) string {
+	return encodeToken(string([]byte{IdentDelimiter, IdentDelimiter, shard}), IdentBM25)
+}
+
+// BM25Stats holds the number of documents indexed with BM25, and their total length.
+type BM25Stats struct {
+	N     uint64
+	Total uint64
+}
+
+// Marshal encodes the stats as the value of their key.
+func (s BM25Stats) Marshal() []byte {
+	return binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, s.N), s.Total)
+}
+
+// Add adds the documents of other to the stats.
+func (s *BM25Stats) Add(other BM25Stats) {
+	s.N += other.N
+	s.Total += other.Total
+}
+
+// AvgLength returns the average length of the documents.
+func (s BM25Stats) AvgLength() float64 {
+	if s.N == 0 {
+		return 0
+	}
+	return float64(s.Total) / float64(s.N)
+}
+
+// UnmarshalBM25Stats decodes the value of the key of the stats.
+func UnmarshalBM25Stats(b []byte) (BM25Stats, error) {
+	if len(b) != 16 {
+		return BM25Stats{}, errors.Errorf("invalid bm25 stats of length %d", len(b))
+	}
+	return BM25Stats{N: binary.BigEndian.Uint64(b), Total: binary.BigEndian.Uint64(b[8:])}, nil
+}
+
+// MarshalBM25Length encodes a length as the value of its key.
+func MarshalBM25Length(length uint32) []byte {
+	return appendCount(nil, length)
+}
+
+// UnmarshalBM25Length decodes the value of the key of a length.
+func UnmarshalBM25Length(b []byte) (uint32, error) {
+	if len(b) != 4 {
+		return 0, errors.Errorf("invalid bm25 length of %d bytes", len(b))
+	}
+	return binary.BigEndian.Uint32(b), nil
+}
+
+// BM25Count returns the frequency stored in an encoded BM25 token.
+func BM25Count(token string) (uint32, bool) {
+	if len(token) < 4 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32([]byte(token[len(token)-4:])), true
+}
+
+// BM25Params holds the parameters of the BM25 ranking function.
+type BM25Params struct {
+	// K1 controls how quickly the score saturates as the frequency of a term grows.
+	K1 float64
+	// B controls how much the score is normalized by the length of the value.
+	B float64
+}
+
+// DefaultBM25Params are the usual parameters of BM25.
+var DefaultBM25Params = BM25Params{K1: 1.2, B: 0.75}
+
+// IDF returns the inverse document frequency of a term found in df of the n values.
+func (p BM25Params) IDF(n, df uint64) float64 {
+	return math.Log(1 + (float64(n)-float64(df)+0.5)/(float64(df)+0.5))
+}
+
+// TermScore returns the score of a term that appears tf times in a value of length dl, given
+// the average length avgdl of the values.
+func (p BM25Params) TermScore(idf float64, tf, dl uint32, avgdl float64) float64 {
+	norm := 1 - p.B
+	if avgdl > 0 {
+		norm += p.B * float64(dl) / avgdl
+	}
+	f := float64(tf)
+	return idf * f * (p.K1 + 1) / (f + p.K1*norm)
+}
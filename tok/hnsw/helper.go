@@ -9,6 +9,7 @@ import (
 	"container/heap"
 	"context"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"log"
 	"math"
@@ -29,6 +30,7 @@ const (
 	Euclidean            = "euclidean"
 	Cosine               = "cosine"
 	DotProd              = "dotproduct"
+	Hamming              = "hamming"
 	EmptyHNSWTreeError   = "HNSW tree has no elements"
 	VecKeyword           = "__vector_"
 	visitedVectorsLevel  = "visited_vectors_level_"
@@ -36,10 +38,24 @@ const (
 	searchTime           = "vector_search_time"
 	VecEntry             = "__vector_entry"
 	VecDead              = "__vector_dead"
+	// VecList suffixes the predicate mapping each vector of an inverted file index to the chunk
+	// of the inverted list holding its entry.
+	//
+	// VecChunk, VecChunkOwner and VecChunks suffix the predicates backing a list of vectors.
+	// Each vector of the list is a chunk stored under a uid of its own in VecChunk, which is
+	// what the vector index indexes, VecChunkOwner maps it back to its node and VecChunks
+	// lists the chunks of the node.
+	VecList              = "__vector_list"
+	VecChunk             = "__vector_chunk"
+	VecChunkOwner        = "__vector_chunk_owner"
+	VecChunks            = "__vector_chunks"
 	VectorIndexMaxLevels = 5
 	EfConstruction       = 16
 	EfSearch             = 12
 	numEdgesConst        = 2
+	// maxFilteredEf is the largest ef the last layer is searched with, when
+	// looking for more neighbors that pass a filter.
+	maxFilteredEf = 8192
 	// ByteData indicates the key stores data.
 	ByteData = byte(0x00)
 	// DefaultPrefix is the prefix used for data, index and reverse keys so that relative
@@ -114,6 +130,25 @@ func euclideanDistanceSq[T c.Float](a, b []T, floatBits int) (T, error) {
 	return applyDistanceFunction(a, b, floatBits, "euclidean distance", vek32.Distance, vek.Distance)
 }
 
+// This needs to implement signature of SimilarityType[T].distanceScore
+// function, hence it takes in a floatBits parameter.
+// It counts the dimensions in which a and b differ, which is meant for
+// binary vectors.
+func hammingDistance[T c.Float](a, b []T, floatBits int) (T, error) {
+	return applyDistanceFunction(a, b, floatBits, "hamming distance", countDifferent[float32],
+		countDifferent[float64])
+}
+
+func countDifferent[F float32 | float64](a, b []F) F {
+	var n F
+	for i := range a {
+		if a[i] != b[i] {
+			n++
+		}
+	}
+	return n
+}
+
 // Used for distance, since shorter distance is better
 func insortPersistentHeapAscending[T c.Float](
 	slice []minPersistentHeapElement[T],
@@ -223,6 +258,9 @@ func GetSimType[T c.Float](indexType string, floatBits int) SimilarityType[T] {
 	case indexType == DotProd:
 		return SimilarityType[T]{indexType: DotProd, distanceScore: dotProduct[T],
 			insortHeap: insortPersistentHeapDescending[T], isBetterScore: isBetterScoreForSimilarity[T]}
+	case indexType == Hamming:
+		return SimilarityType[T]{indexType: Hamming, distanceScore: hammingDistance[T],
+			insortHeap: insortPersistentHeapAscending[T], isBetterScore: isBetterScoreForDistance[T]}
 	default:
 		return SimilarityType[T]{indexType: Euclidean, distanceScore: euclideanDistanceSq[T],
 			insortHeap: insortPersistentHeapAscending[T], isBetterScore: isBetterScoreForDistance[T]}
@@ -247,6 +285,11 @@ func (tc *TxnCache) Find(prefix []byte, filter func([]byte) bool) (uint64, error
 	return tc.txn.Find(prefix, filter)
 }
 
+// Txn returns the transaction the cache reads from, for the indexes writing to it.
+func (tc *TxnCache) Txn() index.Txn {
+	return tc.txn
+}
+
 func NewTxnCache(txn index.Txn, startTs uint64) *TxnCache {
 	return &TxnCache{
 		txn:     txn,
@@ -332,6 +375,18 @@ func entryUuidInsert(
 	return edge, err
 }
 
+// SupportingPredicates returns the predicates backing the vector index of pred, along with
+// the chunks of the vectors if pred is a list of vectors.
+func SupportingPredicates(pred string, list bool) []string {
+	preds := []string{pred + VecEntry, pred + VecKeyword, pred + VecDead, pred + VecList}
+	if list {
+		chunk := pred + VecChunk
+		preds = append(preds, chunk, pred+VecChunkOwner, pred+VecChunks, chunk+VecEntry,
+			chunk+VecKeyword, chunk+VecDead, chunk+VecList)
+	}
+	return preds
+}
+
 func ConcatStrings(strs ...string) string {
 	total := ""
 	for _, s := range strs {
@@ -648,27 +703,76 @@ func (ph *persistentHNSW[T]) addNeighbors(ctx context.Context, tc *TxnCache,
 	return edge, nil
 }
 
-// removeDeadNodes(nnEdges, tc) removes dead nodes from nnEdges and returns the new nnEdges
-func (ph *persistentHNSW[T]) removeDeadNodes(nnEdges []uint64, tc *TxnCache) ([]uint64, error) {
-	// TODO add a path to delete deadNodes
-	if ph.deadNodes == nil {
-		data, err := getDataFromKeyWithCacheType(ph.vecDead, 1, tc)
-		if err != nil && !errors.Is(err, errFetchingPostingList) {
-			return []uint64{}, err
-		}
+// loadDeadNodes reads the dead nodes from the persistent store, unless they
+// have already been read.
+func (ph *persistentHNSW[T]) loadDeadNodes(tc *TxnCache) error {
+	if ph.deadNodes != nil {
+		return nil
+	}
+	data, err := getDataFromKeyWithCacheType(ph.vecDead, 1, tc)
+	if err != nil && !errors.Is(err, errFetchingPostingList) {
+		return err
+	}
 
-		var deadNodes []uint64
-		if data != nil { // if dead nodes exist, convert to []uint64
-			deadNodes, err = ParseEdges(string(data))
-			if err != nil {
-				return []uint64{}, err
-			}
+	var deadNodes []uint64
+	if data != nil { // if dead nodes exist, convert to []uint64
+		deadNodes, err = ParseEdges(string(data))
+		if err != nil {
+			return err
 		}
+	}
 
-		ph.deadNodes = make(map[uint64]struct{})
-		for _, n := range deadNodes {
-			ph.deadNodes[n] = struct{}{}
-		}
+	ph.deadNodes = make(map[uint64]struct{})
+	for _, n := range deadNodes {
+		ph.deadNodes[n] = struct{}{}
+	}
+	return nil
+}
+
+// setDead marks uuid as dead, or as alive again, and writes the dead nodes
+// to the persistent store if this changed them. It returns the edge written,
+// if any.
+func (ph *persistentHNSW[T]) setDead(ctx context.Context, tc *TxnCache, uuid uint64,
+	dead bool) (*index.KeyValue, error) {
+	if err := ph.loadDeadNodes(tc); err != nil {
+		return nil, err
+	}
+	if _, ok := ph.deadNodes[uuid]; ok == dead {
+		return nil, nil
+	}
+	if dead {
+		ph.deadNodes[uuid] = struct{}{}
+	} else {
+		delete(ph.deadNodes, uuid)
+	}
+
+	deadNodes := make([]uint64, 0, len(ph.deadNodes))
+	for n := range ph.deadNodes {
+		deadNodes = append(deadNodes, n)
+	}
+	sort.Slice(deadNodes, func(i, j int) bool { return deadNodes[i] < deadNodes[j] })
+	data, err := json.Marshal(deadNodes)
+	if err != nil {
+		return nil, err
+	}
+	key := DataKey(ph.vecDead, 1)
+	tc.txn.LockKey(key)
+	defer tc.txn.UnlockKey(key)
+	edge := &index.KeyValue{
+		Entity: 1,
+		Attr:   ph.vecDead,
+		Value:  data,
+	}
+	if err := tc.txn.AddMutationWithLockHeld(ctx, key, edge); err != nil {
+		return nil, err
+	}
+	return edge, nil
+}
+
+// removeDeadNodes(nnEdges, tc) removes dead nodes from nnEdges and returns the new nnEdges
+func (ph *persistentHNSW[T]) removeDeadNodes(nnEdges []uint64, tc *TxnCache) ([]uint64, error) {
+	if err := ph.loadDeadNodes(tc); err != nil {
+		return []uint64{}, err
 	}
 	if len(ph.deadNodes) == 0 {
 		return nnEdges, nil
@@ -68,7 +68,7 @@ func (hf *persistentIndexFactory[T]) AllowedOptions() opt.AllowedOptions {
 		AddIntOption(EfConstructionOpt).
 		AddIntOption(EfSearchOpt)
 	getSimFunc := func(optValue string) (any, error) {
-		if optValue != Euclidean && optValue != Cosine && optValue != DotProd {
+		if optValue != Euclidean && optValue != Cosine && optValue != DotProd && optValue != Hamming {
 			return nil, errors.New(fmt.Sprintf("Can't create a vector index for %s", optValue))
 		}
 		return GetSimType[T](optValue, hf.floatBits), nil
@@ -8,6 +8,7 @@ package hnsw
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -279,13 +280,42 @@ func (ph *persistentHNSW[T]) SearchWithUid(_ context.Context, c index.CacheType,
 	// for the best entry node to the last layer since we already know the
 	// best entry node (since it already exists in the lowest level), we
 	// can just search the last layer and return the results.
-	r, err := ph.searchPersistentLayer(
-		c, ph.maxLevels-1, queryUid, queryVec, queryVec,
+	r, err := ph.searchLastLayer(
+		c, queryUid, queryVec, queryVec,
 		shouldFilterOutQueryVec, maxResults, filter)
-	for _, n := range r.neighbors {
-		nnUids = append(nnUids, n.index)
+	if err != nil {
+		return []uint64{}, err
+	}
+	return r.finalNeighbors(maxResults), nil
+}
+
+// searchLastLayer searches the last layer of the hnsw graph for the
+// maxResults nearest neighbors of the query that pass the filter. As
+// searching with an ef of maxResults may find fewer of them when a selective
+// filter rejects most of the nodes, the search is done again with a doubled ef
+// until enough neighbors are found, or no more nodes can be reached.
+func (ph *persistentHNSW[T]) searchLastLayer(
+	c index.CacheType,
+	entry uint64,
+	startVec, query []T,
+	entryIsFilteredOut bool,
+	maxResults int,
+	filter index.SearchFilter[T]) (*searchLayerResult[T], error) {
+	var prev *searchLayerResult[T]
+	for ef := maxResults; ; ef *= 2 {
+		r, err := ph.searchPersistentLayer(
+			c, ph.maxLevels-1, entry, startVec, query, entryIsFilteredOut, ef, filter)
+		if err != nil {
+			return r, err
+		}
+		if prev != nil {
+			r.addMetrics(prev)
+		}
+		if !r.needsExpansion(prev, maxResults, ef) {
+			return r, nil
+		}
+		prev = r
 	}
-	return nnUids, err
 }
 
 // There will be times when the entry node has been deleted. In that case, we want to make a new node
@@ -375,14 +405,14 @@ func (ph *persistentHNSW[T]) SearchWithPath(
 		}
 	}
 	filterOut := !filter(query, startVec, entry)
-	layerResult, err := ph.searchPersistentLayer(
-		c, ph.maxLevels-1, entry, startVec, query, filterOut, maxResults, filter)
+	layerResult, err := ph.searchLastLayer(
+		c, entry, startVec, query, filterOut, maxResults, filter)
 	if err != nil {
 		return ph.emptyFinalResultWithError(err)
 	}
 	layerResult.updateFinalMetrics(r)
 	layerResult.updateFinalPath(r)
-	layerResult.addFinalNeighbors(r)
+	layerResult.addFinalNeighbors(r, maxResults, ph.simType)
 	t := time.Now().UnixMilli()
 	elapsed := t - start
 	r.Metrics[searchTime] = uint64(elapsed)
@@ -397,10 +427,200 @@ func (ph *persistentHNSW[T]) Insert(ctx context.Context, c index.CacheType,
 	if !ok {
 		return []*index.KeyValue{}, nil
 	}
+	// A node inserted again after being removed, as when its vector is
+	// replaced, is no longer dead.
+	deadEdge, err := ph.setDead(ctx, tc, inUuid, false)
+	if err != nil {
+		return []*index.KeyValue{}, err
+	}
 	_, edges, err := ph.insertHelper(ctx, tc, inUuid, inVec)
+	if deadEdge != nil && err == nil {
+		edges = append(edges, deadEdge)
+	}
 	return edges, err
 }
 
+// Remove deletes the node of uuid from the hnsw graph. On every level, the
+// neighbors that were linked to it are linked to its other neighbors instead,
+// so that the nodes that were reached through it can still be reached. The
+// node is also recorded as dead, so that the edges to it that other nodes
+// still hold are dropped once these nodes are updated, or when the index is
+// rebuilt.
+func (ph *persistentHNSW[T]) Remove(ctx context.Context, c index.CacheType,
+	uuid uint64, _ []T) ([]*index.KeyValue, error) {
+	tc, ok := c.(*TxnCache)
+	if !ok {
+		return []*index.KeyValue{}, nil
+	}
+	edges := []*index.KeyValue{}
+	edge, err := ph.setDead(ctx, tc, uuid, true)
+	if err != nil {
+		return []*index.KeyValue{}, err
+	}
+	if edge != nil {
+		edges = append(edges, edge)
+	}
+
+	var nodeEdges [][]uint64
+	found, err := ph.fillNeighborEdges(uuid, tc, &nodeEdges)
+	if err != nil || !found {
+		return edges, err
+	}
+
+	// The neighbors that have uuid as a neighbor on a level get the other
+	// neighbors of uuid on this level as candidates.
+	repairs := make(map[uint64][][]uint64)
+	for level := range nodeEdges {
+		for _, n := range nodeEdges[level] {
+			if n == uuid {
+				continue
+			}
+			if repairs[n] == nil {
+				repairs[n] = make([][]uint64, len(nodeEdges))
+			}
+			repairs[n][level] = nodeEdges[level]
+		}
+	}
+	for n, candidates := range repairs {
+		edge, err := ph.repairNeighbors(ctx, tc, n, uuid, candidates)
+		if err != nil {
+			return []*index.KeyValue{}, err
+		}
+		if edge != nil {
+			edges = append(edges, edge)
+		}
+	}
+
+	edge, err = ph.newPersistentEdgeKeyValueEntry(ctx, DataKey(ph.vecKey, uuid), tc.txn, uuid,
+		encodeUint64MatrixUnsafe(make([][]uint64, ph.maxLevels)))
+	if err != nil {
+		return []*index.KeyValue{}, err
+	}
+	ph.nodeAllEdges[uuid] = make([][]uint64, ph.maxLevels)
+	edges = append(edges, edge)
+
+	edge, err = ph.replaceEntry(ctx, tc, uuid, nodeEdges)
+	if err != nil {
+		return []*index.KeyValue{}, err
+	}
+	if edge != nil {
+		edges = append(edges, edge)
+	}
+	return edges, nil
+}
+
+// repairNeighbors removes the edges of uuid to the removed node, and replaces
+// them on each level with the closest of the candidates, keeping at most
+// efConstruction neighbors. It returns the edge written, if any.
+func (ph *persistentHNSW[T]) repairNeighbors(ctx context.Context, tc *TxnCache,
+	uuid, removed uint64, candidates [][]uint64) (*index.KeyValue, error) {
+	var allLayerEdges [][]uint64
+	found, err := ph.fillNeighborEdges(uuid, tc, &allLayerEdges)
+	if err != nil || !found {
+		return nil, err
+	}
+	var inVec, outVec []T
+	if err := ph.getVecFromUid(uuid, tc, &inVec); err != nil || len(inVec) == 0 {
+		// The neighbor has been removed as well, searches never reach it.
+		return nil, nil
+	}
+
+	changed := false
+	for level := range allLayerEdges {
+		neighbors := make([]uint64, 0, len(allLayerEdges[level]))
+		for _, n := range allLayerEdges[level] {
+			if n != removed {
+				neighbors = append(neighbors, n)
+			}
+		}
+		if len(neighbors) == len(allLayerEdges[level]) {
+			continue
+		}
+		changed = true
+		kept := len(neighbors)
+		if level >= len(candidates) {
+			allLayerEdges[level] = neighbors
+			continue
+		}
+
+		seen := make(map[uint64]struct{}, len(neighbors))
+		for _, n := range neighbors {
+			seen[n] = struct{}{}
+		}
+		dists := make(map[uint64]T)
+		for _, n := range candidates[level] {
+			if _, ok := seen[n]; ok || n == uuid || n == removed {
+				continue
+			}
+			seen[n] = struct{}{}
+			if _, ok := ph.deadNodes[n]; ok {
+				continue
+			}
+			if err := ph.getVecFromUid(n, tc, &outVec); err != nil || len(outVec) == 0 {
+				continue
+			}
+			d, err := ph.simType.distanceScore(inVec, outVec, ph.floatBits)
+			if err != nil {
+				continue
+			}
+			dists[n] = d
+			neighbors = append(neighbors, n)
+		}
+		if len(neighbors) > ph.efConstruction {
+			// Only the candidates compete for the places left, the neighbors
+			// that were kept were already chosen as the closest ones.
+			added := neighbors[kept:]
+			sort.SliceStable(added, func(i, j int) bool {
+				return ph.simType.isBetterScore(dists[added[i]], dists[added[j]])
+			})
+			neighbors = neighbors[:max(kept, ph.efConstruction)]
+		}
+		allLayerEdges[level] = neighbors
+	}
+	if !changed {
+		return nil, nil
+	}
+
+	ph.nodeAllEdges[uuid] = allLayerEdges
+	key := DataKey(ph.vecKey, uuid)
+	tc.txn.LockKey(key)
+	defer tc.txn.UnlockKey(key)
+	edge := &index.KeyValue{
+		Entity: uuid,
+		Attr:   ph.vecKey,
+		Value:  encodeUint64MatrixUnsafe(allLayerEdges),
+	}
+	if err := tc.txn.AddMutationWithLockHeld(ctx, key, edge); err != nil {
+		return nil, err
+	}
+	return edge, nil
+}
+
+// replaceEntry makes a neighbor of the removed node the entry of the graph if
+// the removed node was the entry, choosing one of the neighbors on the
+// sparsest level it has any. It returns the edge written, if any.
+func (ph *persistentHNSW[T]) replaceEntry(ctx context.Context, tc *TxnCache, removed uint64,
+	nodeEdges [][]uint64) (*index.KeyValue, error) {
+	entryKey := DataKey(ph.vecEntryKey, 1)
+	tc.txn.LockKey(entryKey)
+	defer tc.txn.UnlockKey(entryKey)
+	data, _ := tc.txn.GetWithLockHeld(entryKey)
+	if len(data) != 8 || BytesToUint64(data) != removed {
+		return nil, nil
+	}
+	for _, neighbors := range nodeEdges {
+		for _, n := range neighbors {
+			if _, ok := ph.deadNodes[n]; ok || n == removed {
+				continue
+			}
+			return entryUuidInsert(ctx, entryKey, tc.txn, ph.vecEntryKey, Uint64ToBytes(n))
+		}
+	}
+	// The node had no neighbors. The entry is left as it is, and replaced
+	// by any node with a vector when the graph is next searched.
+	return nil, nil
+}
+
 // InsertToPersistentStorage inserts a node into the hnsw graph and returns the
 // traversal path and the edges created
 func (ph *persistentHNSW[T]) insertHelper(ctx context.Context, tc *TxnCache,
@@ -8,6 +8,7 @@ package hnsw
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 	"testing"
 
@@ -549,3 +550,209 @@ func TestBasicSearchPersistentFlatStorage(t *testing.T) {
 		}
 	}
 }
+
+func TestFilteredSearchPersistentFlatStorage(t *testing.T) {
+	emptyTsDbs()
+	ph := &persistentHNSW[float64]{
+		maxLevels:      3,
+		efConstruction: EfConstruction,
+		efSearch:       EfSearch,
+		pred:           "0-f",
+		vecEntryKey:    ConcatStrings("0-f", VecEntry),
+		vecKey:         ConcatStrings("0-f", VecKeyword),
+		vecDead:        ConcatStrings("0-f", VecDead),
+		floatBits:      64,
+		simType:        GetSimType[float64](Euclidean, 64),
+		nodeAllEdges:   make(map[uint64][][]uint64),
+	}
+	for i := uint64(1); i <= 40; i++ {
+		vec := []float64{float64(i) / 40, 0.5, 0.5}
+		key := DataKey(ph.pred, i)
+		for ts := range tsDbs {
+			tsDbs[ts].inMemTestDb[string(key)] = floatArrayAsBytes(vec)
+		}
+		tc := NewTxnCache(&inMemTxn{startTs: i, commitTs: i + 1}, i)
+		if _, err := ph.Insert(context.TODO(), tc, i, vec); err != nil {
+			t.Fatalf("Error inserting %d: %s", i, err)
+		}
+	}
+
+	// Only the multiples of 10 pass the filter, which are far from the query: the search
+	// has to be expanded to find them.
+	filter := func(_, _ []float64, uid uint64) bool { return uid%10 == 0 }
+	qc := NewQueryCache(&inMemLocalCache{readTs: 60}, 60)
+	nns, err := ph.Search(context.TODO(), qc, []float64{0.025, 0.5, 0.5}, 2, filter)
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if !equalUint64Slice(nns, []uint64{10, 20}) {
+		t.Errorf("Nearest neighbors expected value: %v, Got: %v", []uint64{10, 20}, nns)
+	}
+
+	// The scores of the neighbors are their euclidean distances to the query.
+	r, err := ph.SearchWithPath(context.TODO(), qc, []float64{0.025, 0.5, 0.5}, 2, filter)
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if r.Similarity || len(r.Scores) != 2 ||
+		math.Abs(r.Scores[0]-0.225) > 1e-9 || math.Abs(r.Scores[1]-0.475) > 1e-9 {
+		t.Errorf("Scores expected value: %v, Got: %v", []float64{0.225, 0.475}, r.Scores)
+	}
+
+	nns, err = ph.SearchWithUid(context.TODO(), qc, 1, 3, filter)
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if !equalUint64Slice(nns, []uint64{10, 20, 30}) {
+		t.Errorf("Nearest neighbors expected value: %v, Got: %v", []uint64{10, 20, 30}, nns)
+	}
+}
+
+func TestHammingSearchPersistentFlatStorage(t *testing.T) {
+	emptyTsDbs()
+	ph := &persistentHNSW[float64]{
+		maxLevels:      3,
+		efConstruction: EfConstruction,
+		efSearch:       EfSearch,
+		pred:           "0-h",
+		vecEntryKey:    ConcatStrings("0-h", VecEntry),
+		vecKey:         ConcatStrings("0-h", VecKeyword),
+		vecDead:        ConcatStrings("0-h", VecDead),
+		floatBits:      64,
+		simType:        GetSimType[float64](Hamming, 64),
+		nodeAllEdges:   make(map[uint64][][]uint64),
+	}
+	// The vector of uid i has its first i bits set.
+	bits := func(n int) []float64 {
+		vec := make([]float64, 8)
+		for i := 0; i < n; i++ {
+			vec[i] = 1
+		}
+		return vec
+	}
+	for i := uint64(1); i <= 8; i++ {
+		vec := bits(int(i))
+		key := DataKey(ph.pred, i)
+		for ts := range tsDbs {
+			tsDbs[ts].inMemTestDb[string(key)] = floatArrayAsBytes(vec)
+		}
+		tc := NewTxnCache(&inMemTxn{startTs: i, commitTs: i + 1}, i)
+		if _, err := ph.Insert(context.TODO(), tc, i, vec); err != nil {
+			t.Fatalf("Error inserting %d: %s", i, err)
+		}
+	}
+
+	// The scores of the neighbors are the number of bits in which they differ from the query.
+	qc := NewQueryCache(&inMemLocalCache{readTs: 20}, 20)
+	r, err := ph.SearchWithPath(context.TODO(), qc, bits(3), 3, index.AcceptAll[float64])
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if len(r.Neighbors) != 3 || r.Neighbors[0] != 3 {
+		t.Errorf("Nearest neighbor expected value: 3, Got: %v", r.Neighbors)
+	}
+	if r.Similarity || !equalFloat64Slice(r.Scores, []float64{0, 1, 1}) {
+		t.Errorf("Scores expected value: %v, Got: %v", []float64{0, 1, 1}, r.Scores)
+	}
+}
+
+func TestRemovePersistentFlatStorage(t *testing.T) {
+	emptyTsDbs()
+	ph := &persistentHNSW[float64]{
+		maxLevels:      3,
+		efConstruction: EfConstruction,
+		efSearch:       EfSearch,
+		pred:           "0-f",
+		vecEntryKey:    ConcatStrings("0-f", VecEntry),
+		vecKey:         ConcatStrings("0-f", VecKeyword),
+		vecDead:        ConcatStrings("0-f", VecDead),
+		floatBits:      64,
+		simType:        GetSimType[float64](Euclidean, 64),
+		nodeAllEdges:   make(map[uint64][][]uint64),
+	}
+	setVec := func(uid uint64, vec []float64, ts uint64) {
+		key := string(DataKey(ph.pred, uid))
+		for i := ts; i < uint64(len(tsDbs)); i++ {
+			if vec == nil {
+				delete(tsDbs[i].inMemTestDb, key)
+			} else {
+				tsDbs[i].inMemTestDb[key] = floatArrayAsBytes(vec)
+			}
+		}
+	}
+	vecOf := func(uid uint64) []float64 { return []float64{float64(uid) / 40, 0.5, 0.5} }
+	for i := uint64(1); i <= 40; i++ {
+		setVec(i, vecOf(i), 0)
+		tc := NewTxnCache(&inMemTxn{startTs: i, commitTs: i + 1}, i)
+		if _, err := ph.Insert(context.TODO(), tc, i, vecOf(i)); err != nil {
+			t.Fatalf("Error inserting %d: %s", i, err)
+		}
+	}
+	var removedEdges [][]uint64
+	found, err := ph.fillNeighborEdges(20, NewQueryCache(&inMemLocalCache{readTs: 45}, 45),
+		&removedEdges)
+	if err != nil || !found || len(removedEdges[ph.maxLevels-1]) == 0 {
+		t.Fatalf("Error reading the edges of 20: %v", err)
+	}
+
+	// Remove the entry and a node in the middle.
+	for i, uid := range []uint64{1, 20} {
+		ts := uint64(50 + 2*i)
+		setVec(uid, nil, ts)
+		tc := NewTxnCache(&inMemTxn{startTs: ts, commitTs: ts + 1}, ts)
+		if _, err := ph.Remove(context.TODO(), tc, uid, vecOf(uid)); err != nil {
+			t.Fatalf("Error removing %d: %s", uid, err)
+		}
+	}
+	ph.nodeAllEdges = make(map[uint64][][]uint64)
+	ph.deadNodes = nil
+
+	qc := NewQueryCache(&inMemLocalCache{readTs: 60}, 60)
+	data, err := qc.Get(DataKey(ph.vecEntryKey, 1))
+	if err != nil || BytesToUint64(data) == 1 || BytesToUint64(data) == 20 {
+		t.Errorf("Expected the entry to be replaced, got %v (%v)", data, err)
+	}
+	data, err = qc.Get(DataKey(ph.vecDead, 1))
+	if err != nil || string(data) != "[1,20]" {
+		t.Errorf("Dead nodes expected value: [1,20], Got: %s (%v)", data, err)
+	}
+	for level, neighbors := range removedEdges {
+		for _, n := range neighbors {
+			var edges [][]uint64
+			if _, err := ph.fillNeighborEdges(n, qc, &edges); err != nil {
+				t.Fatalf("Error reading the edges of %d: %s", n, err)
+			}
+			if slices.Contains(edges[level], 20) {
+				t.Errorf("Expected %d to no longer be linked to 20 on level %d", n, level)
+			}
+		}
+	}
+
+	query := []float64{20.2 / 40, 0.5, 0.5}
+	nns, err := ph.Search(context.TODO(), qc, query, 3, index.AcceptAll[float64])
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if !equalUint64Slice(nns, []uint64{21, 19, 22}) {
+		t.Errorf("Nearest neighbors expected value: %v, Got: %v", []uint64{21, 19, 22}, nns)
+	}
+
+	// Inserting the node again brings it back.
+	setVec(20, vecOf(20), 70)
+	tc := NewTxnCache(&inMemTxn{startTs: 70, commitTs: 71}, 70)
+	if _, err := ph.Insert(context.TODO(), tc, 20, vecOf(20)); err != nil {
+		t.Fatalf("Error inserting 20: %s", err)
+	}
+	qc = NewQueryCache(&inMemLocalCache{readTs: 80}, 80)
+	data, err = qc.Get(DataKey(ph.vecDead, 1))
+	if err != nil || string(data) != "[1]" {
+		t.Errorf("Dead nodes expected value: [1], Got: %s (%v)", data, err)
+	}
+	nns, err = ph.Search(context.TODO(), qc, query, 2, index.AcceptAll[float64])
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if !equalUint64Slice(nns, []uint64{20, 21}) {
+		t.Errorf("Nearest neighbors expected value: %v, Got: %v", []uint64{20, 21}, nns)
+	}
+}
@@ -43,6 +43,9 @@ func newLayerResult[T c.Float](level int) *searchLayerResult[T] {
 
 func (slr *searchLayerResult[T]) setFirstPathNode(n minPersistentHeapElement[T]) {
 	slr.neighbors = []minPersistentHeapElement[T]{n}
+	if n.filteredOut {
+		slr.filtered = 1
+	}
 	slr.visited = make(map[uint64]minPersistentHeapElement[T])
 	slr.visited[n.index] = n
 	slr.path = []uint64{n.index}
@@ -58,6 +61,12 @@ func (slr *searchLayerResult[T]) addPathNode(
 	}
 	effectiveMaxLen := maxResults + slr.filtered
 	if len(slr.neighbors) > effectiveMaxLen {
+		// A filtered out neighbor that is pushed out no longer counts.
+		for _, dropped := range slr.neighbors[effectiveMaxLen:] {
+			if dropped.filteredOut {
+				slr.filtered--
+			}
+		}
 		slr.neighbors = slr.neighbors[:effectiveMaxLen]
 	}
 
@@ -113,10 +122,55 @@ func (slr *searchLayerResult[T]) updateFinalPath(r *index.SearchPathResult) {
 	r.Path = append(r.Path, slr.path...)
 }
 
-func (slr *searchLayerResult[T]) addFinalNeighbors(r *index.SearchPathResult) {
+// slr.addFinalNeighbors(r, maxResults, simType) adds the best maxResults
+// neighbors that were not filtered out to r, along with their scores.
+func (slr *searchLayerResult[T]) addFinalNeighbors(r *index.SearchPathResult, maxResults int,
+	simType SimilarityType[T]) {
+	r.Similarity = simType.indexType == Cosine || simType.indexType == DotProd
 	for _, n := range slr.neighbors {
+		if len(r.Neighbors) == maxResults {
+			break
+		}
 		if !n.filteredOut {
 			r.Neighbors = append(r.Neighbors, n.index)
+			r.Scores = append(r.Scores, float64(n.value))
+		}
+	}
+}
+
+// slr.finalNeighbors(maxResults) returns the best maxResults neighbors that
+// were not filtered out.
+func (slr *searchLayerResult[T]) finalNeighbors(maxResults int) []uint64 {
+	nns := []uint64{}
+	for _, n := range slr.neighbors {
+		if len(nns) == maxResults {
+			break
 		}
+		if !n.filteredOut {
+			nns = append(nns, n.index)
+		}
+	}
+	return nns
+}
+
+// slr.needsExpansion(prev, maxResults, ef) tells whether the layer should be
+// searched again with a larger ef in order to find maxResults neighbors that
+// pass the filter. This is the case when some of the nodes were filtered out,
+// and the search visited more nodes than the previous one (prev, which may be
+// nil), as the nodes that can be reached have not all been visited yet.
+func (slr *searchLayerResult[T]) needsExpansion(
+	prev *searchLayerResult[T],
+	maxResults, ef int) bool {
+	if slr.filtered == 0 || slr.numNeighbors() >= maxResults || ef >= maxFilteredEf {
+		return false
+	}
+	return prev == nil || len(slr.visited) > len(prev.visited)
+}
+
+// slr.addMetrics(prev) adds the metrics of a previous search of the layer,
+// done with a smaller ef, to the metrics of slr.
+func (slr *searchLayerResult[T]) addMetrics(prev *searchLayerResult[T]) {
+	for k, v := range prev.metrics {
+		slr.metrics[k] += v
 	}
 }
@@ -89,6 +89,16 @@ type OptionalIndexSupport[T c.Float] interface {
 		filter SearchFilter[T]) (*SearchPathResult, error)
 }
 
+// Trainer is implemented by the vector indexes that are trained on the vectors they index, like
+// the inverted file indexes whose centroids are computed with k-means. When such an index is
+// built, it is trained on a sample of the vectors before they are inserted.
+type Trainer[T c.Float] interface {
+	// SampleSize returns the number of vectors the index wants to be trained on.
+	SampleSize() int
+	// Train computes the parameters of the empty index from the sample, and stores them.
+	Train(ctx context.Context, c CacheType, sample [][]T) ([]*KeyValue, error)
+}
+
 // A VectorIndex can be used to Search for vectors and add vectors to an index.
 type VectorIndex[T c.Float] interface {
 	OptionalIndexSupport[T]
@@ -116,6 +126,12 @@ type VectorIndex[T c.Float] interface {
 	// Insert will add a vector and uuid into the existing VectorIndex. If
 	// uuid already exists, it should throw an error to not insert duplicate uuids
 	Insert(ctx context.Context, c CacheType, uuid uint64, vec []T) ([]*KeyValue, error)
+
+	// Remove will delete the uuid from the existing VectorIndex, so that it
+	// is no longer returned by searches. vec is the vector the uuid was
+	// inserted with, which the index may use to find it. Removing a uuid that
+	// is not in the index is not an error.
+	Remove(ctx context.Context, c CacheType, uuid uint64, vec []T) ([]*KeyValue, error)
 }
 
 // A Txn is an interface representation of a persistent storage transaction,
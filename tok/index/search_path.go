@@ -12,6 +12,13 @@ type SearchPathResult struct {
 	// The collection of nearest-neighbors in sorted order after filtlering
 	// out neighbors that fail any Filter criteria.
 	Neighbors []uint64
+	// The score of each of the Neighbors, as computed by the metric of the
+	// index: the euclidean or hamming distance, or the cosine or dot product
+	// similarity.
+	Scores []float64
+	// Similarity tells whether the Scores are similarities, which are higher
+	// for closer vectors, rather than distances.
+	Similarity bool
 	// The path from the start of search to the closest neighbor vector.
 	Path []uint64
 	// A collection of captured named counters that occurred for the
@@ -24,6 +31,7 @@ type SearchPathResult struct {
 func NewSearchPathResult() *SearchPathResult {
 	return &SearchPathResult{
 		Neighbors: []uint64{},
+		Scores:    []float64{},
 		Path:      []uint64{},
 		Metrics:   make(map[string]uint64),
 	}
@@ -0,0 +1,258 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package ivf
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"sort"
+
+	c "github.com/hypermodeinc/dgraph/v25/tok/constraints"
+	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+)
+
+// distance returns how far apart a and b are with the given metric, a smaller distance meaning
+// more similar vectors: the squared euclidean distance, the cosine distance, or the opposite of
+// the dot product.
+func distance[T c.Float](metric string, a, b []T) float64 {
+	var dot, na, nb float64
+	switch metric {
+	case hnsw.Euclidean:
+		for i := range a {
+			d := float64(a[i]) - float64(b[i])
+			dot += d * d
+		}
+		return dot
+	case hnsw.DotProd:
+		for i := range a {
+			dot += float64(a[i]) * float64(b[i])
+		}
+		return -dot
+	default:
+		for i := range a {
+			dot += float64(a[i]) * float64(b[i])
+			na += float64(a[i]) * float64(a[i])
+			nb += float64(b[i]) * float64(b[i])
+		}
+		if na == 0 || nb == 0 {
+			return 1
+		}
+		return 1 - dot/math.Sqrt(na*nb)
+	}
+}
+
+// kmeansIterations bounds the number of iterations of Lloyd's algorithm in kmeans.
+const kmeansIterations = 20
+
+// kmeans clusters the parts [lo, hi) of the vectors into k clusters with Lloyd's algorithm, and
+// returns their centers one after the other. With k vectors or less, the parts of the vectors
+// are the centers. The first centers are picked with k-means++, from a generator with a fixed
+// seed, so that the same vectors always give the same centers.
+func kmeans[T c.Float](vecs [][]T, lo, hi, k int, dist func(a, b []T) float64) []T {
+	dim := hi - lo
+	if len(vecs) <= k {
+		centers := make([]T, 0, len(vecs)*dim)
+		for _, vec := range vecs {
+			centers = append(centers, vec[lo:hi]...)
+		}
+		return centers
+	}
+
+	// k-means++ picks every next center with a probability proportional to the squared
+	// euclidean distance of the vectors to the closest center picked already.
+	rng := rand.New(rand.NewSource(1))
+	centers := make([]T, 0, k*dim)
+	centers = append(centers, vecs[rng.Intn(len(vecs))][lo:hi]...)
+	weights := make([]float64, len(vecs))
+	for i, vec := range vecs {
+		weights[i] = distance(hnsw.Euclidean, vec[lo:hi], centers)
+	}
+	for len(centers) < k*dim {
+		var total float64
+		for _, w := range weights {
+			total += w
+		}
+		next := rng.Intn(len(vecs))
+		if total > 0 {
+			r := rng.Float64() * total
+			for next = 0; next < len(vecs)-1 && r >= weights[next]; next++ {
+				r -= weights[next]
+			}
+		}
+		center := vecs[next][lo:hi]
+		centers = append(centers, center...)
+		for i, vec := range vecs {
+			weights[i] = math.Min(weights[i], distance(hnsw.Euclidean, vec[lo:hi], center))
+		}
+	}
+
+	assigned := make([]int, len(vecs))
+	sums := make([]float64, len(centers))
+	counts := make([]int, k)
+	for iter := range kmeansIterations {
+		changed := false
+		for i, vec := range vecs {
+			best, bestDist := 0, 0.0
+			for j := range k {
+				d := dist(vec[lo:hi], centers[j*dim:(j+1)*dim])
+				if j == 0 || d < bestDist {
+					best, bestDist = j, d
+				}
+			}
+			if iter == 0 || best != assigned[i] {
+				assigned[i], changed = best, true
+			}
+		}
+		if !changed {
+			break
+		}
+		clear(sums)
+		clear(counts)
+		for i, vec := range vecs {
+			j := assigned[i]
+			counts[j]++
+			for d, v := range vec[lo:hi] {
+				sums[j*dim+d] += float64(v)
+			}
+		}
+		// A center left without vectors stays where it is.
+		for j := range k {
+			if counts[j] == 0 {
+				continue
+			}
+			for d := range dim {
+				centers[j*dim+d] = T(sums[j*dim+d] / float64(counts[j]))
+			}
+		}
+	}
+	return centers
+}
+
+// appendFloats appends the encoding of v to b, like the vectors are stored.
+func appendFloats[T c.Float](b []byte, v []T, floatBits int) []byte {
+	for _, f := range v {
+		if floatBits == 32 {
+			b = binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(f)))
+		} else {
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(float64(f)))
+		}
+	}
+	return b
+}
+
+// distanceTables hold the dot products of the subvectors of a query with the codewords, and the
+// squared norms of the codewords, from which the distance of the query to any code is computed
+// with a lookup per subvector.
+type distanceTables struct {
+	metric    string
+	codewords int
+	queryNorm float64
+	dots      []float64
+	norms     []float64
+}
+
+func (ix *persistentIVF[T]) newDistanceTables(codebook []T, query []T) *distanceTables {
+	dim := len(query)
+	sub := dim / ix.subvectors
+	t := &distanceTables{
+		metric:    ix.metric,
+		codewords: len(codebook) / dim,
+	}
+	t.dots = make([]float64, ix.subvectors*t.codewords)
+	t.norms = make([]float64, ix.subvectors*t.codewords)
+	for _, q := range query {
+		t.queryNorm += float64(q) * float64(q)
+	}
+	for s := range ix.subvectors {
+		for j := range t.codewords {
+			var dot, norm float64
+			for i := s * sub; i < (s+1)*sub; i++ {
+				w := float64(codebook[j*dim+i])
+				dot += float64(query[i]) * w
+				norm += w * w
+			}
+			t.dots[s*t.codewords+j] = dot
+			t.norms[s*t.codewords+j] = norm
+		}
+	}
+	return t
+}
+
+// distance returns the distance of the query to the vector approximated by code.
+func (t *distanceTables) distance(code []byte) float64 {
+	var dot, norm float64
+	for s, j := range code {
+		if int(j) >= t.codewords {
+			return math.Inf(1)
+		}
+		dot += t.dots[s*t.codewords+int(j)]
+		norm += t.norms[s*t.codewords+int(j)]
+	}
+	switch t.metric {
+	case hnsw.Euclidean:
+		return t.queryNorm - 2*dot + norm
+	case hnsw.DotProd:
+		return -dot
+	default:
+		if t.queryNorm == 0 || norm == 0 {
+			return 1
+		}
+		return 1 - dot/math.Sqrt(t.queryNorm*norm)
+	}
+}
+
+type candidate struct {
+	uid  uint64
+	dist float64
+}
+
+// topK keeps the k candidates with the smallest distances, sorted by distance.
+type topK struct {
+	k     int
+	items []candidate
+}
+
+func newTopK(k int) *topK {
+	return &topK{k: k}
+}
+
+func (t *topK) add(uid uint64, dist float64) {
+	if t.k <= 0 || (len(t.items) == t.k && dist >= t.items[len(t.items)-1].dist) {
+		return
+	}
+	i := sort.Search(len(t.items), func(i int) bool { return t.items[i].dist > dist })
+	if len(t.items) < t.k {
+		t.items = append(t.items, candidate{})
+	}
+	copy(t.items[i+1:], t.items[i:])
+	t.items[i] = candidate{uid: uid, dist: dist}
+}
+
+func (t *topK) uids() []uint64 {
+	res := make([]uint64, 0, len(t.items))
+	for _, item := range t.items {
+		res = append(res, item.uid)
+	}
+	return res
+}
+
+// scores returns the scores of the candidates with the given metric, like the hnsw index reports
+// them: the euclidean distance, the cosine similarity or the dot product.
+func (t *topK) scores(metric string) []float64 {
+	res := make([]float64, 0, len(t.items))
+	for _, item := range t.items {
+		switch metric {
+		case hnsw.Euclidean:
+			res = append(res, math.Sqrt(math.Max(item.dist, 0)))
+		case hnsw.DotProd:
+			res = append(res, -item.dist)
+		default:
+			res = append(res, 1-item.dist)
+		}
+	}
+	return res
+}
@@ -0,0 +1,168 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package ivf
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	c "github.com/hypermodeinc/dgraph/v25/tok/constraints"
+	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/tok/index"
+	opt "github.com/hypermodeinc/dgraph/v25/tok/options"
+)
+
+const (
+	NlistOpt        string = "nlist"
+	NprobeOpt       string = "nprobe"
+	MetricOpt       string = "metric"
+	SubvectorsOpt   string = "subvectors"
+	CodebookSizeOpt string = "codebookSize"
+	IvfFlat         string = "ivfflat"
+	IvfPQ           string = "ivfpq"
+
+	defaultNlist        = 100
+	defaultNprobe       = 8
+	defaultSubvectors   = 8
+	defaultCodebookSize = 256
+	// maxCodebookSize is the largest codebook whose codes fit in a byte.
+	maxCodebookSize = 256
+)
+
+// persistentIndexFactory implements the IndexFactory interface for the inverted file indexes.
+// With pq set, the vectors are stored in the inverted lists as product quantization codes
+// (IVF-PQ), otherwise only their uids are stored (IVF-Flat).
+type persistentIndexFactory[T c.Float] struct {
+	indexMap  map[string]index.VectorIndex[T]
+	floatBits int
+	pq        bool
+	mu        sync.RWMutex
+}
+
+// CreateFlatFactory creates the factory of the IVF-Flat indexes, which keep the uids of the
+// vectors in the inverted list of their closest centroid, and compare the query with the vectors
+// of the lists closest to it.
+func CreateFlatFactory[T c.Float](floatBits int) index.IndexFactory[T] {
+	return &persistentIndexFactory[T]{
+		indexMap:  map[string]index.VectorIndex[T]{},
+		floatBits: floatBits,
+	}
+}
+
+// CreatePQFactory creates the factory of the IVF-PQ indexes, which also keep a product
+// quantization code of the vectors in the inverted lists, so that the lists can be ranked
+// without reading the vectors.
+func CreatePQFactory[T c.Float](floatBits int) index.IndexFactory[T] {
+	return &persistentIndexFactory[T]{
+		indexMap:  map[string]index.VectorIndex[T]{},
+		floatBits: floatBits,
+		pq:        true,
+	}
+}
+
+func (f *persistentIndexFactory[T]) Name() string {
+	if f.pq {
+		return IvfPQ
+	}
+	return IvfFlat
+}
+
+func (f *persistentIndexFactory[T]) GetOptions(o opt.Options) string {
+	return GetPersistentOptions(o)
+}
+
+// AllowedOptions defines nlist, nprobe and metric for both index types, along with the number
+// of subvectors and the size of their codebooks for IVF-PQ.
+func (f *persistentIndexFactory[T]) AllowedOptions() opt.AllowedOptions {
+	retVal := opt.NewAllowedOptions()
+	retVal.AddCustomOption(NlistOpt, positiveIntParser(NlistOpt, 0)).
+		AddCustomOption(NprobeOpt, positiveIntParser(NprobeOpt, 0))
+	if f.pq {
+		retVal.AddCustomOption(SubvectorsOpt, positiveIntParser(SubvectorsOpt, 0)).
+			AddCustomOption(CodebookSizeOpt, positiveIntParser(CodebookSizeOpt, maxCodebookSize))
+	}
+	retVal.AddCustomOption(MetricOpt, func(optValue string) (any, error) {
+		if optValue != hnsw.Euclidean && optValue != hnsw.Cosine && optValue != hnsw.DotProd {
+			return nil, errors.New(fmt.Sprintf("Can't create a vector index for %s", optValue))
+		}
+		return optValue, nil
+	})
+	return retVal
+}
+
+// positiveIntParser parses the value of an option that must be a positive integer, no larger
+// than limit if it isn't 0.
+func positiveIntParser(optName string, limit int) opt.OptionParser {
+	return func(optValue string) (any, error) {
+		v, err := strconv.Atoi(optValue)
+		if err != nil {
+			return nil, err
+		}
+		if v < 1 || (limit > 0 && v > limit) {
+			if limit > 0 {
+				return nil, errors.Errorf("%s must be between 1 and %d, got %d", optName, limit, v)
+			}
+			return nil, errors.Errorf("%s must be positive, got %d", optName, v)
+		}
+		return v, nil
+	}
+}
+
+func (f *persistentIndexFactory[T]) Create(
+	name string,
+	o opt.Options,
+	floatBits int) (index.VectorIndex[T], error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.createWithLock(name, o, floatBits)
+}
+
+func (f *persistentIndexFactory[T]) createWithLock(
+	name string,
+	o opt.Options,
+	floatBits int) (index.VectorIndex[T], error) {
+	if _, ok := f.indexMap[name]; ok {
+		return nil, errors.New("index with name " + name + " already exists")
+	}
+	retVal := &persistentIVF[T]{
+		pred:      name,
+		entryKey:  hnsw.ConcatStrings(name, hnsw.VecEntry),
+		listKey:   hnsw.ConcatStrings(name, hnsw.VecKeyword),
+		assignKey: hnsw.ConcatStrings(name, hnsw.VecList),
+		floatBits: floatBits,
+	}
+	if err := retVal.applyOptions(o, f.pq); err != nil {
+		return nil, err
+	}
+	f.indexMap[name] = retVal
+	return retVal, nil
+}
+
+func (f *persistentIndexFactory[T]) Find(name string) (index.VectorIndex[T], error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.indexMap[name], nil
+}
+
+func (f *persistentIndexFactory[T]) Remove(name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.indexMap, name)
+	return nil
+}
+
+func (f *persistentIndexFactory[T]) CreateOrReplace(
+	name string,
+	o opt.Options,
+	floatBits int) (index.VectorIndex[T], error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.indexMap, name)
+	return f.createWithLock(name, o, floatBits)
+}
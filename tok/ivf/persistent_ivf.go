@@ -0,0 +1,675 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package ivf
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	c "github.com/hypermodeinc/dgraph/v25/tok/constraints"
+	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/tok/index"
+	opt "github.com/hypermodeinc/dgraph/v25/tok/options"
+)
+
+const (
+	// The centroids of the inverted lists and the codebook of the product quantization are
+	// stored under the entry key of the predicate, with these uids.
+	centroidsUid = 1
+	codebookUid  = 2
+	// listStripes is the number of stripes an inverted list is split into, by the uid of the
+	// vectors, so that vectors inserted in the same list at the same time rarely wait for each
+	// other.
+	listStripes = 16
+	// maxChunkEntries is the number of entries after which a stripe continues in a new chunk,
+	// so that inserting or removing a vector never rewrites more than this many entries.
+	maxChunkEntries = 64
+	// rerankFactor is how many more candidates than requested are ranked with their codes before
+	// being compared with the query using their vectors, in IVF-PQ.
+	rerankFactor = 4
+	// samplePerCentroid is the number of vectors the index is trained on for each centroid of
+	// the lists, or codeword of the codebook.
+	samplePerCentroid = 40
+
+	distanceComputations = "vector_distance_computations"
+	listsProbed          = "vector_lists_probed"
+	searchTime           = "vector_search_time"
+)
+
+// persistentIVF is an inverted file index, persisted in the posting store:
+//
+//	<pred>__vector_entry, uid 1: the centroids of the lists
+//	<pred>__vector_entry, uid 2: the codebook of the product quantization (IVF-PQ only)
+//	<pred>__vector_, uid (list+1)<<32 | stripe<<24: the number of chunks of a stripe of a list
+//	<pred>__vector_, uid (list+1)<<32 | stripe<<24 | chunk: the entries of a chunk of a stripe
+//	<pred>__vector_list, uid of a vector: the uid of the chunk holding its entry
+//
+// The centroids and the codebook start with their number of dimensions. An entry is the uid of
+// a vector, followed by its code for IVF-PQ.
+//
+// The index is trained when it is built: the centroids and the codewords are computed with
+// k-means on a sample of the vectors, see Train. Until an index created on no vectors is
+// rebuilt, the first nlist vectors inserted become the centroids and the first codebookSize
+// ones the codewords instead. Either way, they are never moved afterwards, so that the lists
+// and codes stay valid.
+type persistentIVF[T c.Float] struct {
+	pred     string
+	entryKey string
+	listKey  string
+	// assignKey is the predicate mapping the vectors to the chunks holding their entries.
+	assignKey string
+	nlist     int
+	nprobe    int
+	// subvectors is the number of parts of a vector quantized separately, 0 for IVF-Flat.
+	subvectors   int
+	codebookSize int
+	metric       string
+	floatBits    int
+}
+
+// GetPersistentOptions returns the options of an inverted file index, like they are written in
+// the schema.
+func GetPersistentOptions(o opt.Options) string {
+	sb := strings.Builder{}
+	for _, name := range []string{NlistOpt, NprobeOpt, SubvectorsOpt, CodebookSizeOpt} {
+		if val, ok, _ := opt.GetOpt(o, name, 0); ok {
+			sb.WriteString(fmt.Sprintf(`"%s":"%d",`, name, val))
+		}
+	}
+	if val, ok, _ := opt.GetOpt(o, MetricOpt, ""); ok {
+		sb.WriteString(fmt.Sprintf(`"%s":"%s",`, MetricOpt, val))
+	}
+
+	final := sb.String()
+	if len(final) > 0 {
+		// Remove last , and cover with brackets
+		return "(" + final[:len(final)-1] + ")"
+	}
+	return ""
+}
+
+func (ix *persistentIVF[T]) applyOptions(o opt.Options, pq bool) error {
+	var err error
+	if ix.nlist, _, err = opt.GetOpt(o, NlistOpt, defaultNlist); err != nil {
+		return err
+	}
+	if ix.nprobe, _, err = opt.GetOpt(o, NprobeOpt, defaultNprobe); err != nil {
+		return err
+	}
+	if ix.metric, _, err = opt.GetOpt(o, MetricOpt, hnsw.Euclidean); err != nil {
+		return err
+	}
+	if !pq {
+		return nil
+	}
+	if ix.subvectors, _, err = opt.GetOpt(o, SubvectorsOpt, defaultSubvectors); err != nil {
+		return err
+	}
+	ix.codebookSize, _, err = opt.GetOpt(o, CodebookSizeOpt, defaultCodebookSize)
+	return err
+}
+
+func (ix *persistentIVF[T]) entrySize() int {
+	return 8 + ix.subvectors
+}
+
+// Search searches the lists closest to the query for its nearest neighbors.
+func (ix *persistentIVF[T]) Search(ctx context.Context, c index.CacheType, query []T,
+	maxResults int, filter index.SearchFilter[T]) ([]uint64, error) {
+	r, err := ix.SearchWithPath(ctx, c, query, maxResults, filter)
+	return r.Neighbors, err
+}
+
+// SearchWithUid searches the nearest neighbors of the vector of queryUid.
+func (ix *persistentIVF[T]) SearchWithUid(ctx context.Context, c index.CacheType,
+	queryUid uint64, maxResults int, filter index.SearchFilter[T]) ([]uint64, error) {
+	var queryVec []T
+	if !ix.getVec(c, queryUid, &queryVec) {
+		// No vector. return empty result
+		return []uint64{}, nil
+	}
+	return ix.Search(ctx, c, queryVec, maxResults, filter)
+}
+
+// SearchWithPath allows persistentIVF to implement index.OptionalIndexSupport. The path holds
+// the lists that were probed.
+//
+// When vectors are rejected by the filter and fewer than maxResults are left, the search is
+// done again probing twice as many lists, and ranking twice as many codes for IVF-PQ, until
+// enough vectors pass the filter or all of them have been compared with the query.
+func (ix *persistentIVF[T]) SearchWithPath(
+	_ context.Context,
+	c index.CacheType,
+	query []T,
+	maxResults int,
+	filter index.SearchFilter[T]) (*index.SearchPathResult, error) {
+	start := time.Now()
+	r := index.NewSearchPathResult()
+
+	centroids, dim, err := ix.readBook(c, centroidsUid)
+	if err != nil || dim == 0 {
+		return r, err
+	}
+	if len(query) != dim {
+		return r, errors.Errorf("can not search vectors of %d dimensions with a query of %d",
+			dim, len(query))
+	}
+	nlists := len(centroids) / dim
+	r.Metrics[distanceComputations] = uint64(nlists)
+
+	var top *topK
+	var lists []int
+	for nprobe, pool := ix.nprobe, maxResults*rerankFactor; ; nprobe, pool = 2*nprobe, 2*pool {
+		lists = ix.closest(centroids, dim, query, nprobe, ix.distance)
+		var dropped, exhausted bool
+		if ix.subvectors == 0 {
+			top, dropped, err = ix.searchFlat(c, lists, query, maxResults, filter, r)
+			exhausted = true
+		} else {
+			top, dropped, exhausted, err = ix.searchPQ(c, lists, query, maxResults, pool,
+				filter, r)
+		}
+		if err != nil {
+			return index.NewSearchPathResult(), err
+		}
+		if len(top.items) >= maxResults || !dropped || (exhausted && len(lists) == nlists) {
+			break
+		}
+	}
+	r.Metrics[listsProbed] = uint64(len(lists))
+	for _, list := range lists {
+		r.Path = append(r.Path, uint64(list))
+	}
+	r.Neighbors = top.uids()
+	r.Scores = top.scores(ix.metric)
+	r.Similarity = ix.metric != hnsw.Euclidean
+	r.Metrics[searchTime] = uint64(time.Since(start).Milliseconds())
+	return r, nil
+}
+
+// searchFlat compares the query with the vectors of all the entries of the lists. It also
+// returns whether some of them were dropped, as they were filtered out or had no vector.
+func (ix *persistentIVF[T]) searchFlat(c index.CacheType, lists []int, query []T,
+	maxResults int, filter index.SearchFilter[T], r *index.SearchPathResult) (
+	*topK, bool, error) {
+	top := newTopK(maxResults)
+	seen := make(map[uint64]struct{})
+	var dropped bool
+	var vec []T
+	for _, list := range lists {
+		err := ix.iterateList(c, list, func(uid uint64, _ []byte) {
+			if _, ok := seen[uid]; ok {
+				return
+			}
+			seen[uid] = struct{}{}
+			if !ix.getVec(c, uid, &vec) || !filter(query, vec, uid) {
+				dropped = true
+				return
+			}
+			r.Metrics[distanceComputations]++
+			top.add(uid, ix.distance(query, vec))
+		})
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	return top, dropped, nil
+}
+
+// searchPQ ranks the entries of the lists with the distance of the query to their codes, and
+// compares the query with the vectors of the best pool ones only. It also returns whether some
+// of these were dropped, as they were filtered out or had no vector, and whether all the
+// entries were compared.
+func (ix *persistentIVF[T]) searchPQ(c index.CacheType, lists []int, query []T,
+	maxResults, pool int, filter index.SearchFilter[T], r *index.SearchPathResult) (
+	*topK, bool, bool, error) {
+	codebook, dim, err := ix.readBook(c, codebookUid)
+	if err != nil {
+		return nil, false, false, err
+	}
+	if dim != len(query) {
+		return nil, false, false, errors.Errorf(
+			"the codebook of the index has %d dimensions instead of %d", dim, len(query))
+	}
+	tables := ix.newDistanceTables(codebook, query)
+	candidates := newTopK(pool)
+	seen := make(map[uint64]struct{})
+	for _, list := range lists {
+		err := ix.iterateList(c, list, func(uid uint64, code []byte) {
+			if _, ok := seen[uid]; ok {
+				return
+			}
+			seen[uid] = struct{}{}
+			candidates.add(uid, tables.distance(code))
+		})
+		if err != nil {
+			return nil, false, false, err
+		}
+	}
+
+	top := newTopK(maxResults)
+	var dropped bool
+	var vec []T
+	for _, cand := range candidates.items {
+		if !ix.getVec(c, cand.uid, &vec) || !filter(query, vec, cand.uid) {
+			dropped = true
+			continue
+		}
+		r.Metrics[distanceComputations]++
+		top.add(cand.uid, ix.distance(query, vec))
+	}
+	return top, dropped, len(seen) <= pool, nil
+}
+
+// SampleSize returns the number of vectors the index is trained on, allowing index.Trainer.
+func (ix *persistentIVF[T]) SampleSize() int {
+	return samplePerCentroid * max(ix.nlist, ix.codebookSize)
+}
+
+// Train computes the centroids of the lists with k-means on the sample, as well as the codebook
+// for IVF-PQ, and stores them. It is called on the empty index when the index is built, before
+// the vectors are inserted.
+func (ix *persistentIVF[T]) Train(ctx context.Context, c index.CacheType,
+	sample [][]T) ([]*index.KeyValue, error) {
+	tc, ok := c.(*hnsw.TxnCache)
+	if !ok || len(sample) == 0 {
+		return []*index.KeyValue{}, nil
+	}
+	dim := len(sample[0])
+	for _, vec := range sample {
+		if len(vec) != dim {
+			return []*index.KeyValue{}, errors.Errorf("can not index a vector of %d dimensions "+
+				"with vectors of %d", len(vec), dim)
+		}
+	}
+	if ix.subvectors > 0 && dim%ix.subvectors != 0 {
+		return []*index.KeyValue{}, errors.Errorf(
+			"vectors of %d dimensions can not be split into %d subvectors", dim, ix.subvectors)
+	}
+
+	txn := tc.Txn()
+	centroids := kmeans(sample, 0, dim, ix.nlist, ix.distance)
+	edge, err := ix.writeBook(ctx, txn, centroidsUid, centroids, dim)
+	if err != nil || ix.subvectors == 0 {
+		return []*index.KeyValue{edge}, err
+	}
+
+	// The codewords of every subvector are trained separately, the codeword j of the codebook
+	// being made of the j-th codewords of all the subvectors.
+	sub := dim / ix.subvectors
+	euclidean := func(a, b []T) float64 { return distance(hnsw.Euclidean, a, b) }
+	var codebook []T
+	for s := range ix.subvectors {
+		words := kmeans(sample, s*sub, (s+1)*sub, ix.codebookSize, euclidean)
+		if codebook == nil {
+			codebook = make([]T, len(words)/sub*dim)
+		}
+		for j := 0; j*sub < len(words); j++ {
+			copy(codebook[j*dim+s*sub:j*dim+(s+1)*sub], words[j*sub:(j+1)*sub])
+		}
+	}
+	bookEdge, err := ix.writeBook(ctx, txn, codebookUid, codebook, dim)
+	return []*index.KeyValue{edge, bookEdge}, err
+}
+
+// Insert adds the vector to the list of its closest centroid.
+func (ix *persistentIVF[T]) Insert(ctx context.Context, c index.CacheType,
+	inUuid uint64, inVec []T) ([]*index.KeyValue, error) {
+	tc, ok := c.(*hnsw.TxnCache)
+	if !ok || len(inVec) == 0 {
+		return []*index.KeyValue{}, nil
+	}
+	txn := tc.Txn()
+	if ix.subvectors > 0 && len(inVec)%ix.subvectors != 0 {
+		return []*index.KeyValue{}, errors.Errorf(
+			"vectors of %d dimensions can not be split into %d subvectors", len(inVec),
+			ix.subvectors)
+	}
+
+	edges := []*index.KeyValue{}
+	centroids, edge, err := ix.seed(ctx, txn, centroidsUid, inVec, ix.nlist)
+	if err != nil {
+		return []*index.KeyValue{}, err
+	}
+	if edge != nil {
+		edges = append(edges, edge)
+	}
+	list := ix.closest(centroids, len(inVec), inVec, 1, ix.distance)[0]
+
+	entry := binary.BigEndian.AppendUint64(make([]byte, 0, ix.entrySize()), inUuid)
+	if ix.subvectors > 0 {
+		codebook, edge, err := ix.seed(ctx, txn, codebookUid, inVec, ix.codebookSize)
+		if err != nil {
+			return []*index.KeyValue{}, err
+		}
+		if edge != nil {
+			edges = append(edges, edge)
+		}
+		entry = append(entry, ix.encode(codebook, inVec)...)
+	}
+
+	listEdges, err := ix.appendToList(ctx, txn, list, inUuid, entry)
+	if err != nil {
+		return []*index.KeyValue{}, err
+	}
+	return append(edges, listEdges...), nil
+}
+
+// Remove deletes the entry of uuid from the chunk holding it, found through the assign key.
+func (ix *persistentIVF[T]) Remove(ctx context.Context, c index.CacheType,
+	uuid uint64, _ []T) ([]*index.KeyValue, error) {
+	tc, ok := c.(*hnsw.TxnCache)
+	if !ok {
+		return []*index.KeyValue{}, nil
+	}
+	txn := tc.Txn()
+	assignKey := hnsw.DataKey(ix.assignKey, uuid)
+	txn.LockKey(assignKey)
+	defer txn.UnlockKey(assignKey)
+
+	data, _ := txn.GetWithLockHeld(assignKey)
+	if len(data) != 8 {
+		return []*index.KeyValue{}, nil
+	}
+	edge, err := ix.removeFromChunk(ctx, txn, hnsw.BytesToUint64(data), uuid)
+	if err != nil {
+		return []*index.KeyValue{}, err
+	}
+	assignEdge := &index.KeyValue{
+		Entity: uuid,
+		Attr:   ix.assignKey,
+		Value:  []byte{},
+	}
+	if err := txn.AddMutationWithLockHeld(ctx, assignKey, assignEdge); err != nil {
+		return []*index.KeyValue{}, err
+	}
+	if edge == nil {
+		return []*index.KeyValue{assignEdge}, nil
+	}
+	return []*index.KeyValue{edge, assignEdge}, nil
+}
+
+// removeFromChunk deletes the entry of uuid from the chunk stored under uid, and returns the
+// edge written, or nil if the chunk has no entry for uuid.
+func (ix *persistentIVF[T]) removeFromChunk(ctx context.Context, txn index.Txn, uid uint64,
+	uuid uint64) (*index.KeyValue, error) {
+	key := hnsw.DataKey(ix.listKey, uid)
+	txn.LockKey(key)
+	defer txn.UnlockKey(key)
+
+	size := ix.entrySize()
+	data, _ := txn.GetWithLockHeld(key)
+	for i := 0; i+size <= len(data); i += size {
+		if binary.BigEndian.Uint64(data[i:]) != uuid {
+			continue
+		}
+		value := make([]byte, 0, len(data)-size)
+		value = append(append(value, data[:i]...), data[i+size:]...)
+		edge := &index.KeyValue{
+			Entity: uid,
+			Attr:   ix.listKey,
+			Value:  value,
+		}
+		return edge, txn.AddMutationWithLockHeld(ctx, key, edge)
+	}
+	return nil, nil
+}
+
+// seed adds vec to the vectors stored under uid if there are less than limit of them, and
+// returns these vectors along with the edge written, if any. Once there are limit of them, they
+// are only read, without holding the lock of their key.
+func (ix *persistentIVF[T]) seed(ctx context.Context, txn index.Txn, uid uint64, vec []T,
+	limit int) ([]T, *index.KeyValue, error) {
+	key := hnsw.DataKey(ix.entryKey, uid)
+	if data, err := txn.Get(key); err == nil {
+		book, dim, err := ix.decodeBook(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if dim > 0 && dim != len(vec) {
+			return nil, nil, errors.Errorf("can not index a vector of %d dimensions with "+
+				"vectors of %d", len(vec), dim)
+		}
+		if dim > 0 && len(book)/dim >= limit {
+			return book, nil, nil
+		}
+	}
+
+	txn.LockKey(key)
+	defer txn.UnlockKey(key)
+
+	data, _ := txn.GetWithLockHeld(key)
+	book, dim, err := ix.decodeBook(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if dim > 0 && dim != len(vec) {
+		return nil, nil, errors.Errorf("can not index a vector of %d dimensions with vectors "+
+			"of %d", len(vec), dim)
+	}
+	if len(book)/len(vec) >= limit {
+		return book, nil, nil
+	}
+
+	value := make([]byte, 0, 8+(len(book)+len(vec))*ix.floatBits/8)
+	if len(data) == 0 {
+		value = binary.BigEndian.AppendUint64(value, uint64(len(vec)))
+	}
+	value = append(value, data...)
+	value = appendFloats(value, vec, ix.floatBits)
+	edge := &index.KeyValue{
+		Entity: uid,
+		Attr:   ix.entryKey,
+		Value:  value,
+	}
+	if err := txn.AddMutationWithLockHeld(ctx, key, edge); err != nil {
+		return nil, nil, err
+	}
+	book, _, err = ix.decodeBook(value)
+	return book, edge, err
+}
+
+// writeBook stores the centroids or the codebook under uid.
+func (ix *persistentIVF[T]) writeBook(ctx context.Context, txn index.Txn, uid uint64, book []T,
+	dim int) (*index.KeyValue, error) {
+	key := hnsw.DataKey(ix.entryKey, uid)
+	txn.LockKey(key)
+	defer txn.UnlockKey(key)
+
+	value := binary.BigEndian.AppendUint64(make([]byte, 0, 8+len(book)*ix.floatBits/8),
+		uint64(dim))
+	edge := &index.KeyValue{
+		Entity: uid,
+		Attr:   ix.entryKey,
+		Value:  appendFloats(value, book, ix.floatBits),
+	}
+	return edge, txn.AddMutationWithLockHeld(ctx, key, edge)
+}
+
+// appendToList adds the entry of uuid to the last chunk of its stripe of the list, or to a new
+// chunk if it is full, and maps uuid to that chunk.
+func (ix *persistentIVF[T]) appendToList(ctx context.Context, txn index.Txn, list int,
+	uuid uint64, entry []byte) ([]*index.KeyValue, error) {
+	edges := []*index.KeyValue{}
+	stripe := int(uuid % listStripes)
+	headKey := hnsw.DataKey(ix.listKey, listUid(list, stripe, 0))
+	txn.LockKey(headKey)
+	defer txn.UnlockKey(headKey)
+
+	chunks := uint64(1)
+	if data, _ := txn.GetWithLockHeld(headKey); len(data) == 8 {
+		chunks = hnsw.BytesToUint64(data)
+	}
+	chunkEdges, err := ix.appendToChunk(ctx, txn, listUid(list, stripe, chunks), entry, false)
+	if err != nil {
+		return []*index.KeyValue{}, err
+	}
+	if chunkEdges == nil {
+		// The last chunk is full.
+		chunks++
+		edge := &index.KeyValue{
+			Entity: listUid(list, stripe, 0),
+			Attr:   ix.listKey,
+			Value:  hnsw.Uint64ToBytes(chunks),
+		}
+		if err := txn.AddMutationWithLockHeld(ctx, headKey, edge); err != nil {
+			return []*index.KeyValue{}, err
+		}
+		edges = append(edges, edge)
+		chunkEdges, err = ix.appendToChunk(ctx, txn, listUid(list, stripe, chunks), entry, true)
+		if err != nil {
+			return []*index.KeyValue{}, err
+		}
+	}
+	edges = append(edges, chunkEdges...)
+
+	assignEdge := &index.KeyValue{
+		Entity: uuid,
+		Attr:   ix.assignKey,
+		Value:  hnsw.Uint64ToBytes(listUid(list, stripe, chunks)),
+	}
+	if err := txn.AddMutation(ctx, hnsw.DataKey(ix.assignKey, uuid), assignEdge); err != nil {
+		return []*index.KeyValue{}, err
+	}
+	return append(edges, assignEdge), nil
+}
+
+// appendToChunk adds the entry to the chunk of a list stored under uid. Unless force is set, it
+// returns no edges if the chunk is already full.
+func (ix *persistentIVF[T]) appendToChunk(ctx context.Context, txn index.Txn, uid uint64,
+	entry []byte, force bool) ([]*index.KeyValue, error) {
+	key := hnsw.DataKey(ix.listKey, uid)
+	txn.LockKey(key)
+	defer txn.UnlockKey(key)
+
+	data, _ := txn.GetWithLockHeld(key)
+	if !force && len(data)/ix.entrySize() >= maxChunkEntries {
+		return nil, nil
+	}
+	value := make([]byte, 0, len(data)+len(entry))
+	value = append(append(value, data...), entry...)
+	edge := &index.KeyValue{
+		Entity: uid,
+		Attr:   ix.listKey,
+		Value:  value,
+	}
+	if err := txn.AddMutationWithLockHeld(ctx, key, edge); err != nil {
+		return nil, err
+	}
+	return []*index.KeyValue{edge}, nil
+}
+
+// iterateList calls f with the uid and the code of every entry of the list.
+func (ix *persistentIVF[T]) iterateList(c index.CacheType, list int,
+	f func(uid uint64, code []byte)) error {
+	size := ix.entrySize()
+	for stripe := range listStripes {
+		chunks := uint64(1)
+		if data, err := c.Get(hnsw.DataKey(ix.listKey, listUid(list, stripe, 0))); err == nil &&
+			len(data) == 8 {
+			chunks = hnsw.BytesToUint64(data)
+		}
+		for chunk := uint64(1); chunk <= chunks; chunk++ {
+			// As with the HNSW edges, a chunk that can't be fetched is treated as empty.
+			data, err := c.Get(hnsw.DataKey(ix.listKey, listUid(list, stripe, chunk)))
+			if err != nil {
+				continue
+			}
+			if len(data)%size != 0 {
+				return errors.Errorf("invalid chunk %d of list %d of %s", chunk, list, ix.pred)
+			}
+			for i := 0; i < len(data); i += size {
+				f(binary.BigEndian.Uint64(data[i:]), data[i+8:i+size])
+			}
+		}
+	}
+	return nil
+}
+
+func listUid(list, stripe int, chunk uint64) uint64 {
+	return uint64(list+1)<<32 | uint64(stripe)<<24 | chunk
+}
+
+// readBook returns the centroids or the codebook stored under uid, and their number of
+// dimensions. An index with no vectors yet has no centroids, and 0 dimensions.
+func (ix *persistentIVF[T]) readBook(c index.CacheType, uid uint64) ([]T, int, error) {
+	data, err := c.Get(hnsw.DataKey(ix.entryKey, uid))
+	if err != nil {
+		return nil, 0, nil
+	}
+	return ix.decodeBook(data)
+}
+
+func (ix *persistentIVF[T]) decodeBook(data []byte) ([]T, int, error) {
+	if len(data) == 0 {
+		return nil, 0, nil
+	}
+	if len(data) < 8 {
+		return nil, 0, errors.Errorf("invalid vectors stored in the index of %s", ix.pred)
+	}
+	dim := int(binary.BigEndian.Uint64(data))
+	var book []T
+	index.BytesAsFloatArray(data[8:], &book, ix.floatBits)
+	if dim == 0 || len(book)%dim != 0 {
+		return nil, 0, errors.Errorf("invalid vectors stored in the index of %s", ix.pred)
+	}
+	return book, dim, nil
+}
+
+// getVec fills vec with the vector of uid, and returns whether it has one.
+func (ix *persistentIVF[T]) getVec(c index.CacheType, uid uint64, vec *[]T) bool {
+	data, err := c.Get(hnsw.DataKey(ix.pred, uid))
+	if err != nil || len(data) == 0 {
+		return false
+	}
+	index.BytesAsFloatArray(data, vec, ix.floatBits)
+	return len(*vec) > 0
+}
+
+// distance returns how far apart a and b are with the metric of the index, a smaller distance
+// meaning more similar vectors.
+func (ix *persistentIVF[T]) distance(a, b []T) float64 {
+	return distance(ix.metric, a, b)
+}
+
+// encode returns the product quantization code of vec: for each subvector, the position of the
+// closest codeword in the codebook.
+func (ix *persistentIVF[T]) encode(codebook []T, vec []T) []byte {
+	dim := len(vec)
+	sub := dim / ix.subvectors
+	code := make([]byte, ix.subvectors)
+	for s := range ix.subvectors {
+		lo, hi := s*sub, (s+1)*sub
+		best, bestDist := 0, 0.0
+		for j := 0; j*dim < len(codebook); j++ {
+			d := distance(hnsw.Euclidean, vec[lo:hi], codebook[j*dim+lo:j*dim+hi])
+			if j == 0 || d < bestDist {
+				best, bestDist = j, d
+			}
+		}
+		code[s] = byte(best)
+	}
+	return code
+}
+
+// closest returns the positions of the n vectors of book closest to vec.
+func (ix *persistentIVF[T]) closest(book []T, dim int, vec []T, n int,
+	dist func(a, b []T) float64) []int {
+	top := newTopK(n)
+	for i := 0; i*dim < len(book); i++ {
+		top.add(uint64(i), dist(vec, book[i*dim:(i+1)*dim]))
+	}
+	res := make([]int, 0, len(top.items))
+	for _, item := range top.items {
+		res = append(res, int(item.uid))
+	}
+	return res
+}
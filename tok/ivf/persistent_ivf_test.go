@@ -0,0 +1,441 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package ivf
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/tok/index"
+	opt "github.com/hypermodeinc/dgraph/v25/tok/options"
+)
+
+const testPred = "0-vec"
+
+// memTxn is an index.Txn keeping the values in memory.
+type memTxn struct {
+	data map[string][]byte
+}
+
+func newMemTxn() *memTxn { return &memTxn{data: make(map[string][]byte)} }
+
+func (t *memTxn) StartTs() uint64 { return 1 }
+func (t *memTxn) Get(key []byte) ([]byte, error) {
+	v, ok := t.data[string(key)]
+	if !ok {
+		return nil, errors.New("no value")
+	}
+	return v, nil
+}
+func (t *memTxn) GetWithLockHeld(key []byte) ([]byte, error) { return t.Get(key) }
+func (t *memTxn) Find(prefix []byte, filter func(val []byte) bool) (uint64, error) {
+	return 0, nil
+}
+func (t *memTxn) AddMutation(ctx context.Context, key []byte, kv *index.KeyValue) error {
+	t.data[string(key)] = kv.Value
+	return nil
+}
+func (t *memTxn) AddMutationWithLockHeld(ctx context.Context, key []byte, kv *index.KeyValue) error {
+	return t.AddMutation(ctx, key, kv)
+}
+func (t *memTxn) LockKey(key []byte)   {}
+func (t *memTxn) UnlockKey(key []byte) {}
+
+// insertVectors stores the vectors with the uids 1 to len(vecs), and indexes them.
+func insertVectors(t *testing.T, vi index.VectorIndex[float32], txn *memTxn, vecs [][]float32) {
+	tc := hnsw.NewTxnCache(txn, 1)
+	for i, vec := range vecs {
+		uid := uint64(i + 1)
+		txn.data[string(hnsw.DataKey(testPred, uid))] = appendFloats(nil, vec, 32)
+		_, err := vi.Insert(context.Background(), tc, uid, vec)
+		require.NoError(t, err)
+	}
+}
+
+func randomVectors(n, dim int) [][]float32 {
+	r := rand.New(rand.NewSource(42))
+	vecs := make([][]float32, n)
+	for i := range vecs {
+		vecs[i] = make([]float32, dim)
+		for j := range vecs[i] {
+			vecs[i][j] = r.Float32()
+		}
+	}
+	return vecs
+}
+
+// nearest returns the uids of the k vectors closest to query, found by comparing it with all
+// of them.
+func nearest(metric string, vecs [][]float32, query []float32, k int) []uint64 {
+	uids := make([]uint64, len(vecs))
+	for i := range uids {
+		uids[i] = uint64(i + 1)
+	}
+	sort.SliceStable(uids, func(i, j int) bool {
+		return distance(metric, query, vecs[uids[i]-1]) < distance(metric, query, vecs[uids[j]-1])
+	})
+	return uids[:k]
+}
+
+func parseOptions(t *testing.T, f index.IndexFactory[float32], pairs map[string]string) opt.Options {
+	o := opt.NewOptions()
+	allowed := f.AllowedOptions()
+	for k, v := range pairs {
+		val, err := allowed.GetParsedOption(k, v)
+		require.NoError(t, err)
+		o.SetOpt(k, val)
+	}
+	return o
+}
+
+func TestIVFOptions(t *testing.T) {
+	flat := CreateFlatFactory[float32](32)
+	pq := CreatePQFactory[float32](32)
+	require.Equal(t, IvfFlat, flat.Name())
+	require.Equal(t, IvfPQ, pq.Name())
+
+	_, err := flat.AllowedOptions().GetParsedOption(NlistOpt, "0")
+	require.Error(t, err)
+	_, err = flat.AllowedOptions().GetParsedOption(MetricOpt, "manhattan")
+	require.Error(t, err)
+	_, err = flat.AllowedOptions().GetParsedOption(SubvectorsOpt, "4")
+	require.Error(t, err)
+	_, err = pq.AllowedOptions().GetParsedOption(CodebookSizeOpt, "300")
+	require.Error(t, err)
+
+	o := parseOptions(t, pq, map[string]string{NlistOpt: "16", SubvectorsOpt: "4",
+		MetricOpt: hnsw.Cosine})
+	require.Equal(t, `("nlist":"16","subvectors":"4","metric":"cosine")`, pq.GetOptions(o))
+}
+
+func TestIVFFlatSearch(t *testing.T) {
+	for _, metric := range []string{hnsw.Euclidean, hnsw.Cosine, hnsw.DotProd} {
+		f := CreateFlatFactory[float32](32)
+		// Probing all the lists makes the search exhaustive.
+		o := parseOptions(t, f, map[string]string{NlistOpt: "8", NprobeOpt: "8",
+			MetricOpt: metric})
+		vi, err := f.CreateOrReplace(testPred, o, 32)
+		require.NoError(t, err)
+
+		txn := newMemTxn()
+		vecs := randomVectors(200, 8)
+		insertVectors(t, vi, txn, vecs)
+
+		tc := hnsw.NewTxnCache(txn, 1)
+		query := randomVectors(201, 8)[200]
+		uids, err := vi.Search(context.Background(), tc, query, 5, index.AcceptAll[float32])
+		require.NoError(t, err)
+		require.Equal(t, nearest(metric, vecs, query, 5), uids, metric)
+
+		r, err := vi.SearchWithPath(context.Background(), tc, query, 5, index.AcceptAll[float32])
+		require.NoError(t, err)
+		require.Equal(t, uids, r.Neighbors)
+		require.Equal(t, metric != hnsw.Euclidean, r.Similarity)
+		for i, uid := range r.Neighbors {
+			dist := distance(metric, query, vecs[uid-1])
+			want := map[string]float64{hnsw.Euclidean: math.Sqrt(dist), hnsw.Cosine: 1 - dist,
+				hnsw.DotProd: -dist}[metric]
+			require.InDelta(t, want, r.Scores[i], 1e-6, metric)
+		}
+
+		uids, err = vi.SearchWithUid(context.Background(), tc, 7, 1, index.AcceptAll[float32])
+		require.NoError(t, err)
+		if metric != hnsw.DotProd {
+			require.Equal(t, []uint64{7}, uids, metric)
+		}
+
+		uids, err = vi.Search(context.Background(), tc, query, 5,
+			func(_, _ []float32, uid uint64) bool { return uid%2 == 0 })
+		require.NoError(t, err)
+		require.Len(t, uids, 5)
+		for _, uid := range uids {
+			require.Zero(t, uid%2)
+		}
+	}
+}
+
+func TestIVFFlatSearchFewerLists(t *testing.T) {
+	f := CreateFlatFactory[float32](32)
+	o := parseOptions(t, f, map[string]string{NlistOpt: "16", NprobeOpt: "2"})
+	vi, err := f.CreateOrReplace(testPred, o, 32)
+	require.NoError(t, err)
+
+	txn := newMemTxn()
+	vecs := randomVectors(100, 4)
+	insertVectors(t, vi, txn, vecs)
+
+	r, err := vi.SearchWithPath(context.Background(), hnsw.NewTxnCache(txn, 1), vecs[10], 3,
+		index.AcceptAll[float32])
+	require.NoError(t, err)
+	require.Len(t, r.Path, 2)
+	require.Equal(t, uint64(11), r.Neighbors[0])
+	// Only the vectors of the probed lists are compared with the query.
+	require.Less(t, r.Metrics[distanceComputations], uint64(16+100))
+
+	_, err = vi.Insert(context.Background(), hnsw.NewTxnCache(txn, 1), 500, []float32{1, 2})
+	require.Error(t, err)
+	_, err = vi.Search(context.Background(), hnsw.NewTxnCache(txn, 1), []float32{1, 2}, 3,
+		index.AcceptAll[float32])
+	require.Error(t, err)
+}
+
+func TestIVFFilteredSearch(t *testing.T) {
+	for _, f := range []index.IndexFactory[float32]{CreateFlatFactory[float32](32),
+		CreatePQFactory[float32](32)} {
+		o := parseOptions(t, f, map[string]string{NlistOpt: "16", NprobeOpt: "1"})
+		if f.Name() == IvfPQ {
+			o = parseOptions(t, f, map[string]string{NlistOpt: "16", NprobeOpt: "1",
+				SubvectorsOpt: "4", CodebookSizeOpt: "16"})
+		}
+		vi, err := f.CreateOrReplace(testPred, o, 32)
+		require.NoError(t, err)
+
+		txn := newMemTxn()
+		vecs := randomVectors(200, 4)
+		insertVectors(t, vi, txn, vecs)
+
+		// Few of the vectors of the closest list pass the filter, so that more lists have to
+		// be probed to find enough of them.
+		query := vecs[0]
+		r, err := vi.SearchWithPath(context.Background(), hnsw.NewTxnCache(txn, 1), query, 5,
+			func(_, _ []float32, uid uint64) bool { return uid%10 == 0 })
+		require.NoError(t, err)
+		require.Len(t, r.Neighbors, 5, f.Name())
+		require.Greater(t, len(r.Path), 1, f.Name())
+		for _, uid := range r.Neighbors {
+			require.Zero(t, uid%10, f.Name())
+		}
+	}
+}
+
+func TestIVFEmpty(t *testing.T) {
+	for _, f := range []index.IndexFactory[float32]{CreateFlatFactory[float32](32),
+		CreatePQFactory[float32](32)} {
+		vi, err := f.CreateOrReplace(testPred, opt.NewOptions(), 32)
+		require.NoError(t, err)
+		uids, err := vi.Search(context.Background(), hnsw.NewTxnCache(newMemTxn(), 1),
+			[]float32{1, 2}, 3, index.AcceptAll[float32])
+		require.NoError(t, err)
+		require.Empty(t, uids)
+	}
+}
+
+func TestIVFListChunks(t *testing.T) {
+	f := CreateFlatFactory[float32](32)
+	o := parseOptions(t, f, map[string]string{NlistOpt: "1"})
+	vi, err := f.CreateOrReplace(testPred, o, 32)
+	require.NoError(t, err)
+
+	// Every stripe of the list gets one more vector than a chunk holds.
+	txn := newMemTxn()
+	vecs := randomVectors((maxChunkEntries+1)*listStripes, 2)
+	insertVectors(t, vi, txn, vecs)
+
+	ix := vi.(*persistentIVF[float32])
+	for stripe := range listStripes {
+		head, err := txn.Get(hnsw.DataKey(ix.listKey, listUid(0, stripe, 0)))
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), hnsw.BytesToUint64(head))
+	}
+	// The vectors are mapped to the chunks holding their entries.
+	assigned, err := txn.Get(hnsw.DataKey(ix.assignKey, uint64(len(vecs))))
+	require.NoError(t, err)
+	require.Equal(t, listUid(0, len(vecs)%listStripes, 2), hnsw.BytesToUint64(assigned))
+
+	var n int
+	require.NoError(t, ix.iterateList(hnsw.NewTxnCache(txn, 1), 0, func(uint64, []byte) { n++ }))
+	require.Equal(t, len(vecs), n)
+
+	query := []float32{0.5, 0.5}
+	uids, err := vi.Search(context.Background(), hnsw.NewTxnCache(txn, 1), query, 10,
+		index.AcceptAll[float32])
+	require.NoError(t, err)
+	require.Equal(t, nearest(hnsw.Euclidean, vecs, query, 10), uids)
+}
+
+// clusteredVectors returns n vectors spread around each of the centers.
+func clusteredVectors(centers [][]float32, n int) [][]float32 {
+	r := rand.New(rand.NewSource(7))
+	var vecs [][]float32
+	for _, center := range centers {
+		for range n {
+			vec := make([]float32, len(center))
+			for j := range vec {
+				vec[j] = center[j] + 0.1*(r.Float32()-0.5)
+			}
+			vecs = append(vecs, vec)
+		}
+	}
+	return vecs
+}
+
+func TestIVFTrain(t *testing.T) {
+	centers := [][]float32{{0, 0}, {0, 10}, {10, 0}, {10, 10}}
+	vecs := clusteredVectors(centers, 50)
+	// The first vectors all come from the same cluster, so that they would make poor centroids.
+	for _, f := range []index.IndexFactory[float32]{CreateFlatFactory[float32](32),
+		CreatePQFactory[float32](32)} {
+		o := parseOptions(t, f, map[string]string{NlistOpt: "4", NprobeOpt: "1"})
+		if f.Name() == IvfPQ {
+			o = parseOptions(t, f, map[string]string{NlistOpt: "4", NprobeOpt: "1",
+				SubvectorsOpt: "2", CodebookSizeOpt: "4"})
+		}
+		vi, err := f.CreateOrReplace(testPred, o, 32)
+		require.NoError(t, err)
+		trainer, ok := vi.(index.Trainer[float32])
+		require.True(t, ok)
+		require.Equal(t, samplePerCentroid*4, trainer.SampleSize())
+
+		txn := newMemTxn()
+		tc := hnsw.NewTxnCache(txn, 1)
+		_, err = trainer.Train(context.Background(), tc, vecs)
+		require.NoError(t, err)
+		insertVectors(t, vi, txn, vecs)
+
+		// Every cluster gets a list of its own.
+		ix := vi.(*persistentIVF[float32])
+		lists := make(map[int]bool)
+		for list := range 4 {
+			clusters := make(map[uint64]bool)
+			require.NoError(t, ix.iterateList(tc, list, func(uid uint64, _ []byte) {
+				clusters[(uid-1)/50] = true
+			}))
+			require.Len(t, clusters, 1, f.Name())
+			for c := range clusters {
+				lists[int(c)] = true
+			}
+		}
+		require.Len(t, lists, 4, f.Name())
+
+		// Probing a single list is then enough to find the nearest neighbors. The codes of
+		// the vectors of a cluster are all the same, so that IVF-PQ only finds neighbors in
+		// the right cluster.
+		query := []float32{9.9, 0.1}
+		uids, err := vi.Search(context.Background(), tc, query, 5, index.AcceptAll[float32])
+		require.NoError(t, err)
+		if f.Name() == IvfFlat {
+			require.Equal(t, nearest(hnsw.Euclidean, vecs, query, 5), uids)
+		}
+		require.Len(t, uids, 5, f.Name())
+		for _, uid := range uids {
+			require.Equal(t, uint64(2), (uid-1)/50, f.Name())
+		}
+
+		// The same sample always gives the same centroids.
+		again := newMemTxn()
+		_, err = trainer.Train(context.Background(), hnsw.NewTxnCache(again, 1), vecs)
+		require.NoError(t, err)
+		key := string(hnsw.DataKey(ix.entryKey, centroidsUid))
+		require.Equal(t, txn.data[key], again.data[key])
+
+		_, err = trainer.Train(context.Background(), tc, [][]float32{{1, 2}, {1, 2, 3}})
+		require.Error(t, err)
+	}
+}
+
+func TestIVFPQSearch(t *testing.T) {
+	f := CreatePQFactory[float32](32)
+	o := parseOptions(t, f, map[string]string{NlistOpt: "4", NprobeOpt: "4",
+		SubvectorsOpt: "4", CodebookSizeOpt: "64"})
+	vi, err := f.CreateOrReplace(testPred, o, 32)
+	require.NoError(t, err)
+
+	txn := newMemTxn()
+	vecs := randomVectors(300, 8)
+	insertVectors(t, vi, txn, vecs)
+
+	// An entry is the uid and one byte per subvector.
+	ix := vi.(*persistentIVF[float32])
+	var n int
+	for list := range 4 {
+		require.NoError(t, ix.iterateList(hnsw.NewTxnCache(txn, 1), list,
+			func(_ uint64, code []byte) {
+				require.Len(t, code, 4)
+				n++
+			}))
+	}
+	require.Equal(t, len(vecs), n)
+
+	tc := hnsw.NewTxnCache(txn, 1)
+	for _, uid := range []uint64{3, 100, 250} {
+		uids, err := vi.SearchWithUid(context.Background(), tc, uid, 3, index.AcceptAll[float32])
+		require.NoError(t, err)
+		require.Equal(t, uid, uids[0])
+	}
+
+	// The codes give a good enough ranking for most of the exact neighbors to be found.
+	query := randomVectors(301, 8)[300]
+	uids, err := vi.Search(context.Background(), tc, query, 10, index.AcceptAll[float32])
+	require.NoError(t, err)
+	require.Len(t, uids, 10)
+	var found int
+	for _, uid := range nearest(hnsw.Euclidean, vecs, query, 10) {
+		for _, u := range uids {
+			if u == uid {
+				found++
+			}
+		}
+	}
+	require.GreaterOrEqual(t, found, 7)
+
+	_, err = vi.Insert(context.Background(), tc, 500, make([]float32, 6))
+	require.Error(t, err)
+}
+
+func TestIVFRemove(t *testing.T) {
+	for _, f := range []index.IndexFactory[float32]{CreateFlatFactory[float32](32),
+		CreatePQFactory[float32](32)} {
+		o := parseOptions(t, f, map[string]string{NlistOpt: "8", NprobeOpt: "8"})
+		if f.Name() == IvfPQ {
+			o = parseOptions(t, f, map[string]string{NlistOpt: "8", NprobeOpt: "8",
+				SubvectorsOpt: "4", CodebookSizeOpt: "16"})
+		}
+		vi, err := f.CreateOrReplace(testPred, o, 32)
+		require.NoError(t, err)
+
+		txn := newMemTxn()
+		vecs := randomVectors(100, 4)
+		insertVectors(t, vi, txn, vecs)
+
+		tc := hnsw.NewTxnCache(txn, 1)
+		// The vector isn't needed to find the entry, the chunk holding it is looked up.
+		for _, uid := range []uint64{5, 50} {
+			var vec []float32
+			if uid == 5 {
+				vec = vecs[uid-1]
+			}
+			edges, err := vi.Remove(context.Background(), tc, uid, vec)
+			require.NoError(t, err)
+			require.Len(t, edges, 2, f.Name())
+		}
+		edges, err := vi.Remove(context.Background(), tc, 5, vecs[4])
+		require.NoError(t, err)
+		require.Empty(t, edges, f.Name())
+
+		ix := vi.(*persistentIVF[float32])
+		var n int
+		for list := range 8 {
+			require.NoError(t, ix.iterateList(tc, list, func(uid uint64, _ []byte) {
+				require.NotContains(t, []uint64{5, 50}, uid)
+				n++
+			}))
+		}
+		require.Equal(t, len(vecs)-2, n, f.Name())
+
+		// The removed vectors are still stored, but they are no longer found.
+		uids, err := vi.Search(context.Background(), tc, vecs[4], 3, index.AcceptAll[float32])
+		require.NoError(t, err)
+		require.NotContains(t, uids, uint64(5), f.Name())
+		require.Len(t, uids, 3, f.Name())
+	}
+}
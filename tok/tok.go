@@ -20,6 +20,7 @@ import (
 
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/tok/ivf"
 	opts "github.com/hypermodeinc/dgraph/v25/tok/options"
 	"github.com/hypermodeinc/dgraph/v25/types"
 	"github.com/hypermodeinc/dgraph/v25/x"
@@ -48,6 +49,7 @@ const (
 	IdentSha       = 0xC
 	IdentBigFloat  = 0xD
 	IdentVFloat    = 0xE
+	IdentBM25      = 0xF
 	IdentCustom    = 0x80
 	IdentDelimiter = 0x1f // ASCII 31 - Unit separator
 )
@@ -85,6 +87,8 @@ var indexFactories = make(map[string]IndexFactory)
 func init() {
 	registerTokenizer(BigFloatTokenizer{})
 	registerIndexFactory(createIndexFactory(hnsw.CreateFactory[float32](32)))
+	registerIndexFactory(createIndexFactory(ivf.CreateFlatFactory[float32](32)))
+	registerIndexFactory(createIndexFactory(ivf.CreatePQFactory[float32](32)))
 	registerTokenizer(GeoTokenizer{})
 	registerTokenizer(IntTokenizer{})
 	registerTokenizer(FloatTokenizer{})
@@ -98,6 +102,7 @@ func init() {
 	registerTokenizer(HashTokenizer{})
 	registerTokenizer(TermTokenizer{})
 	registerTokenizer(FullTextTokenizer{})
+	registerTokenizer(BM25Tokenizer{})
 	registerTokenizer(Sha256Tokenizer{})
 	setupBleve()
 }
@@ -304,6 +304,48 @@ func TestTermTokenizeCJKChinese(t *testing.T) {
 
 }
 
+func TestBM25Tokenizer(t *testing.T) {
+	tokenizer, has := GetTokenizer("bm25")
+	require.True(t, has)
+	require.NotNil(t, tokenizer)
+
+	got, err := BuildTokens("The quick brown fox and the quick dog", tokenizer)
+	require.NoError(t, err)
+	require.Len(t, got, 4)
+
+	counts := make(map[string]uint32)
+	for _, token := range got {
+		count, ok := BM25Count(token)
+		require.True(t, ok)
+		counts[token[:len(token)-4]] = count
+	}
+	require.Equal(t, map[string]uint32{
+		BM25TermPrefix("quick"): 2,
+		BM25TermPrefix("brown"): 1,
+		BM25TermPrefix("fox"):   1,
+		BM25TermPrefix("dog"):   1,
+	}, counts)
+	require.Equal(t, []string{"quick", "fox"}, GetBM25Terms("quick fox, the quick foxes", "en"))
+
+	stats, err := UnmarshalBM25Stats(BM25Stats{N: 3, Total: 10}.Marshal())
+	require.NoError(t, err)
+	require.Equal(t, BM25Stats{N: 3, Total: 10}, stats)
+	require.InDelta(t, 10.0/3, stats.AvgLength(), 1e-9)
+	length, err := UnmarshalBM25Length(MarshalBM25Length(5))
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), length)
+}
+
+func TestBM25Score(t *testing.T) {
+	p := DefaultBM25Params
+	idf := p.IDF(4, 2)
+	require.InDelta(t, math.Ln2, idf, 1e-9)
+	// The score grows with the frequency of the term, and shrinks with the length of the value.
+	require.Greater(t, p.TermScore(idf, 2, 5, 3.25), p.TermScore(idf, 1, 5, 3.25))
+	require.Greater(t, p.TermScore(idf, 1, 2, 3.25), p.TermScore(idf, 1, 5, 3.25))
+	require.InDelta(t, 0.8226, p.TermScore(idf, 1, 2, 3.25), 1e-4)
+}
+
 func checkSortedAndUnique(t *testing.T, tokens []string) {
 	if !sort.StringsAreSorted(tokens) {
 		t.Error("tokens were not sorted")
@@ -162,6 +162,12 @@ func Convert(from Val, toID TypeID) (Val, error) {
 					return to, errors.Errorf("invalid data for vector of floats: %v", data)
 				}
 				*res = BytesAsFloatArray(data)
+			case VFloat16ID, VInt8ID, VBinaryID:
+				vc, err := BytesAsVector(toID, data)
+				if err != nil {
+					return to, err
+				}
+				*res = vc
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -228,6 +234,15 @@ func Convert(from Val, toID TypeID) (Val, error) {
 					return to, err
 				}
 				*res = vf
+			case VFloat16ID, VInt8ID, VBinaryID:
+				vf, err := ParseVFloat(vc)
+				if err != nil {
+					return to, err
+				}
+				if err := CheckVector(toID, vf); err != nil {
+					return to, err
+				}
+				*res = vf
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -468,6 +483,34 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				vc := BytesAsFloatArray(data)
 				sa := FloatArrayAsString(vc)
 				*res = sa
+			case VFloat16ID, VInt8ID, VBinaryID:
+				vc := BytesAsFloatArray(data)
+				if err := CheckVector(toID, vc); err != nil {
+					return to, err
+				}
+				*res = vc
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
+	case VFloat16ID, VInt8ID, VBinaryID:
+		{
+			if toID == BinaryID {
+				*res = data
+				return to, nil
+			}
+			vc, err := BytesAsVector(fromID, data)
+			if err != nil {
+				return to, err
+			}
+			switch toID {
+			case VFloatID, VFloat16ID, VInt8ID, VBinaryID:
+				if err := CheckVector(toID, vc); err != nil {
+					return to, err
+				}
+				*res = vc
+			case StringID:
+				*res = FloatArrayAsString(vc)
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -617,11 +660,11 @@ func Marshal(from Val, to *Val) error {
 		default:
 			return cantConvert(fromID, toID)
 		}
-	case VFloatID:
+	case VFloatID, VFloat16ID, VInt8ID, VBinaryID:
 		vc := val.([]float32)
 		switch toID {
 		case BinaryID:
-			*res = FloatArrayAsBytes(vc)
+			*res = VectorAsBytes(fromID, vc)
 		case StringID:
 			*res = FloatArrayAsString(vc)
 		default:
@@ -707,6 +750,14 @@ func ObjectValue(id TypeID, value interface{}) (*api.Value, error) {
 			return def, err
 		}
 		return &api.Value{Val: &api.Value_Vfloat32Val{Vfloat32Val: vf}}, nil
+	// There is no dedicated field for the other vector types, they are sent as float32 vectors
+	// and converted back to the type of the predicate when they are stored.
+	case VFloat16ID, VInt8ID, VBinaryID:
+		vf, err := toBinary(VFloatID, value)
+		if err != nil {
+			return def, err
+		}
+		return &api.Value{Val: &api.Value_Vfloat32Val{Vfloat32Val: vf}}, nil
 	default:
 		return def, errors.Errorf("ObjectValue not available for: %v", id)
 	}
@@ -62,6 +62,12 @@ const (
 	UndefinedID = TypeID(100)
 	// BigFloatID represents the arbitrary precision type.
 	BigFloatID = TypeID(pb.Posting_BIGFLOAT)
+	// VFloat16ID represents a vector of IEEE754 16-bit floats.
+	VFloat16ID = TypeID(pb.Posting_VFLOAT16)
+	// VInt8ID represents a vector of 8-bit signed integers.
+	VInt8ID = TypeID(pb.Posting_VINT8)
+	// VBinaryID represents a vector of bits.
+	VBinaryID = TypeID(pb.Posting_VBINARY)
 )
 
 var typeNameMap = map[string]TypeID{
@@ -77,6 +83,9 @@ var typeNameMap = map[string]TypeID{
 	"password":      PasswordID,
 	"bigfloat":      BigFloatID,
 	"float32vector": VFloatID,
+	"float16vector": VFloat16ID,
+	"int8vector":    VInt8ID,
+	"binaryvector":  VBinaryID,
 }
 
 // TypeID represents the type of the data.
@@ -114,6 +123,12 @@ func (t TypeID) Name() string {
 		return "bigfloat"
 	case VFloatID:
 		return "float32vector"
+	case VFloat16ID:
+		return "float16vector"
+	case VInt8ID:
+		return "int8vector"
+	case VBinaryID:
+		return "binaryvector"
 	}
 	return ""
 }
@@ -155,6 +170,12 @@ func (t TypeID) IsNumber() bool {
 	return t == IntID || t == FloatID
 }
 
+// IsVector returns whether the type is a vector type. The values of all the vector types are
+// held as []float32 in memory, they only differ in how they are stored.
+func (t TypeID) IsVector() bool {
+	return t == VFloatID || t == VFloat16ID || t == VInt8ID || t == VBinaryID
+}
+
 // ValueForType returns the zero value for a type id
 func ValueForType(id TypeID) Val {
 	switch id {
@@ -202,9 +223,9 @@ func ValueForType(id TypeID) Val {
 	case PasswordID:
 		var p string
 		return Val{PasswordID, p}
-	case VFloatID:
+	case VFloatID, VFloat16ID, VInt8ID, VBinaryID:
 		var v []float32
-		return Val{VFloatID, &v}
+		return Val{id, &v}
 	default:
 		return Val{}
 	}
@@ -0,0 +1,174 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package types
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// maxFloat16 is the largest finite value of an IEEE754 16-bit float.
+const maxFloat16 = 65504
+
+// CheckVector returns an error if v can't be stored as a vector of type tid: the values of an
+// int8vector must be integers in [-128, 127], the values of a binaryvector must be 0 or 1 and its
+// length a multiple of 8, and the values of a float16vector must fit in a 16-bit float.
+func CheckVector(tid TypeID, v []float32) error {
+	switch tid {
+	case VFloat16ID:
+		for _, f := range v {
+			if math.Abs(float64(f)) > maxFloat16 {
+				return errors.Errorf("value %v of vector is out of range for %s", f, tid.Name())
+			}
+		}
+	case VInt8ID:
+		for _, f := range v {
+			if f != float32(math.Trunc(float64(f))) || f < math.MinInt8 || f > math.MaxInt8 {
+				return errors.Errorf("value %v of vector is not a valid int8 for %s", f, tid.Name())
+			}
+		}
+	case VBinaryID:
+		for _, f := range v {
+			if f != 0 && f != 1 {
+				return errors.Errorf("value %v of vector is not a bit for %s", f, tid.Name())
+			}
+		}
+		if len(v)%8 != 0 {
+			return errors.Errorf("length %d of %s is not a multiple of 8", len(v), tid.Name())
+		}
+	}
+	return nil
+}
+
+// VectorAsBytes encodes v according to the vector type tid. float32vector takes 4 bytes per
+// value, float16vector 2, int8vector 1, and binaryvector packs 8 values per byte. The values are
+// expected to have been validated with CheckVector.
+func VectorAsBytes(tid TypeID, v []float32) []byte {
+	switch tid {
+	case VFloat16ID:
+		b := make([]byte, 2*len(v))
+		for i, f := range v {
+			binary.LittleEndian.PutUint16(b[2*i:], float32ToFloat16(f))
+		}
+		return b
+	case VInt8ID:
+		b := make([]byte, len(v))
+		for i, f := range v {
+			b[i] = byte(int8(f))
+		}
+		return b
+	case VBinaryID:
+		b := make([]byte, (len(v)+7)/8)
+		for i, f := range v {
+			if f != 0 {
+				b[i/8] |= 1 << (7 - uint(i%8))
+			}
+		}
+		return b
+	default:
+		return FloatArrayAsBytes(v)
+	}
+}
+
+// BytesAsVector decodes a vector of type tid encoded by VectorAsBytes.
+func BytesAsVector(tid TypeID, data []byte) ([]float32, error) {
+	switch tid {
+	case VFloat16ID:
+		if len(data)%2 != 0 {
+			return nil, errors.Errorf("invalid data for vector of float16: %v", data)
+		}
+		v := make([]float32, len(data)/2)
+		for i := range v {
+			v[i] = float16ToFloat32(binary.LittleEndian.Uint16(data[2*i:]))
+		}
+		return v, nil
+	case VInt8ID:
+		v := make([]float32, len(data))
+		for i, b := range data {
+			v[i] = float32(int8(b))
+		}
+		return v, nil
+	case VBinaryID:
+		v := make([]float32, 8*len(data))
+		for i := range v {
+			if data[i/8]&(1<<(7-uint(i%8))) != 0 {
+				v[i] = 1
+			}
+		}
+		return v, nil
+	case VFloatID:
+		if len(data)%4 != 0 {
+			return nil, errors.Errorf("invalid data for vector of floats: %v", data)
+		}
+		return BytesAsFloatArray(data), nil
+	default:
+		return nil, errors.Errorf("%s is not a vector type", tid.Name())
+	}
+}
+
+// float32ToFloat16 returns the bits of the IEEE754 16-bit float nearest to f, rounding ties to
+// even. Values out of range become infinities.
+func float32ToFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	if exp == 0xff {
+		if mant != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	}
+	e := exp - 127 + 15
+	switch {
+	case e >= 0x1f:
+		return sign | 0x7c00
+	case e <= 0:
+		// Subnormal, or too small to be represented.
+		if e < -10 {
+			return sign
+		}
+		mant |= 0x800000
+		shift := uint(14 - e)
+		h := mant >> shift
+		rem, half := mant&(1<<shift-1), uint32(1)<<(shift-1)
+		if rem > half || (rem == half && h&1 == 1) {
+			h++
+		}
+		return sign | uint16(h)
+	default:
+		h := uint32(e)<<10 | mant>>13
+		rem := mant & 0x1fff
+		// A carry out of the mantissa correctly bumps the exponent, up to infinity.
+		if rem > 0x1000 || (rem == 0x1000 && h&1 == 1) {
+			h++
+		}
+		return sign | uint16(h)
+	}
+}
+
+// float16ToFloat32 returns the value of the IEEE754 16-bit float with bits h.
+func float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0:
+		f := float32(mant) / (1 << 24)
+		if sign != 0 {
+			f = -f
+		}
+		return f
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
@@ -0,0 +1,104 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertStringToVectors(t *testing.T) {
+	tests := []struct {
+		in  string
+		tid TypeID
+		out []float32
+		err bool
+	}{
+		{in: "[1.5, -2, 0.25]", tid: VFloat16ID, out: []float32{1.5, -2, 0.25}},
+		{in: "[65504, -65504]", tid: VFloat16ID, out: []float32{65504, -65504}},
+		{in: "[70000]", tid: VFloat16ID, err: true},
+		{in: "[127, -128, 0]", tid: VInt8ID, out: []float32{127, -128, 0}},
+		{in: "[128]", tid: VInt8ID, err: true},
+		{in: "[1.5]", tid: VInt8ID, err: true},
+		{in: "[1, 0, 1, 1, 0, 0, 0, 1]", tid: VBinaryID, out: []float32{1, 0, 1, 1, 0, 0, 0, 1}},
+		{in: "[1, 0, 2, 1, 0, 0, 0, 1]", tid: VBinaryID, err: true},
+		{in: "[1, 0, 1]", tid: VBinaryID, err: true},
+	}
+
+	for _, tc := range tests {
+		out, err := Convert(Val{Tid: StringID, Value: []byte(tc.in)}, tc.tid)
+		if tc.err {
+			require.Error(t, err, tc.in)
+			continue
+		}
+		require.NoError(t, err, tc.in)
+		require.Equal(t, Val{Tid: tc.tid, Value: tc.out}, out)
+	}
+}
+
+func TestVectorsRoundTrip(t *testing.T) {
+	tests := []struct {
+		tid  TypeID
+		in   []float32
+		size int
+	}{
+		{tid: VFloatID, in: []float32{0.1, -2.5, 3}, size: 12},
+		{tid: VFloat16ID, in: []float32{0.5, -2.5, 3}, size: 6},
+		{tid: VInt8ID, in: []float32{-128, 5, 127}, size: 3},
+		{tid: VBinaryID, in: []float32{1, 0, 0, 1, 1, 1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1}, size: 2},
+	}
+
+	for _, tc := range tests {
+		b := ValueForType(BinaryID)
+		require.NoError(t, Marshal(Val{Tid: tc.tid, Value: tc.in}, &b))
+		require.Len(t, b.Value.([]byte), tc.size, tc.tid.Name())
+
+		out, err := Convert(Val{Tid: tc.tid, Value: b.Value.([]byte)}, tc.tid)
+		require.NoError(t, err)
+		require.Equal(t, tc.in, out.Value)
+
+		// The vectors of all types can be read as float32 vectors.
+		out, err = Convert(Val{Tid: tc.tid, Value: b.Value.([]byte)}, VFloatID)
+		require.NoError(t, err)
+		require.Equal(t, tc.in, out.Value)
+	}
+}
+
+func TestConvertFloat32VectorToInt8Vector(t *testing.T) {
+	out, err := Convert(Val{Tid: VFloatID, Value: FloatArrayAsBytes([]float32{1, -3})}, VInt8ID)
+	require.NoError(t, err)
+	require.Equal(t, Val{Tid: VInt8ID, Value: []float32{1, -3}}, out)
+
+	_, err = Convert(Val{Tid: VFloatID, Value: FloatArrayAsBytes([]float32{1.5})}, VInt8ID)
+	require.Error(t, err)
+}
+
+func TestFloat16(t *testing.T) {
+	tests := []struct {
+		in   float32
+		bits uint16
+		out  float32
+	}{
+		{in: 1, bits: 0x3c00, out: 1},
+		{in: -2, bits: 0xc000, out: -2},
+		{in: 65504, bits: 0x7bff, out: 65504},
+		{in: 1e6, bits: 0x7c00, out: float32(math.Inf(1))},
+		// The smallest subnormal.
+		{in: 1.0 / (1 << 24), bits: 0x0001, out: 1.0 / (1 << 24)},
+		{in: 1e-10, bits: 0x0000, out: 0},
+		// 1 + 2^-11 is halfway between 1 and the next float16, and rounds to even.
+		{in: 1 + 1.0/(1<<11), bits: 0x3c00, out: 1},
+		{in: 0.1, bits: 0x2e66, out: 0.0999755859375},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.bits, float32ToFloat16(tc.in), "%v", tc.in)
+		require.Equal(t, tc.out, float16ToFloat32(tc.bits), "%#x", tc.bits)
+	}
+	require.True(t, math.IsNaN(float64(float16ToFloat32(float32ToFloat16(float32(math.NaN()))))))
+}
@@ -23,7 +23,7 @@ func couldApplyAggregatorOn(agrtr string, typ types.TypeID) bool {
 	case "sum", "avg":
 		return typ == types.IntID ||
 			typ == types.FloatID ||
-			typ == types.VFloatID
+			typ.IsVector()
 	default:
 		return false
 	}
@@ -14,6 +14,7 @@ import (
 	"math"
 	"net/url"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -32,6 +33,7 @@ import (
 	"github.com/hypermodeinc/dgraph/v25/posting"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/types"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
 
@@ -102,6 +104,18 @@ func (m *Manifest) getPredsInGroup(gid uint32) predicateSet {
 	return predSet
 }
 
+// splitPredicates returns the predicates split across groups in state, sorted, with their
+// namespaces.
+func splitPredicates(state *pb.MembershipState) []string {
+	var preds []string
+	for pred := range state.GetTabletRanges() {
+		ns, attr := x.ParseNamespaceAttr(pred)
+		preds = append(preds, fmt.Sprintf("%s (namespace %d)", attr, ns))
+	}
+	sort.Strings(preds)
+	return preds
+}
+
 // GetCredentialsFromRequest extracts the credentials from a backup request.
 func GetCredentialsFromRequest(req *pb.BackupRequest) *x.MinioCredentials {
 	return &x.MinioCredentials{
@@ -276,6 +290,12 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 
 	// Get the current membership state and parse it for easier processing.
 	state := GetMembershipState()
+	// The tablet of a split predicate is only listed in the group serving its first range, and a
+	// restore assigns every predicate to a single group, so the other ranges would be lost.
+	if preds := splitPredicates(state); len(preds) > 0 {
+		return errors.Errorf("cannot back up predicates split across groups: %s. "+
+			"Merge their ranges before taking a backup.", strings.Join(preds, ", "))
+	}
 	var groups []uint32
 	predMap := make(map[uint32][]string)
 	for gid, group := range state.Groups {
@@ -297,9 +317,9 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 		}
 
 		for _, pred := range schema {
-			if pred.Type == "float32vector" && len(pred.IndexSpecs) != 0 {
-				vecPredMap[gid] = append(predMap[gid], pred.Predicate+hnsw.VecEntry, pred.Predicate+hnsw.VecKeyword,
-					pred.Predicate+hnsw.VecDead)
+			if typ, ok := types.TypeForName(pred.Type); ok && typ.IsVector() && len(pred.IndexSpecs) != 0 {
+				vecPredMap[gid] = append(predMap[gid],
+					hnsw.SupportingPredicates(pred.Predicate, pred.List)...)
 			}
 		}
 	}
@@ -0,0 +1,176 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/hypermodeinc/dgraph/v25/algo"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// handleSearchFunction ranks the values of the predicate matching any of the terms of the query
+// with BM25, using the term frequencies, the value lengths and the stats kept by the bm25 index.
+// The uids are returned in the UidMatrix, and their scores in the ValueMatrix, in the same order.
+func (qs *queryState) handleSearchFunction(ctx context.Context, arg funcArgs) error {
+	attr, readTs := arg.q.Attr, arg.q.ReadTs
+	params := tok.DefaultBM25Params
+
+	// tfs holds the frequency of every term in the values that contain it.
+	tfs := make([]map[uint64]uint32, len(arg.srcFn.tokens))
+	matches := make(map[uint64]struct{})
+	for i, term := range arg.srcFn.tokens {
+		tfs[i] = make(map[uint64]uint32)
+		err := qs.iterateBM25Tokens(ctx, attr, tok.BM25TermPrefix(term), readTs,
+			func(tf uint32, uids []uint64) {
+				for _, uid := range uids {
+					if tf > tfs[i][uid] {
+						tfs[i][uid] = tf
+					}
+					matches[uid] = struct{}{}
+				}
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	uids := make([]uint64, 0, len(matches))
+	for uid := range matches {
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	res := &pb.List{Uids: uids}
+	if arg.q.UidList != nil {
+		algo.IntersectWith(res, arg.q.UidList, res)
+	}
+
+	stats, err := qs.bm25Stats(attr, readTs)
+	if err != nil {
+		return err
+	}
+	avgdl := stats.AvgLength()
+
+	idfs := make([]float64, len(tfs))
+	for i := range tfs {
+		idfs[i] = params.IDF(stats.N, uint64(len(tfs[i])))
+	}
+	scores := &pb.ValueList{Values: make([]*pb.TaskValue, 0, len(res.Uids))}
+	for _, uid := range res.Uids {
+		var length uint32
+		val, err := qs.bm25Value(attr, tok.BM25LengthToken(uid), readTs)
+		if err != nil {
+			return err
+		}
+		if val != nil {
+			if length, err = tok.UnmarshalBM25Length(val); err != nil {
+				return err
+			}
+		}
+		var score float64
+		for i := range tfs {
+			if tf, ok := tfs[i][uid]; ok {
+				score += params.TermScore(idfs[i], tf, length, avgdl)
+			}
+		}
+		tv, err := convertToType(types.Val{Tid: types.FloatID, Value: score}, types.FloatID)
+		if err != nil {
+			return err
+		}
+		scores.Values = append(scores.Values, tv)
+	}
+	arg.out.UidMatrix = append(arg.out.UidMatrix, res)
+	arg.out.ValueMatrix = append(arg.out.ValueMatrix, scores)
+	return nil
+}
+
+// bm25Stats returns the number of documents of the bm25 index of attr and their total length,
+// summed over the shards they are kept in.
+func (qs *queryState) bm25Stats(attr string, readTs uint64) (tok.BM25Stats, error) {
+	var stats tok.BM25Stats
+	for shard := 0; shard < tok.BM25StatsShards; shard++ {
+		val, err := qs.bm25Value(attr, tok.BM25StatsToken(byte(shard)), readTs)
+		if err != nil {
+			return stats, err
+		}
+		if val == nil {
+			continue
+		}
+		s, err := tok.UnmarshalBM25Stats(val)
+		if err != nil {
+			return stats, err
+		}
+		stats.Add(s)
+	}
+	return stats, nil
+}
+
+// bm25Value returns the value of the bm25 index of attr stored under token, or nil if there is
+// none.
+func (qs *queryState) bm25Value(attr, token string, readTs uint64) ([]byte, error) {
+	pl, err := qs.cache.Get(x.IndexKey(attr, token))
+	if err != nil {
+		return nil, err
+	}
+	val, err := pl.Value(readTs)
+	switch {
+	case errors.Is(err, posting.ErrNoValue):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	b, _ := val.Value.([]byte)
+	return b, nil
+}
+
+// iterateBM25Tokens calls f with the count stored in every bm25 token of attr starting with
+// prefix, along with the uids of the values having the token.
+func (qs *queryState) iterateBM25Tokens(ctx context.Context, attr, prefix string, readTs uint64,
+	f func(uint32, []uint64)) error {
+
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.Prefix = x.IndexKey(attr, prefix)
+	itr := txn.NewIterator(itOpt)
+	defer itr.Close()
+
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		key := itr.Item().KeyCopy(nil)
+		pk, err := x.Parse(key)
+		if err != nil {
+			return err
+		}
+		if len(pk.Term) != len(prefix)+4 {
+			continue
+		}
+		count, _ := tok.BM25Count(pk.Term)
+		pl, err := qs.cache.GetUids(key)
+		if err != nil {
+			return err
+		}
+		uids, err := pl.Uids(posting.ListOptions{ReadTs: readTs})
+		if err != nil {
+			return err
+		}
+		f(count, uids.Uids)
+	}
+	return nil
+}
@@ -8,7 +8,6 @@ package worker
 import (
 	"bytes"
 	"encoding/binary"
-	"encoding/json"
 	"math"
 	"strconv"
 	"strings"
@@ -44,11 +43,18 @@ const (
 type CDC struct {
 	sync.Mutex
 	sink             Sink
+	rules            *cdcRules
 	closer           *z.Closer
 	pendingTxnEvents map[uint64][]CDCEvent
+	replayCh         chan cdcReplay
 
 	// dont use mutex, use atomic for the following.
 
+	// backfillTs is the timestamp at which the data must be sent before processing the Raft
+	// logs, set by a replay. It's part of the CDC state replicated through Raft, so that a new
+	// leader resumes the backfill.
+	backfillTs uint64
+
 	// seenIndex is the Raft index till which we have read the raft logs, and
 	// put the events in our pendingTxnEvents. This does NOT mean that we have
 	// sent them yet.
@@ -64,10 +70,14 @@ func newCDC() *CDC {
 	cdcFlag := z.NewSuperFlag(Config.ChangeDataConf).MergeAndCheckDefault(CDCDefaults)
 	sink, err := GetSink(cdcFlag)
 	x.Check(err)
+	rules, err := parseCDCRules(cdcFlag)
+	x.Check(err)
 	cdc := &CDC{
 		sink:             sink,
+		rules:            rules,
 		closer:           z.NewCloser(1),
 		pendingTxnEvents: make(map[uint64][]CDCEvent),
+		replayCh:         make(chan cdcReplay),
 	}
 	return cdc
 }
@@ -131,12 +141,18 @@ func (cdc *CDC) hasPending(attr string) bool {
 }
 
 func (cdc *CDC) addToPending(ts uint64, events []CDCEvent) {
-	if cdc == nil {
+	if cdc == nil || len(events) == 0 {
 		return
 	}
 	cdc.Lock()
 	defer cdc.Unlock()
-	cdc.pendingTxnEvents[ts] = append(cdc.pendingTxnEvents[ts], events...)
+	// The Raft entries are read again after a failure to send the events, and the ones of the
+	// mutations already added are skipped.
+	pending := cdc.pendingTxnEvents[ts]
+	if n := len(pending); n > 0 && pending[n-1].Meta.RaftIndex >= events[0].Meta.RaftIndex {
+		return
+	}
+	cdc.pendingTxnEvents[ts] = append(pending, events...)
 }
 
 func (cdc *CDC) removeFromPending(ts uint64) {
@@ -164,6 +180,18 @@ func (cdc *CDC) updateCDCState(state *pb.CDCState) {
 		return
 	}
 
+	if state.Replay {
+		// The events are sent again from the first entry of the Raft log, the transactions
+		// committed up to sentTs being skipped. Resetting the seenIndex also prevents the Raft
+		// log from being truncated during the backfill, as the snapshots don't go beyond it.
+		cdc.resetPendingEvents()
+		atomic.StoreUint64(&cdc.seenIndex, 0)
+		atomic.StoreUint64(&cdc.sentTs, state.SentTs)
+		atomic.StoreUint64(&cdc.backfillTs, state.BackfillTs)
+		return
+	}
+	atomic.StoreUint64(&cdc.backfillTs, state.BackfillTs)
+
 	// Dont try to update seen index in case of default mode else cdc job will not
 	// be able to build the complete pending txns in case of membership changes.
 	ts := atomic.LoadUint64(&cdc.sentTs)
@@ -188,43 +216,37 @@ func (cdc *CDC) processCDCEvents() {
 		return
 	}
 
-	sendToSink := func(pending []CDCEvent, commitTs uint64) error {
-		batch := make([]SinkMessage, 0)
-		for _, e := range pending {
-			e.Meta.CommitTs = commitTs
-			b, err := json.Marshal(e)
-			if err != nil {
-				glog.Errorf("error while marshalling batch for event [%+v]: %v\n", e.Event, err)
-				continue
-			}
-			batch = append(batch, SinkMessage{
-				Meta: SinkMeta{
-					Topic: defaultEventTopic,
-				},
-				Key:   e.Meta.Namespace,
-				Value: b,
-			})
+	sendToSink := func(txns []cdcTxn) error {
+		if len(txns) == 0 {
+			return nil
+		}
+		txns, err := cdc.rules.filter(cdc.closer.Ctx(), txns)
+		if err != nil {
+			return err
+		}
+		batch, err := cdc.rules.encode(cdc.closer.Ctx(), txns)
+		if err != nil {
+			return err
 		}
-		if err := cdc.sink.Send(batch); err != nil {
+		if err := cdc.sink.Send(cdc.closer.Ctx(), batch); err != nil {
 			glog.Errorf("error while sending cdc event to sink %+v", err)
 			return err
 		}
 		// We successfully sent messages to sink.
-		atomic.StoreUint64(&cdc.sentTs, commitTs)
+		atomic.StoreUint64(&cdc.sentTs, txns[len(txns)-1].commitTs)
 		return nil
 	}
 
-	handleEntry := func(entry raftpb.Entry) (rerr error) {
-		defer func() {
-			// Irrespective of whether we act on this entry or not, we should
-			// always update the seenIndex. Otherwise, we'll loop over these
-			// entries over and over again. However, if we encounter an error,
-			// we should not update the index.
-			if rerr == nil {
-				cdc.updateSeenIndex(entry.Index)
-			}
-		}()
+	// The transactions are sent once per batch of Raft entries, so that the values read by the
+	// rules are read for all of them at once. Until they are sent, the events of the committed
+	// transactions are kept in removed, to put them back in pendingTxnEvents if that fails.
+	var flush []cdcTxn
+	removed := make(map[uint64][]CDCEvent)
+	queue := func(events []CDCEvent, commitTs uint64) {
+		flush = append(flush, cdcTxn{events: events, commitTs: commitTs})
+	}
 
+	handleEntry := func(entry raftpb.Entry) {
 		if entry.Type != raftpb.EntryNormal || len(entry.Data) == 0 {
 			return
 		}
@@ -253,10 +275,11 @@ func (cdc *CDC) processCDCEvents() {
 					}
 					cdc.resetPendingEventsForNs(ns)
 				}
-				if err := sendToSink(events, proposal.Mutations.StartTs); err != nil {
-					rerr = errors.Wrapf(err, "unable to send messages to sink")
+				// The drops before sentTs have already been sent, unless the events are replayed.
+				if atomic.LoadUint64(&cdc.sentTs) >= proposal.Mutations.StartTs {
 					return
 				}
+				queue(events, proposal.Mutations.StartTs)
 				// If drop predicate, then mutation only succeeds if there were no pending txn
 				// This check ensures then event will only be send if there were no pending txns
 			case len(edges) == 1 &&
@@ -264,10 +287,9 @@ func (cdc *CDC) processCDCEvents() {
 				bytes.Equal(edges[0].Value, []byte(x.Star)):
 				// If there are no pending txn send the events else
 				// return as the mutation must have errored out in that case.
-				if !cdc.hasPending(x.ParseAttr(edges[0].Attr)) {
-					if err := sendToSink(events, proposal.Mutations.StartTs); err != nil {
-						rerr = errors.Wrapf(err, "unable to send messages to sink")
-					}
+				if !cdc.hasPending(x.ParseAttr(edges[0].Attr)) &&
+					atomic.LoadUint64(&cdc.sentTs) < proposal.Mutations.StartTs {
+					queue(events, proposal.Mutations.StartTs)
 				}
 				return
 			default:
@@ -280,16 +302,14 @@ func (cdc *CDC) processCDCEvents() {
 				// This ensures we dont send events again in case of membership changes.
 				if ts.CommitTs > 0 && atomic.LoadUint64(&cdc.sentTs) < ts.CommitTs {
 					events := cdc.pendingTxnEvents[ts.StartTs]
-					if err := sendToSink(events, ts.CommitTs); err != nil {
-						rerr = errors.Wrapf(err, "unable to send messages to sink")
-						return
-					}
+					queue(events, ts.CommitTs)
+					removed[ts.StartTs] = events
 				}
-				// Delete from pending events once events are sent.
+				// Delete from pending events once events are queued. They are put back if they
+				// can't be sent.
 				cdc.removeFromPending(ts.StartTs)
 			}
 		}
-		return
 	}
 
 	// This will always run on leader node only. For default mode, Leader will
@@ -316,9 +336,23 @@ func (cdc *CDC) processCDCEvents() {
 			}
 			batchFirst = entries[len(entries)-1].Index + 1
 			for _, entry := range entries {
-				if err := handleEntry(entry); err != nil {
-					return errors.Wrapf(err, "CDC: unable to process raft entry")
+				handleEntry(entry)
+			}
+			err = sendToSink(flush)
+			if err != nil {
+				// The entries are read again by the next attempt, the events of the mutations
+				// already in pendingTxnEvents being skipped.
+				for startTs, events := range removed {
+					cdc.addToPending(startTs, events)
 				}
+			} else {
+				// We should not update the seenIndex before the events are sent, otherwise
+				// we'll skip them.
+				cdc.updateSeenIndex(entries[len(entries)-1].Index)
+			}
+			flush, removed = flush[:0], make(map[uint64][]CDCEvent)
+			if err != nil {
+				return errors.Wrapf(err, "CDC: unable to send messages to sink")
 			}
 		}
 		return nil
@@ -334,8 +368,26 @@ func (cdc *CDC) processCDCEvents() {
 		select {
 		case <-cdc.closer.HasBeenClosed():
 			return
+		case r := <-cdc.replayCh:
+			r.errCh <- cdc.startReplay(r.ctx, r.sinceTs)
 		case <-jobTick.C:
 			if groups().Node.AmLeader() {
+				if backfillTs := atomic.LoadUint64(&cdc.backfillTs); backfillTs > 0 {
+					// The Raft logs are only processed once the backfill is done, and it's retried
+					// from scratch if it fails, or if the group doesn't learn that it's done.
+					send := func(events []CDCEvent, readTs uint64) error {
+						return sendToSink([]cdcTxn{{events: events, commitTs: readTs}})
+					}
+					if err := cdc.backfill(backfillTs, send); err != nil {
+						glog.Errorf("CDC: unable to backfill the data at %d: %+v", backfillTs, err)
+						continue
+					}
+					if err := cdc.finishBackfill(); err != nil {
+						glog.Errorf("CDC: unable to propose the end of the backfill: %+v", err)
+						continue
+					}
+					glog.Infof("CDC: backfilled the data at %d", backfillTs)
+				}
 				if err := sendEvents(); err != nil {
 					glog.Errorf("unable to send events %+v", err)
 				}
@@ -350,7 +402,8 @@ func (cdc *CDC) processCDCEvents() {
 					// No need to propose anything.
 					continue
 				}
-				if err := groups().Node.proposeCDCState(atomic.LoadUint64(&cdc.sentTs)); err != nil {
+				err := groups().Node.proposeCDCState(sentTs, atomic.LoadUint64(&cdc.backfillTs))
+				if err != nil {
 					glog.Errorf("unable to propose cdc state %+v", err)
 				} else {
 					lastSent = sentTs
@@ -0,0 +1,261 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/hypermodeinc/dgraph/v25/conn"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// backfillBatchSize is the number of events of the backfill sent to the sink at once.
+const backfillBatchSize = 1000
+
+// cdcReplay asks the goroutine processing the CDC events to send them again from sinceTs.
+type cdcReplay struct {
+	ctx     context.Context
+	sinceTs uint64
+	errCh   chan error
+}
+
+// replay makes the CDC send the state of the data at sinceTs as set mutations, followed by the
+// events of all the transactions committed after sinceTs. It returns once the replay has been
+// accepted, the events are sent in the background.
+func (cdc *CDC) replay(ctx context.Context, sinceTs uint64) error {
+	if cdc == nil {
+		return errors.New("CDC is not enabled, it must be configured with the --cdc flag")
+	}
+	r := cdcReplay{ctx: ctx, sinceTs: sinceTs, errCh: make(chan error, 1)}
+	select {
+	case cdc.replayCh <- r:
+	case <-cdc.closer.HasBeenClosed():
+		return errors.New("CDC is shutting down")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err := <-r.errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// startReplay rewinds the CDC to sinceTs. The Raft log is read again from its first entry, the
+// transactions committed up to sinceTs being skipped thanks to sentTs, and the backfill is done
+// before the next entries get processed. The new state is proposed to the group, so that another
+// leader carries on with the replay. It must only be called by processCDCEvents.
+func (cdc *CDC) startReplay(ctx context.Context, sinceTs uint64) error {
+	n := groups().Node
+	if !n.AmLeader() {
+		return errors.Errorf("CDC events can only be replayed by the leader of group %d",
+			n.gid)
+	}
+	// The transactions committed after the snapshot are still in the Raft log, and the versions
+	// at its ReadTs are still in the posting store.
+	snap, err := n.Snapshot()
+	if err != nil {
+		return errors.Wrapf(err, "unable to get the snapshot of group %d", n.gid)
+	}
+	if sinceTs < snap.ReadTs {
+		return errors.Errorf("cannot replay the CDC events of group %d from %d, the changes "+
+			"before its last snapshot at %d have been discarded", n.gid, sinceTs, snap.ReadTs)
+	}
+
+	// The state is applied by updateCDCState once the proposal goes through, before the next
+	// events are processed.
+	state := &pb.CDCState{SentTs: sinceTs, BackfillTs: sinceTs, Replay: true}
+	if err := n.proposeAndWait(ctx, &pb.Proposal{CdcState: state}); err != nil {
+		return errors.Wrapf(err, "unable to propose the CDC replay of group %d", n.gid)
+	}
+	glog.Infof("CDC: replaying the events of group %d from %d", n.gid, sinceTs)
+	return nil
+}
+
+// finishBackfill proposes the end of the backfill to the group, and waits for the CDC state to
+// be updated. It must only be called by processCDCEvents.
+func (cdc *CDC) finishBackfill() error {
+	state := &pb.CDCState{SentTs: atomic.LoadUint64(&cdc.sentTs)}
+	return groups().Node.proposeAndWait(cdc.closer.Ctx(), &pb.Proposal{CdcState: state})
+}
+
+// backfill sends the data of the predicates served by the group at readTs as set mutations,
+// committed at readTs.
+func (cdc *CDC) backfill(readTs uint64, send func([]CDCEvent, uint64) error) error {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.AllVersions = true
+	itOpt.Prefix = []byte{x.DefaultPrefix}
+	itr := txn.NewIterator(itOpt)
+	defer itr.Close()
+
+	served := make(map[string]bool)
+	var events []CDCEvent
+	var prevKey []byte
+	for itr.Rewind(); itr.Valid(); {
+		item := itr.Item()
+		if bytes.Equal(item.Key(), prevKey) {
+			itr.Next()
+			continue
+		}
+		prevKey = append(prevKey[:0], item.Key()...)
+
+		pk, err := x.Parse(item.Key())
+		if err != nil {
+			return err
+		}
+		if !pk.IsData() || pk.HasStartUid || x.IsReservedPredicate(pk.Attr) {
+			continue
+		}
+		serves, ok := served[pk.Attr]
+		if !ok {
+			if serves, err = groups().ServesTablet(pk.Attr); err != nil {
+				return err
+			}
+			served[pk.Attr] = serves
+		}
+		if !serves {
+			continue
+		}
+
+		pl, err := posting.ReadPostingList(item.KeyCopy(nil), itr)
+		if err != nil {
+			return err
+		}
+		ns, attr := x.ParseNamespaceBytes(pk.Attr)
+		err = pl.Iterate(readTs, 0, func(p *pb.Posting) error {
+			events = append(events, CDCEvent{
+				Meta: &EventMeta{Namespace: ns},
+				Type: EventTypeMutation,
+				Event: &MutationEvent{
+					Operation: "set",
+					Uid:       pk.Uid,
+					Attr:      attr,
+					Value:     postingValue(p),
+					ValueType: postingType(p).Name(),
+				},
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(events) >= backfillBatchSize {
+			if err := send(events, readTs); err != nil {
+				return err
+			}
+			events = events[:0]
+		}
+		select {
+		case <-cdc.closer.HasBeenClosed():
+			return errors.New("CDC is shutting down")
+		default:
+		}
+	}
+	return send(events, readTs)
+}
+
+func postingType(p *pb.Posting) types.TypeID {
+	if p.PostingType == pb.Posting_REF {
+		return types.UidID
+	}
+	return types.TypeID(p.ValType)
+}
+
+// postingValue returns the value of the posting like it's sent in the events of the mutations.
+func postingValue(p *pb.Posting) interface{} {
+	switch tid := postingType(p); tid {
+	case types.UidID:
+		return p.Uid
+	case types.PasswordID:
+		return "****"
+	default:
+		v, err := types.Convert(types.Val{Tid: types.BinaryID, Value: p.Value}, tid)
+		if err != nil {
+			glog.Errorf("error while converting value %v", err)
+			return nil
+		}
+		return v.Value
+	}
+}
+
+func (w *grpcWorker) ReplayCDC(ctx context.Context, req *pb.CDCReplayRequest) (*pb.Status, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if err := replayCDC(ctx, req); err != nil {
+		return nil, err
+	}
+	return &pb.Status{}, nil
+}
+
+func replayCDC(ctx context.Context, req *pb.CDCReplayRequest) error {
+	if req.GroupId != groups().groupId() {
+		return errors.Errorf("CDC replay for group %d sent to group %d",
+			req.GroupId, groups().groupId())
+	}
+	n := groups().Node
+	if n == nil {
+		return conn.ErrNoNode
+	}
+	// All the transactions committed up to SinceTs must have been applied for the backfill to
+	// be consistent.
+	if err := posting.Oracle().WaitForTs(ctx, req.SinceTs); err != nil {
+		return err
+	}
+	return n.cdcTracker.replay(ctx, req.SinceTs)
+}
+
+func handleReplayCDCOverNetwork(ctx context.Context, req *pb.CDCReplayRequest) error {
+	if req.GroupId == groups().groupId() && groups().Node.AmLeader() {
+		return replayCDC(ctx, req)
+	}
+	pl := groups().Leader(req.GroupId)
+	if pl == nil {
+		return errors.Errorf("Unable to find leader of group: %d\n", req.GroupId)
+	}
+	c := pb.NewWorkerClient(pl.Get())
+	_, err := c.ReplayCDC(ctx, req)
+	return err
+}
+
+// ReplayCDCOverNetwork makes the leaders of all the groups send their CDC events again from
+// sinceTs, starting with a backfill of the data at sinceTs. If sinceTs is zero, the latest
+// timestamp is used, so that only the current state of the data is backfilled. It returns the
+// timestamp the events are sent from.
+func ReplayCDCOverNetwork(ctx context.Context, sinceTs uint64) (uint64, error) {
+	if err := x.HealthCheck(); err != nil {
+		return 0, err
+	}
+	if sinceTs == 0 {
+		ts, err := Timestamps(ctx, &pb.Num{ReadOnly: true})
+		if err != nil {
+			return 0, errors.Wrapf(err, "unable to retrieve readonly ts for CDC replay")
+		}
+		sinceTs = ts.ReadOnly
+	}
+
+	gids := groups().KnownGroups()
+	glog.Infof("Requesting CDC replay from %d for groups: %v\n", sinceTs, gids)
+	for _, gid := range gids {
+		req := &pb.CDCReplayRequest{GroupId: gid, SinceTs: sinceTs}
+		if err := handleReplayCDCOverNetwork(ctx, req); err != nil {
+			return 0, errors.Wrapf(err, "CDC replay failed for group %d", gid)
+		}
+	}
+	return sinceTs, nil
+}
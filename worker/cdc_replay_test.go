@@ -0,0 +1,111 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestPostingValue(t *testing.T) {
+	ref := &pb.Posting{Uid: 0x10, PostingType: pb.Posting_REF}
+	require.Equal(t, types.UidID, postingType(ref))
+	require.Equal(t, uint64(0x10), postingValue(ref))
+
+	name := &pb.Posting{Value: []byte("alice"), ValType: pb.Posting_STRING,
+		PostingType: pb.Posting_VALUE}
+	require.Equal(t, "string", postingType(name).Name())
+	require.Equal(t, "alice", postingValue(name))
+
+	password := &pb.Posting{Value: []byte("hash"), ValType: pb.Posting_PASSWORD,
+		PostingType: pb.Posting_VALUE}
+	require.Equal(t, "****", postingValue(password))
+}
+
+// setCDCTestValue commits a string value of attr for uid.
+func setCDCTestValue(t *testing.T, attr string, uid uint64, value string, startTs, commitTs uint64) {
+	txn := posting.Oracle().RegisterStartTs(startTs)
+	require.NoError(t, runMutation(context.Background(), &pb.DirectedEdge{
+		Value:     []byte(value),
+		ValueType: pb.Posting_STRING,
+		Attr:      attr,
+		Entity:    uid,
+		Op:        pb.DirectedEdge_SET,
+	}, txn))
+	txn.Update()
+	writer := posting.NewTxnWriter(pstore)
+	require.NoError(t, txn.CommitToDisk(writer, commitTs))
+	require.NoError(t, writer.Flush())
+	txn.UpdateCachedKeys(commitTs)
+}
+
+func TestCDCReplay(t *testing.T) {
+	ps, err := badger.OpenManaged(badger.DefaultOptions(t.TempDir()).WithLogger(nil))
+	require.NoError(t, err)
+	defer ps.Close()
+	pstore = ps
+	posting.Init(ps, 0, false)
+	Init(ps)
+	require.NoError(t, schema.ParseBytes([]byte("replayName: string ."), 1))
+
+	attr := x.AttrInRootNamespace("replayName")
+	prev := gr
+	gr = &groupi{gid: 1, tablets: map[string]*pb.Tablet{attr: {GroupId: 1, Predicate: attr}}}
+	defer func() { gr = prev }()
+
+	setCDCTestValue(t, attr, 1, "alice", 1, 2)
+	setCDCTestValue(t, attr, 2, "bob", 3, 4)
+	setCDCTestValue(t, attr, 1, "carol", 5, 6)
+
+	cdc := &CDC{
+		closer:           z.NewCloser(0),
+		pendingTxnEvents: map[uint64][]CDCEvent{7: {{Type: EventTypeMutation}}},
+		seenIndex:        50,
+		sentTs:           6,
+	}
+	// The replay proposed by the leader rewinds the CDC on all the members of the group.
+	cdc.updateCDCState(&pb.CDCState{SentTs: 4, BackfillTs: 4, Replay: true})
+	require.Zero(t, cdc.getSeenIndex())
+	require.Equal(t, uint64(4), atomic.LoadUint64(&cdc.sentTs))
+	require.Equal(t, uint64(4), atomic.LoadUint64(&cdc.backfillTs))
+	require.Empty(t, cdc.pendingTxnEvents)
+
+	// The states proposed during the backfill keep it going, for a new leader to resume it.
+	cdc.updateCDCState(&pb.CDCState{SentTs: 4, BackfillTs: 4})
+	require.Equal(t, uint64(4), atomic.LoadUint64(&cdc.backfillTs))
+
+	sent := make(map[uint64]interface{})
+	require.NoError(t, cdc.backfill(atomic.LoadUint64(&cdc.backfillTs),
+		func(events []CDCEvent, commitTs uint64) error {
+			require.Equal(t, uint64(4), commitTs)
+			for _, e := range events {
+				me := e.Event.(*MutationEvent)
+				require.Equal(t, "set", me.Operation)
+				require.Equal(t, "replayName", me.Attr)
+				sent[me.Uid] = me.Value
+			}
+			return nil
+		}))
+	require.Equal(t, map[uint64]interface{}{1: "alice", 2: "bob"}, sent)
+
+	// Once the end of the backfill is applied, the Raft log is processed from sentTs.
+	cdc.updateCDCState(&pb.CDCState{SentTs: 4})
+	require.Zero(t, atomic.LoadUint64(&cdc.backfillTs))
+	require.Equal(t, uint64(4), atomic.LoadUint64(&cdc.sentTs))
+	cdc.updateCDCState(&pb.CDCState{SentTs: 6})
+	require.Equal(t, uint64(6), atomic.LoadUint64(&cdc.sentTs))
+}
@@ -0,0 +1,471 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// The envelopes in which the CDC events can be sent.
+const (
+	// EnvelopeDgraph sends the events as they are.
+	EnvelopeDgraph = "dgraph"
+	// EnvelopeCloudEvents wraps the events in CloudEvents in the JSON structured content mode.
+	EnvelopeCloudEvents = "cloudevents"
+	// EnvelopeDebezium sends the value of the predicate before and after the transaction, like
+	// the change events of Debezium.
+	EnvelopeDebezium = "debezium"
+)
+
+// cdcRules holds the filters and the envelope of the CDC events, set in the --cdc flag.
+type cdcRules struct {
+	namespaces map[uint64]struct{}
+	predicates []string
+	types      map[string]struct{}
+	envelope   string
+}
+
+func splitList(s string) []string {
+	var res []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+func parseCDCRules(conf *z.SuperFlag) (*cdcRules, error) {
+	r := &cdcRules{envelope: strings.ToLower(conf.GetString("envelope"))}
+	switch r.envelope {
+	case EnvelopeDgraph, EnvelopeCloudEvents, EnvelopeDebezium:
+	default:
+		return nil, errors.Errorf("Invalid CDC envelope %q. Valid envelopes are: %s, %s and %s",
+			r.envelope, EnvelopeDgraph, EnvelopeCloudEvents, EnvelopeDebezium)
+	}
+	for _, ns := range splitList(conf.GetString("namespaces")) {
+		n, err := strconv.ParseUint(ns, 0, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid namespace %q in the CDC namespaces", ns)
+		}
+		if r.namespaces == nil {
+			r.namespaces = make(map[uint64]struct{})
+		}
+		r.namespaces[n] = struct{}{}
+	}
+	r.predicates = splitList(conf.GetString("predicate-prefixes"))
+	for _, t := range splitList(conf.GetString("types")) {
+		if r.types == nil {
+			r.types = make(map[string]struct{})
+		}
+		r.types[t] = struct{}{}
+	}
+	return r, nil
+}
+
+func sortedUnique(uids []uint64) []uint64 {
+	slices.Sort(uids)
+	return slices.Compact(uids)
+}
+
+func (r *cdcRules) matchesPredicate(attr string) bool {
+	if len(r.predicates) == 0 {
+		return true
+	}
+	for _, p := range r.predicates {
+		if strings.HasPrefix(attr, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// cdcTxn holds the events of a transaction, or of a drop operation, sent at commitTs.
+type cdcTxn struct {
+	events   []CDCEvent
+	commitTs uint64
+}
+
+// filter returns the transactions with the events that match the rules. The namespace filter
+// applies to all the events but DROP ALL, which affects every namespace. The predicate and type
+// filters apply to the mutations and to the drops of a predicate or a type respectively. A
+// mutation matches the type filter if its node has one of the types before or after the
+// transaction. The types are read for all the transactions at once.
+func (r *cdcRules) filter(ctx context.Context, txns []cdcTxn) ([]cdcTxn, error) {
+	if r.namespaces == nil && r.predicates == nil && r.types == nil {
+		return txns, nil
+	}
+	res := make([]cdcTxn, 0, len(txns))
+	reads := make(cdcReads)
+	for _, txn := range txns {
+		var events []CDCEvent
+		for _, e := range txn.events {
+			ns := binary.BigEndian.Uint64(e.Meta.Namespace)
+			if _, ok := r.namespaces[ns]; !ok && r.namespaces != nil {
+				if de, ok := e.Event.(*DropEvent); !ok || de.Operation != "all" {
+					continue
+				}
+			}
+			switch ev := e.Event.(type) {
+			case *MutationEvent:
+				if !r.matchesPredicate(ev.Attr) {
+					continue
+				}
+				if r.types != nil {
+					reads.add(x.NamespaceAttr(ns, "dgraph.type"), ev.Uid, txn.commitTs)
+				}
+			case *DropEvent:
+				if ev.Operation == OpDropPred && !r.matchesPredicate(ev.Pred) {
+					continue
+				}
+				if _, ok := r.types[ev.Type]; !ok && r.types != nil && ev.Type != "" {
+					continue
+				}
+			}
+			events = append(events, e)
+		}
+		res = append(res, cdcTxn{events: events, commitTs: txn.commitTs})
+	}
+	if r.types == nil {
+		return res, nil
+	}
+
+	// Keep the mutations of the nodes that have one of the types.
+	if err := reads.fetch(ctx); err != nil {
+		return nil, errors.Wrapf(err, "while fetching the types of nodes for CDC")
+	}
+	hasType := func(ns, uid, commitTs uint64) bool {
+		before, after := reads.get(x.NamespaceAttr(ns, "dgraph.type"), uid, commitTs)
+		for _, v := range append(before, after...) {
+			if _, ok := r.types[fmt.Sprint(v)]; ok {
+				return true
+			}
+		}
+		return false
+	}
+	for i, txn := range res {
+		filtered := txn.events[:0]
+		for _, e := range txn.events {
+			if me, ok := e.Event.(*MutationEvent); ok {
+				if !hasType(binary.BigEndian.Uint64(e.Meta.Namespace), me.Uid, txn.commitTs) {
+					continue
+				}
+			}
+			filtered = append(filtered, e)
+		}
+		res[i].events = filtered
+	}
+	return res, nil
+}
+
+// cdcReads holds the values of predicates read for the rules, before and after transactions,
+// by predicate, uid and commit timestamp. The values of uid predicates are the uids they point
+// to.
+type cdcReads map[string]map[uint64]map[uint64][2][]interface{}
+
+// add asks for the values of nsAttr of uid before and after the transaction committed at
+// commitTs.
+func (r cdcReads) add(nsAttr string, uid, commitTs uint64) {
+	if r[nsAttr] == nil {
+		r[nsAttr] = make(map[uint64]map[uint64][2][]interface{})
+	}
+	if r[nsAttr][uid] == nil {
+		r[nsAttr][uid] = make(map[uint64][2][]interface{})
+	}
+	r[nsAttr][uid][commitTs] = [2][]interface{}{}
+}
+
+// get returns the values of nsAttr of uid before and after the transaction committed at
+// commitTs, once they have been fetched.
+func (r cdcReads) get(nsAttr string, uid, commitTs uint64) ([]interface{}, []interface{}) {
+	vals := r[nsAttr][uid][commitTs]
+	return vals[0], vals[1]
+}
+
+// fetch reads all the values that have been asked for. The predicates served by the group,
+// which include the predicates of all its mutations, are read from its posting lists. The others
+// are read from their group with one query per timestamp, before or after a transaction, for
+// all the uids asked for at that timestamp.
+func (r cdcReads) fetch(ctx context.Context) error {
+	for nsAttr, byUid := range r {
+		serves, err := groups().ServesTablet(nsAttr)
+		if err != nil {
+			return err
+		}
+		if serves {
+			for uid, byTs := range byUid {
+				key := x.DataKey(nsAttr, uid)
+				for commitTs := range byTs {
+					var vals [2][]interface{}
+					for side, ts := range []uint64{commitTs - 1, commitTs} {
+						if vals[side], err = readCDCValues(key, ts); err != nil {
+							return err
+						}
+					}
+					byTs[commitTs] = vals
+				}
+			}
+			continue
+		}
+
+		uidsAt := make(map[uint64][]uint64)
+		for uid, byTs := range byUid {
+			for commitTs := range byTs {
+				uidsAt[commitTs-1] = append(uidsAt[commitTs-1], uid)
+				uidsAt[commitTs] = append(uidsAt[commitTs], uid)
+			}
+		}
+		ns, attr := x.ParseNamespaceAttr(nsAttr)
+		valsAt := make(map[uint64]map[uint64][]interface{}, len(uidsAt))
+		for ts, uids := range uidsAt {
+			uids = sortedUnique(uids)
+			vals, err := fetchCDCValues(ctx, ns, attr, uids, ts)
+			if err != nil {
+				return err
+			}
+			valsAt[ts] = make(map[uint64][]interface{}, len(uids))
+			for i, uid := range uids {
+				valsAt[ts][uid] = vals[i]
+			}
+		}
+		for uid, byTs := range byUid {
+			for commitTs := range byTs {
+				byTs[commitTs] = [2][]interface{}{valsAt[commitTs-1][uid], valsAt[commitTs][uid]}
+			}
+		}
+	}
+	return nil
+}
+
+// fetchCDCValues returns the values of attr at readTs for every one of uids, which must be sorted
+// and unique. The values of uid predicates are the uids they point to.
+func fetchCDCValues(ctx context.Context, ns uint64, attr string, uids []uint64, readTs uint64) (
+	[][]interface{}, error) {
+
+	res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    x.NamespaceAttr(ns, attr),
+		UidList: &pb.List{Uids: uids},
+		ReadTs:  readTs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	vals := make([][]interface{}, len(uids))
+	if typ, err := schema.State().TypeOf(x.NamespaceAttr(ns, attr)); err == nil && typ == types.UidID {
+		for i := range uids {
+			if i >= len(res.GetUidMatrix()) {
+				break
+			}
+			for _, uid := range res.UidMatrix[i].GetUids() {
+				vals[i] = append(vals[i], uid)
+			}
+		}
+		return vals, nil
+	}
+	for i := range uids {
+		if i >= len(res.GetValueMatrix()) {
+			break
+		}
+		for _, tv := range res.ValueMatrix[i].Values {
+			if len(tv.Val) == 0 {
+				continue
+			}
+			tid := types.TypeID(tv.ValType)
+			if tid == types.PasswordID {
+				vals[i] = append(vals[i], "****")
+				continue
+			}
+			v, err := types.Convert(types.Val{Tid: types.BinaryID, Value: tv.Val}, tid)
+			if err != nil {
+				return nil, err
+			}
+			vals[i] = append(vals[i], v.Value)
+		}
+	}
+	return vals, nil
+}
+
+// readCDCValues returns the untagged values of the posting list of key at readTs, like they are
+// sent in the events of the mutations.
+func readCDCValues(key []byte, readTs uint64) ([]interface{}, error) {
+	pl, err := posting.GetNoStore(key, readTs)
+	if err != nil {
+		return nil, err
+	}
+	var vals []interface{}
+	err = pl.Iterate(readTs, 0, func(p *pb.Posting) error {
+		if len(p.LangTag) == 0 {
+			vals = append(vals, postingValue(p))
+		}
+		return nil
+	})
+	return vals, err
+}
+
+type cloudEvent struct {
+	SpecVersion     string      `json:"specversion"`
+	Id              string      `json:"id"`
+	Source          string      `json:"source"`
+	Type            string      `json:"type"`
+	Time            string      `json:"time"`
+	DataContentType string      `json:"datacontenttype"`
+	CommitTs        uint64      `json:"committs"`
+	Namespace       uint64      `json:"namespace"`
+	Data            interface{} `json:"data"`
+}
+
+type debeziumSource struct {
+	Version   string `json:"version"`
+	Connector string `json:"connector"`
+	Group     uint32 `json:"group"`
+	Namespace uint64 `json:"namespace"`
+	CommitTs  uint64 `json:"commit_ts"`
+}
+
+type debeziumEvent struct {
+	Before map[string]interface{} `json:"before"`
+	After  map[string]interface{} `json:"after"`
+	Source debeziumSource         `json:"source"`
+	Op     string                 `json:"op"`
+	TsMs   int64                  `json:"ts_ms"`
+	Drop   *DropEvent             `json:"drop,omitempty"`
+}
+
+// encode returns the messages to send to the sink for the events of the transactions, in the
+// envelope of the rules.
+func (r *cdcRules) encode(ctx context.Context, txns []cdcTxn) ([]SinkMessage, error) {
+	now := time.Now()
+	gid := groups().groupId()
+	var states cdcReads
+	if r.envelope == EnvelopeDebezium {
+		var err error
+		if states, err = fetchStates(ctx, txns); err != nil {
+			return nil, errors.Wrapf(err, "while fetching the values of predicates for CDC")
+		}
+	}
+
+	var res []SinkMessage
+	for _, txn := range txns {
+		msgs := r.encodeTxn(txn.events, txn.commitTs, states, now, gid)
+		res = append(res, msgs...)
+	}
+	return res, nil
+}
+
+func (r *cdcRules) encodeTxn(events []CDCEvent, commitTs uint64, states cdcReads,
+	now time.Time, gid uint32) []SinkMessage {
+
+	res := make([]SinkMessage, 0, len(events))
+	for i, e := range events {
+		e.Meta.CommitTs = commitTs
+		ns := binary.BigEndian.Uint64(e.Meta.Namespace)
+		var v interface{}
+		switch r.envelope {
+		case EnvelopeCloudEvents:
+			v = &cloudEvent{
+				SpecVersion:     "1.0",
+				Id:              fmt.Sprintf("%d-%d", commitTs, i),
+				Source:          fmt.Sprintf("/dgraph/group/%d", gid),
+				Type:            "io.dgraph.cdc." + e.Type,
+				Time:            now.UTC().Format(time.RFC3339Nano),
+				DataContentType: "application/json",
+				CommitTs:        commitTs,
+				Namespace:       ns,
+				Data:            e.Event,
+			}
+		case EnvelopeDebezium:
+			de := &debeziumEvent{
+				Source: debeziumSource{
+					Version:   x.Version(),
+					Connector: "dgraph",
+					Group:     gid,
+					Namespace: ns,
+					CommitTs:  commitTs,
+				},
+				TsMs: now.UnixMilli(),
+			}
+			switch ev := e.Event.(type) {
+			case *MutationEvent:
+				nsAttr := x.NamespaceAttr(ns, ev.Attr)
+				before, after := states.get(nsAttr, ev.Uid, commitTs)
+				isList := schema.State().IsList(nsAttr)
+				de.Before = cdcState(ev.Attr, ev.Uid, before, isList)
+				de.After = cdcState(ev.Attr, ev.Uid, after, isList)
+				switch {
+				case de.After == nil:
+					de.Op = "d"
+				case de.Before == nil:
+					de.Op = "c"
+				default:
+					de.Op = "u"
+				}
+			case *DropEvent:
+				de.Op = "t"
+				de.Drop = ev
+			}
+			v = de
+		default:
+			v = e
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			glog.Errorf("error while marshalling batch for event [%+v]: %v\n", e.Event, err)
+			continue
+		}
+		res = append(res, SinkMessage{
+			Meta: SinkMeta{
+				Topic: defaultEventTopic,
+			},
+			Key:   e.Meta.Namespace,
+			Value: b,
+		})
+	}
+	return res
+}
+
+// fetchStates reads the values of the predicates of the mutation events before and after their
+// transactions, for all the transactions at once.
+func fetchStates(ctx context.Context, txns []cdcTxn) (cdcReads, error) {
+	states := make(cdcReads)
+	for _, txn := range txns {
+		for _, e := range txn.events {
+			if me, ok := e.Event.(*MutationEvent); ok {
+				nsAttr := x.NamespaceAttr(binary.BigEndian.Uint64(e.Meta.Namespace), me.Attr)
+				states.add(nsAttr, me.Uid, txn.commitTs)
+			}
+		}
+	}
+	return states, states.fetch(ctx)
+}
+
+// cdcState returns the state of a predicate of a node in the Debezium envelope, or nil if the
+// predicate has no value.
+func cdcState(attr string, uid uint64, vals []interface{}, isList bool) map[string]interface{} {
+	if len(vals) == 0 {
+		return nil
+	}
+	if isList {
+		return map[string]interface{}{"uid": uid, attr: vals}
+	}
+	return map[string]interface{}{"uid": uid, attr: vals[0]}
+}
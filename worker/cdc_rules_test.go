@@ -0,0 +1,161 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func cdcMutation(ns uint64, uid uint64, attr string) CDCEvent {
+	return CDCEvent{
+		Meta: &EventMeta{Namespace: x.NamespaceToBytes(ns)},
+		Type: EventTypeMutation,
+		Event: &MutationEvent{
+			Operation: "set",
+			Uid:       uid,
+			Attr:      attr,
+			Value:     "v",
+			ValueType: "string",
+		},
+	}
+}
+
+func cdcDrop(ns uint64, op, pred string) CDCEvent {
+	return CDCEvent{
+		Meta:  &EventMeta{Namespace: x.NamespaceToBytes(ns)},
+		Type:  EventTypeDrop,
+		Event: &DropEvent{Operation: op, Pred: pred},
+	}
+}
+
+func TestCDCRulesFilter(t *testing.T) {
+	_, err := parseCDCRules(sinkConf("file=/tmp; envelope=xml"))
+	require.Error(t, err)
+	_, err = parseCDCRules(sinkConf("file=/tmp; namespaces=one"))
+	require.Error(t, err)
+
+	rules, err := parseCDCRules(sinkConf("file=/tmp; namespaces=0,0x2; " +
+		"predicate-prefixes=user.,name"))
+	require.NoError(t, err)
+	events := []CDCEvent{
+		cdcMutation(0, 1, "name"),
+		cdcMutation(0, 1, "age"),
+		cdcMutation(0, 1, "user.email"),
+		cdcMutation(1, 1, "name"),
+		cdcMutation(2, 1, "nickname"),
+		cdcDrop(0, OpDropPred, "age"),
+		cdcDrop(0, OpDropPred, "user.email"),
+		cdcDrop(1, "all", ""),
+		cdcDrop(1, "data", ""),
+	}
+	filtered, err := rules.filter(context.Background(), []cdcTxn{{events: events, commitTs: 10}})
+	require.NoError(t, err)
+	require.Equal(t, []cdcTxn{{events: []CDCEvent{events[0], events[2], events[6], events[7]},
+		commitTs: 10}}, filtered)
+
+	// Without any filter, all the events are sent.
+	rules, err = parseCDCRules(sinkConf("file=/tmp"))
+	require.NoError(t, err)
+	txns := []cdcTxn{{events: events, commitTs: 10}}
+	filtered, err = rules.filter(context.Background(), txns)
+	require.NoError(t, err)
+	require.Equal(t, txns, filtered)
+}
+
+func TestCDCRulesEncode(t *testing.T) {
+	events := []CDCEvent{cdcMutation(2, 1, "name"), cdcDrop(2, OpDropPred, "age")}
+
+	rules, err := parseCDCRules(sinkConf("file=/tmp"))
+	require.NoError(t, err)
+	msgs, err := rules.encode(context.Background(), []cdcTxn{{events: events, commitTs: 10}})
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	require.Equal(t, defaultEventTopic, msgs[0].Meta.Topic)
+	require.Equal(t, x.NamespaceToBytes(2), msgs[0].Key)
+	require.JSONEq(t, `{"meta":{"commit_ts":10},"type":"mutation","event":{"operation":"set",`+
+		`"uid":1,"attr":"name","value":"v","value_type":"string"}}`, string(msgs[0].Value))
+
+	rules, err = parseCDCRules(sinkConf("file=/tmp; envelope=CloudEvents"))
+	require.NoError(t, err)
+	msgs, err = rules.encode(context.Background(), []cdcTxn{{events: events, commitTs: 10}})
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	var ce map[string]interface{}
+	require.NoError(t, json.Unmarshal(msgs[1].Value, &ce))
+	require.Equal(t, "1.0", ce["specversion"])
+	require.Equal(t, "10-1", ce["id"])
+	require.Equal(t, "io.dgraph.cdc.drop", ce["type"])
+	require.Equal(t, 2.0, ce["namespace"])
+	require.Equal(t, map[string]interface{}{"operation": "predicate", "type": "", "pred": "age"},
+		ce["data"])
+}
+
+func TestCDCRulesReadValues(t *testing.T) {
+	ps, err := badger.OpenManaged(badger.DefaultOptions(t.TempDir()).WithLogger(nil))
+	require.NoError(t, err)
+	defer ps.Close()
+	pstore = ps
+	posting.Init(ps, 0, false)
+	Init(ps)
+	require.NoError(t, schema.ParseBytes([]byte("dgraph.type: [string] @index(exact) .\n"+
+		"cdcName: string ."), 1))
+
+	typeAttr, nameAttr := x.AttrInRootNamespace("dgraph.type"), x.AttrInRootNamespace("cdcName")
+	prev := gr
+	gr = &groupi{gid: 1, tablets: map[string]*pb.Tablet{
+		typeAttr: {GroupId: 1, Predicate: typeAttr},
+		nameAttr: {GroupId: 1, Predicate: nameAttr},
+	}}
+	defer func() { gr = prev }()
+
+	setCDCTestValue(t, typeAttr, 1, "Person", 1, 2)
+	setCDCTestValue(t, nameAttr, 1, "alice", 1, 2)
+	setCDCTestValue(t, nameAttr, 1, "bob", 3, 4)
+	setCDCTestValue(t, nameAttr, 2, "carol", 3, 4)
+	txns := []cdcTxn{
+		{events: []CDCEvent{cdcMutation(0, 1, "cdcName")}, commitTs: 2},
+		{events: []CDCEvent{cdcMutation(0, 1, "cdcName"), cdcMutation(0, 2, "cdcName")},
+			commitTs: 4},
+	}
+
+	// The types are read for all the transactions at once, at the timestamps of each one.
+	rules, err := parseCDCRules(sinkConf("file=/tmp; types=Person"))
+	require.NoError(t, err)
+	filtered, err := rules.filter(context.Background(), txns)
+	require.NoError(t, err)
+	require.Equal(t, []cdcTxn{txns[0], {events: txns[1].events[:1], commitTs: 4}}, filtered)
+
+	rules, err = parseCDCRules(sinkConf("file=/tmp; envelope=debezium"))
+	require.NoError(t, err)
+	msgs, err := rules.encode(context.Background(), txns)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	for i, want := range []struct {
+		op            string
+		before, after interface{}
+	}{
+		{"c", nil, map[string]interface{}{"uid": 1.0, "cdcName": "alice"}},
+		{"u", map[string]interface{}{"uid": 1.0, "cdcName": "alice"},
+			map[string]interface{}{"uid": 1.0, "cdcName": "bob"}},
+		{"c", nil, map[string]interface{}{"uid": 2.0, "cdcName": "carol"}},
+	} {
+		var de map[string]interface{}
+		require.NoError(t, json.Unmarshal(msgs[i].Value, &de))
+		require.Equal(t, want.op, de["op"], "message %d", i)
+		require.Equal(t, want.before, de["before"], "message %d", i)
+		require.Equal(t, want.after, de["after"], "message %d", i)
+	}
+}
@@ -68,6 +68,10 @@ type Options struct {
 	// index might be slower. This would allow people to set their limit according to
 	// their use case.
 	TypeFilterUidLimit uint64
+
+	// HistoryRetention is how long the older versions of the data are kept around, so that they
+	// can be read by @asof queries and the history function.
+	HistoryRetention time.Duration
 }
 
 // Config holds an instance of the server options..
@@ -62,6 +62,7 @@ type node struct {
 
 	checkpointTs uint64 // Timestamp corresponding to checkpoint.
 	streaming    int32  // Used to avoid calculating snapshot
+	caughtUpAt   int64  // Unix nanoseconds when this node last applied all of the Raft log.
 
 	// Used to track the ops going on in the system.
 	ops         map[op]operation
@@ -278,6 +279,7 @@ func newNode(store *raftwal.DiskStorage, gid uint32, id uint64, myAddr string) *
 		ops:        make(map[op]operation),
 		cdcTracker: newCDC(),
 	}
+	m.ReadState = n.readState
 	return n
 }
 
@@ -442,7 +444,8 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 			}
 		}
 
-		if err := runSchemaMutation(ctx, proposal.Mutations.Schema, startTs); err != nil {
+		if err := runSchemaMutation(ctx, proposal.Mutations.Schema, startTs,
+			proposal.Mutations.RebuildVectorIndex); err != nil {
 			return err
 		}
 
@@ -537,6 +540,14 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 	}
 	// Discard the posting lists from cache to release memory at the end.
 	defer txn.Update()
+	for i := 0; i < len(m.Edges); {
+		j := i + 1
+		for j < len(m.Edges) && m.Edges[j].Attr == m.Edges[i].Attr {
+			j++
+		}
+		tabletLoads.addMutations(m.Edges[i].Attr, j-i)
+		i = j
+	}
 
 	process := func(edges []*pb.DirectedEdge) error {
 		var retries int
@@ -614,6 +625,21 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 	return errs
 }
 
+// waitForChecksum waits up to 10 seconds for the membership checksum of the group to match the
+// expected one, if any. It returns false if it didn't.
+func waitForChecksum(expected uint64) bool {
+	end := time.Now().Add(10 * time.Second)
+	for expected > 0 && time.Now().Before(end) {
+		cur := atomic.LoadUint64(&groups().membershipChecksum)
+		if expected == cur {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+		glog.Infof("Waiting for checksums to match. Expected: %d. Current: %d\n", expected, cur)
+	}
+	return !time.Now().After(end)
+}
+
 func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 	start := time.Now()
 	defer func() {
@@ -658,17 +684,7 @@ func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 	case len(proposal.CleanPredicate) > 0:
 		span.AddEvent("Cleaning predicate: %s", trace.WithAttributes(
 			attribute.String("predicate", proposal.CleanPredicate)))
-		end := time.Now().Add(10 * time.Second)
-		for proposal.ExpectedChecksum > 0 && time.Now().Before(end) {
-			cur := atomic.LoadUint64(&groups().membershipChecksum)
-			if proposal.ExpectedChecksum == cur {
-				break
-			}
-			time.Sleep(100 * time.Millisecond)
-			glog.Infof("Waiting for checksums to match. Expected: %d. Current: %d\n",
-				proposal.ExpectedChecksum, cur)
-		}
-		if time.Now().After(end) {
+		if !waitForChecksum(proposal.ExpectedChecksum) {
 			glog.Warningf(
 				"Giving up on predicate deletion: %q due to timeout. Wanted checksum: %d.",
 				proposal.CleanPredicate, proposal.ExpectedChecksum)
@@ -684,6 +700,17 @@ func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 		}
 		return err
 
+	case len(proposal.CleanRange) > 0:
+		span.AddEvent("Cleaning the ranges of predicate: %s", trace.WithAttributes(
+			attribute.String("predicate", proposal.CleanRange)))
+		if !waitForChecksum(proposal.ExpectedChecksum) {
+			glog.Warningf(
+				"Giving up on cleaning the ranges of predicate: %q due to timeout. "+
+					"Wanted checksum: %d.", proposal.CleanRange, proposal.ExpectedChecksum)
+			return nil
+		}
+		return cleanRange(ctx, proposal.CleanRange, proposal.StartTs)
+
 	case proposal.Delta != nil:
 		span.AddEvent("Applying Oracle Delta for key: %d", trace.WithAttributes(
 			attribute.Int64("key", int64(key))))
@@ -782,8 +809,9 @@ func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 			}
 			glog.Warningf("Error while calling CreateSnapshot: %v. Retrying...", err)
 		}
-		// We can now discard all invalid versions of keys below this ts.
-		pstore.SetDiscardTs(snap.ReadTs)
+		// We can now discard all invalid versions of keys below this ts, unless they are still
+		// within the history retention window.
+		pstore.SetDiscardTs(history.set(snap))
 		return nil
 	case proposal.Restore != nil:
 		// Enable draining mode for the duration of the restore processing.
@@ -1107,13 +1135,14 @@ func (n *node) retrieveSnapshot(snap *pb.Snapshot) error {
 	return nil
 }
 
-func (n *node) proposeCDCState(ts uint64) error {
+func (n *node) proposeCDCState(ts, backfillTs uint64) error {
 	proposal := &pb.Proposal{
 		CdcState: &pb.CDCState{
-			SentTs: ts,
+			SentTs:     ts,
+			BackfillTs: backfillTs,
 		},
 	}
-	glog.V(2).Infof("Proposing new CDC state ts: %d\n", ts)
+	glog.V(2).Infof("Proposing new CDC state ts: %d backfill ts: %d\n", ts, backfillTs)
 	sz := proto.Size(proposal)
 	data := make([]byte, 8+sz)
 	x.Check2(x.MarshalToSizedBuffer(data[8:], proposal))
@@ -1142,6 +1171,8 @@ func (n *node) proposeSnapshot() error {
 	if snap == nil {
 		return nil
 	}
+	snap.History, snap.HistoryStartTs = history.next(snap.ReadTs, time.Now(),
+		Config.HistoryRetention)
 	proposal := &pb.Proposal{
 		Snapshot: snap,
 	}
@@ -1463,6 +1494,7 @@ func (n *node) Run() {
 						time.Sleep(time.Second) // Wait for a bit.
 					}
 					glog.Infof("---> SNAPSHOT: %+v. Group %d. DONE.\n", snap, n.gid)
+					pstore.SetDiscardTs(history.set(snap))
 
 					// Set node to healthy state here.
 					x.UpdateHealthStatus(true)
@@ -1977,6 +2009,11 @@ func (n *node) InitAndStartNode() {
 			// zero-member Raft group.
 			n.SetConfState(&sp.Metadata.ConfState)
 
+			// The history retention window is kept in the snapshot.
+			snap := &pb.Snapshot{}
+			x.Check(proto.Unmarshal(sp.Data, snap))
+			pstore.SetDiscardTs(history.set(snap))
+
 			// TODO: Making connections here seems unnecessary, evaluate.
 			members := groups().members(n.gid)
 			for _, id := range sp.Metadata.ConfState.Voters {
@@ -0,0 +1,272 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// EmbeddingProvider computes the embeddings of texts. It is used to fill the vector predicates
+// that have an @embedding directive, and to search them by text with similar_to.
+type EmbeddingProvider interface {
+	// Embed returns the embeddings of texts, in the same order.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+var (
+	embeddingProviders = map[string]func(config *z.SuperFlag) (EmbeddingProvider, error){
+		"openai": newOpenAIEmbedder,
+	}
+	embedder EmbeddingProvider
+)
+
+// RegisterEmbeddingProvider makes the provider created by newProvider available under name to
+// the provider option of the --embedding flag.
+func RegisterEmbeddingProvider(name string,
+	newProvider func(config *z.SuperFlag) (EmbeddingProvider, error)) {
+	embeddingProviders[name] = newProvider
+}
+
+// InitEmbeddingProvider sets up the embedding provider from the --embedding flag. No provider is
+// set up if no url is given.
+func InitEmbeddingProvider(config *z.SuperFlag) error {
+	if config.GetString("url") == "" {
+		return nil
+	}
+	name := config.GetString("provider")
+	newProvider, ok := embeddingProviders[name]
+	if !ok {
+		return errors.Errorf("unknown embedding provider %q", name)
+	}
+	provider, err := newProvider(config)
+	if err != nil {
+		return err
+	}
+	embedder = provider
+	return nil
+}
+
+// embed returns the embeddings of texts computed by the configured provider.
+func embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if embedder == nil {
+		return nil, errors.New("no embedding provider is configured, see the --embedding flag")
+	}
+	vecs, err := embedder.Embed(ctx, texts)
+	if err != nil {
+		return nil, errors.Wrap(err, "while computing embeddings")
+	}
+	if len(vecs) != len(texts) {
+		return nil, errors.Errorf("embedding provider returned %d embeddings for %d texts",
+			len(vecs), len(texts))
+	}
+	return vecs, nil
+}
+
+// embedQuery returns the embedding of text as a vector of the type of attr, to search it with
+// similar_to.
+func embedQuery(ctx context.Context, attr, text string) ([][]float32, error) {
+	tid, err := schema.State().TypeOf(attr)
+	if err != nil {
+		return nil, err
+	}
+	vecs, err := embed(ctx, []string{text})
+	if err != nil {
+		return nil, err
+	}
+	vec, err := embeddingAsVector(tid, vecs[0])
+	if err != nil {
+		return nil, err
+	}
+	return [][]float32{vec}, nil
+}
+
+// AddEmbeddingEdges returns edges along with the edges setting the vector predicates computed
+// from the predicates they set, as declared by the @embedding directive in the schema. Deleting
+// the value of such a predicate deletes the vectors computed from it.
+func AddEmbeddingEdges(ctx context.Context, edges []*pb.DirectedEdge) ([]*pb.DirectedEdge, error) {
+	if !schema.State().HasEmbeddings() {
+		return edges, nil
+	}
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isGalaxyQuery := x.IsRootNsOperation(ctx)
+
+	embeddedBy := make(map[string][]string)
+	var texts []string
+	var vecEdges []*pb.DirectedEdge
+	var vecTypes []types.TypeID
+	for _, edge := range edges {
+		if edge.Attr == x.Star || edge.ValueId != 0 || edge.Lang != "" {
+			continue
+		}
+		ns := namespace
+		if isGalaxyQuery {
+			ns = edge.GetNamespace()
+		}
+		attr := x.NamespaceAttr(ns, edge.Attr)
+		vecPreds, ok := embeddedBy[attr]
+		if !ok {
+			vecPreds = schema.State().EmbeddedBy(attr)
+			embeddedBy[attr] = vecPreds
+		}
+		for _, pred := range vecPreds {
+			vecEdge := &pb.DirectedEdge{
+				Entity:    edge.Entity,
+				Attr:      x.ParseAttr(pred),
+				Op:        edge.Op,
+				Namespace: edge.Namespace,
+			}
+			if edge.Op == pb.DirectedEdge_DEL {
+				vecEdge.Value = []byte(x.Star)
+				vecEdge.ValueType = pb.Posting_DEFAULT
+				edges = append(edges, vecEdge)
+				continue
+			}
+			if edge.ValueType != pb.Posting_STRING && edge.ValueType != pb.Posting_DEFAULT {
+				return nil, errors.Errorf("Predicate %s can only be embedded from text values",
+					x.ParseAttr(pred))
+			}
+			tid, err := schema.State().TypeOf(pred)
+			if err != nil {
+				return nil, err
+			}
+			texts = append(texts, string(edge.Value))
+			vecEdges = append(vecEdges, vecEdge)
+			vecTypes = append(vecTypes, tid)
+		}
+	}
+	if len(vecEdges) == 0 {
+		return edges, nil
+	}
+
+	vecs, err := embed(ctx, texts)
+	if err != nil {
+		return nil, err
+	}
+	for i, vecEdge := range vecEdges {
+		vec, err := embeddingAsVector(vecTypes[i], vecs[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "while embedding predicate %s", vecEdge.Attr)
+		}
+		vecEdge.Value = types.VectorAsBytes(vecTypes[i], vec)
+		vecEdge.ValueType = pb.Posting_ValType(vecTypes[i])
+		edges = append(edges, vecEdge)
+	}
+	return edges, nil
+}
+
+// embeddingAsVector converts an embedding into the values of a vector of type tid. The
+// embeddings being normalized, their values are scaled from [-1, 1] to [-127, 127] for an
+// int8vector, and the positive ones are set to 1 for a binaryvector.
+func embeddingAsVector(tid types.TypeID, vec []float32) ([]float32, error) {
+	res := vec
+	switch tid {
+	case types.VFloatID, types.VFloat16ID:
+	case types.VInt8ID:
+		res = make([]float32, len(vec))
+		for i, v := range vec {
+			res[i] = float32(math.Max(math.MinInt8, math.Min(math.MaxInt8, math.Round(float64(v)*127))))
+		}
+	case types.VBinaryID:
+		res = make([]float32, len(vec))
+		for i, v := range vec {
+			if v > 0 {
+				res[i] = 1
+			}
+		}
+	default:
+		return nil, errors.Errorf("%s is not a vector type", tid.Name())
+	}
+	return res, types.CheckVector(tid, res)
+}
+
+// openAIEmbedder computes embeddings with an OpenAI compatible embeddings endpoint, which most
+// local model servers provide.
+type openAIEmbedder struct {
+	url    string
+	model  string
+	apiKey string
+	client *http.Client
+}
+
+func newOpenAIEmbedder(config *z.SuperFlag) (EmbeddingProvider, error) {
+	return &openAIEmbedder{
+		url:    config.GetString("url"),
+		model:  config.GetString("model"),
+		apiKey: config.GetString("api-key"),
+		client: &http.Client{Timeout: config.GetDuration("timeout")},
+	}, nil
+}
+
+type openAIEmbeddingRequest struct {
+	Model string   `json:"model,omitempty"`
+	Input []string `json:"input"`
+}
+
+type openAIEmbeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+func (o *openAIEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	body, err := json.Marshal(openAIEmbeddingRequest{Model: o.model, Input: texts})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create embedding request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if o.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+o.apiKey)
+	}
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to send embedding request")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, errors.Errorf("embedding endpoint responded with status %s: %s",
+			resp.Status, msg)
+	}
+
+	var res openAIEmbeddingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, errors.Wrap(err, "unable to decode embedding response")
+	}
+	vecs := make([][]float32, len(texts))
+	for _, d := range res.Data {
+		if d.Index < 0 || d.Index >= len(vecs) {
+			return nil, errors.Errorf("embedding response has invalid index %d", d.Index)
+		}
+		vecs[d.Index] = d.Embedding
+	}
+	for i, vec := range vecs {
+		if vec == nil {
+			return nil, errors.Errorf("embedding response is missing the embedding of text %d", i)
+		}
+	}
+	return vecs, nil
+}
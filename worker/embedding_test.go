@@ -0,0 +1,173 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// newEmbeddingServer returns a server implementing the OpenAI embeddings API, which embeds a
+// text into a vector holding its length. The embeddings are returned in reverse order to check
+// that they are put back in order.
+func newEmbeddingServer(t *testing.T, requests *[]openAIEmbeddingRequest) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer s3cr3t", r.Header.Get("Authorization"))
+		var req openAIEmbeddingRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		*requests = append(*requests, req)
+
+		var res openAIEmbeddingResponse
+		res.Data = make([]struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		}, len(req.Input))
+		for i, text := range req.Input {
+			d := &res.Data[len(req.Input)-1-i]
+			d.Index = i
+			d.Embedding = []float32{float32(len(text)), 1}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+}
+
+func initEmbedder(t *testing.T, url string) {
+	conf := z.NewSuperFlag("url=" + url + "; model=test; api-key=s3cr3t").
+		MergeAndCheckDefault(EmbeddingDefaults)
+	require.NoError(t, InitEmbeddingProvider(conf))
+	t.Cleanup(func() { embedder = nil })
+}
+
+func TestOpenAIEmbedder(t *testing.T) {
+	var requests []openAIEmbeddingRequest
+	srv := newEmbeddingServer(t, &requests)
+	defer srv.Close()
+	initEmbedder(t, srv.URL)
+
+	vecs, err := embed(context.Background(), []string{"a", "abc"})
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{1, 1}, {3, 1}}, vecs)
+	require.Equal(t, []openAIEmbeddingRequest{{Model: "test", Input: []string{"a", "abc"}}},
+		requests)
+}
+
+func TestAddEmbeddingEdges(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		description: string .
+		description_vec: float32vector @index(hnsw) @embedding(description) .`), 1))
+	ctx := x.AttachNamespace(context.Background(), x.RootNamespace)
+	edges := []*pb.DirectedEdge{
+		{Entity: 1, Attr: "description", Value: []byte("abcd"), ValueType: pb.Posting_DEFAULT,
+			Op: pb.DirectedEdge_SET},
+		{Entity: 2, Attr: "description", Value: []byte("*"), ValueType: pb.Posting_DEFAULT,
+			Op: pb.DirectedEdge_DEL},
+		{Entity: 3, Attr: "name", Value: []byte("abc"), ValueType: pb.Posting_DEFAULT,
+			Op: pb.DirectedEdge_SET},
+	}
+
+	// The vectors can't be computed without a provider.
+	_, err := AddEmbeddingEdges(ctx, edges)
+	require.ErrorContains(t, err, "no embedding provider is configured")
+
+	var requests []openAIEmbeddingRequest
+	srv := newEmbeddingServer(t, &requests)
+	defer srv.Close()
+	initEmbedder(t, srv.URL)
+
+	out, err := AddEmbeddingEdges(ctx, edges)
+	require.NoError(t, err)
+	require.Len(t, out, 5)
+	require.Equal(t, &pb.DirectedEdge{Entity: 2, Attr: "description_vec", Value: []byte(x.Star),
+		ValueType: pb.Posting_DEFAULT, Op: pb.DirectedEdge_DEL}, out[3])
+	require.Equal(t, &pb.DirectedEdge{Entity: 1, Attr: "description_vec",
+		Value: types.FloatArrayAsBytes([]float32{4, 1}), ValueType: pb.Posting_VFLOAT,
+		Op: pb.DirectedEdge_SET}, out[4])
+	require.Len(t, requests, 1)
+}
+
+func TestAddEmbeddingEdgesInt8(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		summary: string .
+		summary_vec: int8vector @index(hnsw) @embedding(summary) .`), 1))
+	ctx := x.AttachNamespace(context.Background(), x.RootNamespace)
+
+	var requests []openAIEmbeddingRequest
+	srv := newEmbeddingServer(t, &requests)
+	defer srv.Close()
+	initEmbedder(t, srv.URL)
+
+	out, err := AddEmbeddingEdges(ctx, []*pb.DirectedEdge{{Entity: 1, Attr: "summary",
+		Value: []byte("abcd"), ValueType: pb.Posting_DEFAULT, Op: pb.DirectedEdge_SET}})
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+	require.Equal(t, &pb.DirectedEdge{Entity: 1, Attr: "summary_vec",
+		Value:     types.VectorAsBytes(types.VInt8ID, []float32{127, 127}),
+		ValueType: pb.Posting_VINT8, Op: pb.DirectedEdge_SET}, out[1])
+
+	// The text searched with similar_to is embedded as a vector of the same type.
+	vecs, err := embedQuery(ctx, x.AttrInRootNamespace("summary_vec"), "abcd")
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{127, 127}}, vecs)
+}
+
+func TestSimilarToText(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		summary: string .
+		summary_vec: float32vector @index(hnsw) @embedding(summary) .
+		other_vec: float32vector @index(hnsw) .`), 1))
+	ctx := x.AttachNamespace(context.Background(), x.RootNamespace)
+
+	var requests []openAIEmbeddingRequest
+	srv := newEmbeddingServer(t, &requests)
+	defer srv.Close()
+	initEmbedder(t, srv.URL)
+
+	query := func(attr string) *pb.Query {
+		return &pb.Query{Attr: x.AttrInRootNamespace(attr), ReadTs: 1,
+			SrcFunc: &pb.SrcFunction{Name: "similar_to", Args: []string{"3", "abcd"}}}
+	}
+	fc, err := parseSrcFn(ctx, query("summary_vec"))
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{4, 1}}, fc.vectorInfo)
+
+	// Only the predicates computed by the embedder are searched by text.
+	_, err = parseSrcFn(ctx, query("other_vec"))
+	require.Error(t, err)
+	require.Len(t, requests, 1)
+}
+
+func TestEmbeddingAsVector(t *testing.T) {
+	vec := []float32{0.5, -1, 0, 0.25, -0.1, 1, 0.75, -0.5}
+	res, err := embeddingAsVector(types.VFloatID, vec)
+	require.NoError(t, err)
+	require.Equal(t, vec, res)
+
+	res, err = embeddingAsVector(types.VInt8ID, vec)
+	require.NoError(t, err)
+	require.Equal(t, []float32{64, -127, 0, 32, -13, 127, 95, -64}, res)
+
+	res, err = embeddingAsVector(types.VBinaryID, vec)
+	require.NoError(t, err)
+	require.Equal(t, []float32{1, 0, 0, 1, 0, 1, 1, 0}, res)
+
+	// A binaryvector holds a whole number of bytes.
+	_, err = embeddingAsVector(types.VBinaryID, vec[:3])
+	require.Error(t, err)
+
+	_, err = embeddingAsVector(types.StringID, vec)
+	require.ErrorContains(t, err, "string is not a vector type")
+}
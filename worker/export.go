@@ -13,6 +13,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"net/url"
 	"os"
@@ -27,6 +28,7 @@ import (
 
 	"github.com/dgraph-io/badger/v4"
 	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/badger/v4/y"
 	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/dgraph-io/ristretto/v2/z"
 	"github.com/hypermodeinc/dgraph/v25/enc"
@@ -42,9 +44,10 @@ import (
 const DefaultExportFormat = "rdf"
 
 type exportFormat struct {
-	ext  string // file extension
-	pre  string // string to write before exported records
-	post string // string to write after exported records
+	ext     string // file extension
+	pre     string // string to write before exported records
+	post    string // string to write after exported records
+	tabular bool   // one row per node, written by tabularExport
 }
 
 var exportFormats = map[string]exportFormat{
@@ -58,6 +61,13 @@ var exportFormats = map[string]exportFormat{
 		pre:  "",
 		post: "",
 	},
+	// The csv and parquet formats write the data into several files, see export_tabular.go.
+	"csv": {
+		tabular: true,
+	},
+	"parquet": {
+		tabular: true,
+	},
 }
 
 type exporter struct {
@@ -66,6 +76,26 @@ type exporter struct {
 	attr      string
 	namespace uint64
 	readTs    uint64
+	// postings are exported instead of the postings of pl if pl is nil. Delta exports use it
+	// to export only the postings that changed.
+	postings []*pb.Posting
+}
+
+// deleteVersion is the version of the KVs that hold the postings deleted since the SinceTs
+// of a delta export.
+const deleteVersion = 5
+
+// iterate calls f for all the postings to be exported.
+func (e *exporter) iterate(f func(p *pb.Posting) error) error {
+	if e.pl != nil {
+		return e.pl.Iterate(e.readTs, 0, f)
+	}
+	for _, p := range e.postings {
+		if err := f(p); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Map from our types to RDF type. Useful when writing storage types
@@ -82,6 +112,9 @@ var rdfTypeMap = map[types.TypeID]string{
 	types.PasswordID: "xs:password",
 	types.BigFloatID: "xs:decimal",
 	types.VFloatID:   "xs:[]float32",
+	types.VFloat16ID: "xs:[]float16",
+	types.VInt8ID:    "xs:[]int8",
+	types.VBinaryID:  "xs:[]binary",
 }
 
 // UIDs like 0x1 look weird but 64-bit ones like 0x0000000000000001 are too long.
@@ -160,7 +193,7 @@ func (e *exporter) toJSON() (*bpb.KVList, error) {
 
 	continuing := false
 	mapStart := fmt.Sprintf("  {\"uid\":"+uidFmtStrJson+`,"namespace":"0x%x"`, e.uid, e.namespace)
-	err := e.pl.Iterate(e.readTs, 0, func(p *pb.Posting) error {
+	err := e.iterate(func(p *pb.Posting) error {
 		if continuing {
 			fmt.Fprint(bp, ",\n")
 		} else {
@@ -217,7 +250,7 @@ func (e *exporter) toRDF() (*bpb.KVList, error) {
 	bp := new(bytes.Buffer)
 
 	prefix := fmt.Sprintf(uidFmtStrRdf+" <%s> ", e.uid, e.attr)
-	err := e.pl.Iterate(e.readTs, 0, func(p *pb.Posting) error {
+	err := e.iterate(func(p *pb.Posting) error {
 		fmt.Fprint(bp, prefix)
 		if p.PostingType == pb.Posting_REF {
 			fmt.Fprintf(bp, uidFmtStrRdf, p.Uid)
@@ -324,6 +357,9 @@ func toSchema(attr string, update *pb.SchemaUpdate) *bpb.KV {
 	if update.GetUnique() {
 		x.Check2(buf.WriteString(" @unique"))
 	}
+	if update.GetEmbeddingSource() != "" {
+		x.Check2(buf.WriteString(" @embedding(" + update.GetEmbeddingSource() + ")"))
+	}
 	x.Check2(buf.WriteString(" . \n"))
 	//TODO(Naman): We don't need the version anymore.
 	return &bpb.KV{
@@ -397,11 +433,13 @@ func fieldToString(update *pb.SchemaUpdate) string {
 type ExportWriter struct {
 	fd            *os.File
 	bw            *bufio.Writer
+	ew            io.Writer
 	gw            *gzip.Writer
 	relativePath  string
 	hasDataBefore bool
 }
 
+// open creates the file at fpath. Files are gzip compressed if their name ends with .gz.
 func (writer *ExportWriter) open(fpath string) error {
 	var err error
 	writer.fd, err = os.Create(fpath)
@@ -409,20 +447,33 @@ func (writer *ExportWriter) open(fpath string) error {
 		return err
 	}
 	writer.bw = bufio.NewWriterSize(writer.fd, 1e6)
-	w, err := enc.GetWriter(x.WorkerConfig.EncryptionKey, writer.bw)
+	writer.ew, err = enc.GetWriter(x.WorkerConfig.EncryptionKey, writer.bw)
 	if err != nil {
 		return err
 	}
-	writer.gw, err = gzip.NewWriterLevel(w, gzip.BestSpeed)
+	if !strings.HasSuffix(fpath, ".gz") {
+		return nil
+	}
+	writer.gw, err = gzip.NewWriterLevel(writer.ew, gzip.BestSpeed)
 	return err
 }
 
-func (writer *ExportWriter) Close() error {
-	if err := writer.gw.Flush(); err != nil {
-		return err
+// Write writes p into the file, through gzip if the file is compressed.
+func (writer *ExportWriter) Write(p []byte) (int, error) {
+	if writer.gw == nil {
+		return writer.ew.Write(p)
 	}
-	if err := writer.gw.Close(); err != nil {
-		return err
+	return writer.gw.Write(p)
+}
+
+func (writer *ExportWriter) Close() error {
+	if writer.gw != nil {
+		if err := writer.gw.Flush(); err != nil {
+			return err
+		}
+		if err := writer.gw.Close(); err != nil {
+			return err
+		}
 	}
 	if err := writer.bw.Flush(); err != nil {
 		return err
@@ -485,19 +536,19 @@ func (l *localExportStorage) OpenFile(fileName string) (*ExportWriter, error) {
 }
 
 func (l *localExportStorage) FinishWriting(w *Writers) (ExportedFiles, error) {
-	if err := w.DataWriter.Close(); err != nil {
-		return nil, err
-	}
-	if err := w.SchemaWriter.Close(); err != nil {
-		return nil, err
-	}
-	if err := w.GqlSchemaWriter.Close(); err != nil {
-		return nil, err
-	}
-	files := ExportedFiles{
-		w.DataWriter.relativePath,
-		w.SchemaWriter.relativePath,
-		w.GqlSchemaWriter.relativePath,
+	var files ExportedFiles
+	all := append([]*ExportWriter{w.DataWriter, w.DeleteWriter, w.SchemaWriter,
+		w.GqlSchemaWriter}, w.TabularWriters...)
+	for _, writer := range all {
+		// The tabular formats don't have a data writer, and only delta exports have a
+		// delete writer.
+		if writer == nil {
+			continue
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		files = append(files, writer.relativePath)
 	}
 	return files, nil
 }
@@ -561,9 +612,13 @@ func (r *remoteExportStorage) FinishWriting(w *Writers) (ExportedFiles, error) {
 		}
 		filePath := filepath.Join(r.les.destination, f)
 		// FIXME: tejas [06/2020] - We could probably stream these results, but it's easier to copy for now
+		contentType := "application/gzip"
+		if !strings.HasSuffix(f, ".gz") {
+			contentType = "application/octet-stream"
+		}
 		glog.Infof("Uploading from %s to %s\n", filePath, d)
 		_, err := r.mc.FPutObject(context.Background(), r.bucket, d, filePath, minio.PutObjectOptions{
-			ContentType: "application/gzip",
+			ContentType: contentType,
 		})
 		if err != nil {
 			return nil, err
@@ -599,10 +654,37 @@ func export(ctx context.Context, in *pb.ExportRequest) (ExportedFiles, error) {
 	}
 	glog.Infof("Running export for group %d at timestamp %d.", in.GroupId, in.ReadTs)
 
+	if in.SinceTs > 0 {
+		// The versions older than the last snapshot can be discarded by Badger, after which
+		// the state of the data at SinceTs can't be read anymore.
+		snap, err := groups().Node.Snapshot()
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read snapshot")
+		}
+		if in.SinceTs < snap.ReadTs {
+			return nil, errors.Errorf("Cannot export the changes since %d because group %d may "+
+				"have discarded the versions older than its last snapshot at %d",
+				in.SinceTs, in.GroupId, snap.ReadTs)
+		}
+	}
 	return exportInternal(ctx, in, pstore, false)
 }
 
 func ToExportKvList(pk x.ParsedKey, pl *posting.List, in *pb.ExportRequest) (*bpb.KVList, error) {
+	return toExportKvList(pk, pl, nil, in)
+}
+
+// ToDeltaExportKvList exports the changes made to a posting list between in.SinceTs and
+// in.ReadTs. oldPl and pl must have been read at in.SinceTs and in.ReadTs respectively. The
+// postings that were added or changed are exported like ToExportKvList does, while the ones
+// that were removed are exported as deletions.
+func ToDeltaExportKvList(pk x.ParsedKey, oldPl, pl *posting.List,
+	in *pb.ExportRequest) (*bpb.KVList, error) {
+	return toExportKvList(pk, pl, oldPl, in)
+}
+
+func toExportKvList(pk x.ParsedKey, pl, oldPl *posting.List,
+	in *pb.ExportRequest) (*bpb.KVList, error) {
 	e := &exporter{
 		readTs:    in.ReadTs,
 		uid:       pk.Uid,
@@ -663,27 +745,40 @@ func ToExportKvList(pk x.ParsedKey, pl *posting.List, in *pb.ExportRequest) (*bp
 	case pk.IsData():
 		// The GraphQL layer will create a node of type "dgraph.graphql". That entry
 		// should not be exported.
-		if e.attr == "dgraph.type" {
-			vals, err := e.pl.AllValues(in.ReadTs)
+		isGraphQLNode := func(pl *posting.List, readTs uint64) (bool, error) {
+			vals, err := pl.AllValues(readTs)
 			if err != nil {
-				return emptyList, errors.Wrapf(err, "cannot read value of dgraph.type entry")
+				return false, errors.Wrapf(err, "cannot read value of dgraph.type entry")
 			}
 			if len(vals) == 1 {
 				val, ok := vals[0].Value.([]byte)
 				if !ok {
-					return emptyList, errors.Errorf("cannot read value of dgraph.type entry")
-				}
-				if string(val) == "dgraph.graphql" {
-					return emptyList, nil
+					return false, errors.Errorf("cannot read value of dgraph.type entry")
 				}
+				return string(val) == "dgraph.graphql", nil
+			}
+			return false, nil
+		}
+		if e.attr == "dgraph.type" {
+			skip, err := isGraphQLNode(e.pl, in.ReadTs)
+			if err == nil && !skip && oldPl != nil {
+				skip, err = isGraphQLNode(oldPl, in.SinceTs)
+			}
+			if err != nil || skip {
+				return emptyList, err
 			}
 		}
 
+		if oldPl != nil {
+			return e.toDelta(oldPl, in.SinceTs, in.Format)
+		}
 		switch in.Format {
 		case "json":
 			return e.toJSON()
 		case "rdf":
 			return e.toRDF()
+		case "csv", "parquet":
+			return e.toTabular()
 		default:
 			glog.Fatalf("Invalid export format found: %s", in.Format)
 		}
@@ -694,6 +789,98 @@ func ToExportKvList(pk x.ParsedKey, pl *posting.List, in *pb.ExportRequest) (*bp
 	return emptyList, nil
 }
 
+// postingsAt returns copies of the postings of pl at readTs, sorted by uid.
+func postingsAt(pl *posting.List, readTs uint64) ([]*pb.Posting, error) {
+	var postings []*pb.Posting
+	err := pl.Iterate(readTs, 0, func(p *pb.Posting) error {
+		postings = append(postings, proto.Clone(p).(*pb.Posting))
+		return nil
+	})
+	return postings, err
+}
+
+// samePosting tells whether a and b, which have the same uid, hold the same value and facets.
+func samePosting(a, b *pb.Posting) bool {
+	if a.PostingType != b.PostingType || a.ValType != b.ValType ||
+		!bytes.Equal(a.Value, b.Value) || !bytes.Equal(a.LangTag, b.LangTag) ||
+		len(a.Facets) != len(b.Facets) {
+		return false
+	}
+	for i := range a.Facets {
+		if !proto.Equal(a.Facets[i], b.Facets[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// toDelta exports the postings of e.pl that aren't in oldPl, which was read at sinceTs, or
+// whose value or facets changed since then. The postings of oldPl that are no longer in
+// e.pl are exported as deletions. A changed posting is only exported as a set, given that
+// setting it again overwrites the old value.
+func (e *exporter) toDelta(oldPl *posting.List, sinceTs uint64, format string) (
+	*bpb.KVList, error) {
+
+	oldPostings, err := postingsAt(oldPl, sinceTs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read posting list at %d", sinceTs)
+	}
+	newPostings, err := postingsAt(e.pl, e.readTs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read posting list at %d", e.readTs)
+	}
+
+	var set, del []*pb.Posting
+	i, j := 0, 0
+	for i < len(oldPostings) || j < len(newPostings) {
+		switch {
+		case j == len(newPostings) ||
+			(i < len(oldPostings) && oldPostings[i].Uid < newPostings[j].Uid):
+			del = append(del, oldPostings[i])
+			i++
+		case i == len(oldPostings) || newPostings[j].Uid < oldPostings[i].Uid:
+			set = append(set, newPostings[j])
+			j++
+		default:
+			if !samePosting(oldPostings[i], newPostings[j]) {
+				set = append(set, newPostings[j])
+			}
+			i++
+			j++
+		}
+	}
+
+	export := func(postings []*pb.Posting, version uint64) (*bpb.KVList, error) {
+		ee := *e
+		ee.pl = nil
+		ee.postings = postings
+		var list *bpb.KVList
+		var err error
+		switch format {
+		case "json":
+			list, err = ee.toJSON()
+		case "rdf":
+			list, err = ee.toRDF()
+		default:
+			return nil, errors.Errorf("Delta exports don't support the %s format", format)
+		}
+		for _, kv := range list.GetKv() {
+			kv.Version = version
+		}
+		return list, err
+	}
+	list, err := export(set, 1)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := export(del, deleteVersion)
+	if err != nil {
+		return nil, err
+	}
+	list.Kv = append(list.Kv, deleted.Kv...)
+	return list, nil
+}
+
 func WriteExport(writers *Writers, kv *bpb.KV, format string) error {
 	// Skip nodes that have no data. Otherwise, the exported data could have
 	// formatting and/or syntax errors.
@@ -708,6 +895,9 @@ func WriteExport(writers *Writers, kv *bpb.KV, format string) error {
 	case "rdf":
 		// The separator for RDF should be empty since the toRDF function already
 		// adds newline to each RDF entry.
+	case "csv", "parquet":
+		// The data of these formats is written by tabularExport, only the GraphQL schema
+		// comes through here.
 	default:
 		glog.Fatalf("Invalid export format found: %s", format)
 	}
@@ -723,6 +913,9 @@ func WriteExport(writers *Writers, kv *bpb.KV, format string) error {
 		sep = []byte(",\n") // use json separator.
 	case 3: // graphQL schema
 		writer = writers.SchemaWriter
+	case deleteVersion:
+		writer = writers.DeleteWriter
+		sep = dataSeparator
 	default:
 		glog.Fatalf("Invalid data type found: %x", kv.Key)
 	}
@@ -744,6 +937,12 @@ type Writers struct {
 	DataWriter      *ExportWriter
 	SchemaWriter    *ExportWriter
 	GqlSchemaWriter *ExportWriter
+	// TabularWriters are the data files of the csv and parquet formats.
+	TabularWriters []*ExportWriter
+	// DeleteWriter holds the data deleted since the SinceTs of a delta export. A delta export
+	// holds the difference between the data at SinceTs and at ReadTs rather than the history of
+	// the changes, so a posting is either set or deleted, and the files commute.
+	DeleteWriter *ExportWriter
 }
 
 func InitWriters(s ExportStorage, in *pb.ExportRequest) (*Writers, error) {
@@ -754,8 +953,15 @@ func InitWriters(s ExportStorage, in *pb.ExportRequest) (*Writers, error) {
 	}
 
 	var err error
-	if w.DataWriter, err = s.OpenFile(fileName(xfmt.ext + ".gz")); err != nil {
-		return w, err
+	if !xfmt.tabular {
+		if w.DataWriter, err = s.OpenFile(fileName(xfmt.ext + ".gz")); err != nil {
+			return w, err
+		}
+	}
+	if in.SinceTs > 0 {
+		if w.DeleteWriter, err = s.OpenFile(fileName(".deletes" + xfmt.ext + ".gz")); err != nil {
+			return w, err
+		}
 	}
 	if w.SchemaWriter, err = s.OpenFile(fileName(".schema.gz")); err != nil {
 		return w, err
@@ -766,6 +972,15 @@ func InitWriters(s ExportStorage, in *pb.ExportRequest) (*Writers, error) {
 	return w, nil
 }
 
+// exportName returns the name of the directory holding the files of the export.
+func exportName(in *pb.ExportRequest) string {
+	uts := time.Unix(in.UnixTs, 0).UTC().Format("0102.1504")
+	if in.SinceTs > 0 {
+		return fmt.Sprintf("dgraph.r%d.s%d.u%s", in.ReadTs, in.SinceTs, uts)
+	}
+	return fmt.Sprintf("dgraph.r%d.u%s", in.ReadTs, uts)
+}
+
 // exportInternal contains the core logic to export a Dgraph database. If skipZero is set to
 // false, the parts of this method that require to talk to zero will be skipped. This is useful
 // when exporting a p directory directly from disk without a running cluster.
@@ -774,9 +989,16 @@ func InitWriters(s ExportStorage, in *pb.ExportRequest) (*Writers, error) {
 func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 	skipZero bool) (ExportedFiles, error) {
 
-	uts := time.Unix(in.UnixTs, 0)
-	exportStorage, err := NewExportStorage(in,
-		fmt.Sprintf("dgraph.r%d.u%s", in.ReadTs, uts.UTC().Format("0102.1504")))
+	if in.SinceTs > 0 {
+		if in.SinceTs >= in.ReadTs {
+			return nil, errors.Errorf("Export sinceTs %d must be lower than readTs %d",
+				in.SinceTs, in.ReadTs)
+		}
+		if exportFormats[in.Format].tabular {
+			return nil, errors.Errorf("Delta exports don't support the %s format", in.Format)
+		}
+	}
+	exportStorage, err := NewExportStorage(in, exportName(in))
 	if err != nil {
 		return nil, err
 	}
@@ -784,65 +1006,27 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 	if err != nil {
 		return nil, errors.Wrap(err, "exportInternal failed")
 	}
-	// This stream exports only the data and the graphQL schema.
-	stream := db.NewStreamAt(in.ReadTs)
-	stream.Prefix = []byte{x.DefaultPrefix}
-	if in.Namespace != math.MaxUint64 {
-		// Export a specific namespace.
-		stream.Prefix = append(stream.Prefix, x.NamespaceToBytes(in.Namespace)...)
-	}
-	stream.LogPrefix = "Export"
-	stream.ChooseKey = func(item *badger.Item) bool {
-		// Skip exporting delete data including Schema and Types.
-		if item.IsDeletedOrExpired() {
-			return false
-		}
-		pk, err := x.Parse(item.Key())
-		if err != nil {
-			glog.Errorf("error %v while parsing key %v during export. Skip.", err,
-				hex.EncodeToString(item.Key()))
-			return false
-		}
-
-		// Do not pick keys storing parts of a multi-part list. They will be read
-		// from the main key.
-		if pk.HasStartUid {
-			return false
-		}
-		// _predicate_ is deprecated but leaving this here so that users with a
-		// binary with version >= 1.1 can export data from a version < 1.1 without
-		// this internal data showing up.
-		if pk.Attr == "_predicate_" {
-			return false
-		}
-
-		if !skipZero {
-			if servesTablet, err := groups().ServesTablet(pk.Attr); err != nil || !servesTablet {
-				return false
-			}
-		}
-
-		if strings.Contains(pk.Attr, hnsw.VecKeyword) {
-			return false
-		}
-		return pk.IsData()
-	}
-
-	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
-		item := itr.Item()
-		pk, err := x.Parse(item.Key())
-		if err != nil {
-			glog.Errorf("error %v while parsing key %v during export. Skip.", err,
-				hex.EncodeToString(item.Key()))
+	xfmt := exportFormats[in.Format]
+	var tabular *tabularExport
+	if xfmt.tabular && skipZero {
+		if tabular, err = newTabularExport(in, skipZero); err != nil {
 			return nil, err
 		}
-		pl, err := posting.ReadPostingList(key, itr)
-		if err != nil {
-			return nil, errors.Wrapf(err, "cannot read posting list")
+		defer tabular.close()
+	}
+	// This stream exports only the data and the graphQL schema.
+	stream := exportStream(in, db, skipZero)
+	if xfmt.tabular && !skipZero {
+		// The data of all the groups is written together by the alpha coordinating the export,
+		// see exportTabular, so that there is a single file per type. Only the GraphQL schema is
+		// exported here.
+		chooseKey := stream.ChooseKey
+		stream.ChooseKey = func(item *badger.Item) bool {
+			pk, err := x.Parse(item.Key())
+			return err == nil && x.ParseAttr(pk.Attr) == GqlSchemaPred &&
+				chooseKey(item)
 		}
-		return ToExportKvList(pk, pl, in)
 	}
-
 	stream.Send = func(buf *z.Buffer) error {
 		kv := &bpb.KV{}
 		return buf.SliceIterate(func(s []byte) error {
@@ -850,6 +1034,9 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 			if err := proto.Unmarshal(s, kv); err != nil {
 				return err
 			}
+			if kv.Version == tabularVersion {
+				return tabular.add(kv)
+			}
 			return WriteExport(writers, kv, in.Format)
 		})
 	}
@@ -911,20 +1098,34 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 		}
 		return nil
 	}
-	xfmt := exportFormats[in.Format]
 
 	// All prepwork done. Time to roll.
 	if _, err = writers.GqlSchemaWriter.gw.Write([]byte(exportFormats["json"].pre)); err != nil {
 		return nil, err
 	}
-	if _, err = writers.DataWriter.gw.Write([]byte(xfmt.pre)); err != nil {
-		return nil, err
+	for _, w := range []*ExportWriter{writers.DataWriter, writers.DeleteWriter} {
+		if w == nil {
+			continue
+		}
+		if _, err = w.gw.Write([]byte(xfmt.pre)); err != nil {
+			return nil, err
+		}
 	}
 	if err := stream.Orchestrate(ctx); err != nil {
 		return nil, err
 	}
-	if _, err = writers.DataWriter.gw.Write([]byte(xfmt.post)); err != nil {
-		return nil, err
+	if tabular != nil {
+		if err := tabular.finish(ctx, db, exportStorage, writers); err != nil {
+			return nil, err
+		}
+	}
+	for _, w := range []*ExportWriter{writers.DataWriter, writers.DeleteWriter} {
+		if w == nil {
+			continue
+		}
+		if _, err = w.gw.Write([]byte(xfmt.post)); err != nil {
+			return nil, err
+		}
 	}
 	if _, err = writers.GqlSchemaWriter.gw.Write([]byte(exportFormats["json"].post)); err != nil {
 		return nil, err
@@ -942,6 +1143,96 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 	return exportStorage.FinishWriting(writers)
 }
 
+// exportStream returns a stream over the data keys of the export, turning them into the KVs of
+// the export format. If skipZero is false, only the tablets served by this group are exported.
+func exportStream(in *pb.ExportRequest, db *badger.DB, skipZero bool) *badger.Stream {
+	stream := db.NewStreamAt(in.ReadTs)
+	// Delta exports only pick the keys that were written after SinceTs.
+	stream.SinceTs = in.SinceTs
+	stream.Prefix = []byte{x.DefaultPrefix}
+	if in.Namespace != math.MaxUint64 {
+		// Export a specific namespace.
+		stream.Prefix = append(stream.Prefix, x.NamespaceToBytes(in.Namespace)...)
+	}
+	stream.LogPrefix = "Export"
+	stream.ChooseKey = func(item *badger.Item) bool {
+		// Skip exporting delete data including Schema and Types. Delta exports need the
+		// deleted keys to export their deletion.
+		if item.IsDeletedOrExpired() && in.SinceTs == 0 {
+			return false
+		}
+		pk, err := x.Parse(item.Key())
+		if err != nil {
+			glog.Errorf("error %v while parsing key %v during export. Skip.", err,
+				hex.EncodeToString(item.Key()))
+			return false
+		}
+
+		// Do not pick keys storing parts of a multi-part list. They will be read
+		// from the main key.
+		if pk.HasStartUid {
+			return false
+		}
+		// _predicate_ is deprecated but leaving this here so that users with a
+		// binary with version >= 1.1 can export data from a version < 1.1 without
+		// this internal data showing up.
+		if pk.Attr == "_predicate_" {
+			return false
+		}
+
+		if !skipZero {
+			if servesTablet, err := groups().ServesTablet(pk.Attr); err != nil || !servesTablet {
+				return false
+			}
+		}
+
+		if strings.Contains(pk.Attr, hnsw.VecKeyword) {
+			return false
+		}
+		return pk.IsData()
+	}
+
+	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+		item := itr.Item()
+		pk, err := x.Parse(item.Key())
+		if err != nil {
+			glog.Errorf("error %v while parsing key %v during export. Skip.", err,
+				hex.EncodeToString(item.Key()))
+			return nil, err
+		}
+		if in.SinceTs > 0 {
+			// The iterator of the stream skips the versions up to SinceTs, so both lists
+			// are read with their own iterators.
+			oldPl, err := readListAt(db, key, in.SinceTs)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot read posting list at %d", in.SinceTs)
+			}
+			pl, err := readListAt(db, key, in.ReadTs)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot read posting list at %d", in.ReadTs)
+			}
+			return ToDeltaExportKvList(pk, oldPl, pl, in)
+		}
+		pl, err := posting.ReadPostingList(key, itr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read posting list")
+		}
+		return ToExportKvList(pk, pl, in)
+	}
+	return stream
+}
+
+// readListAt reads the posting list stored in key as of readTs.
+func readListAt(db *badger.DB, key []byte, readTs uint64) (*posting.List, error) {
+	txn := db.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	itr := txn.NewKeyIterator(key, badger.IteratorOptions{AllVersions: true})
+	defer itr.Close()
+	itr.Rewind()
+	// ReadPostingList takes ownership of the key.
+	return posting.ReadPostingList(y.Copy(key), itr)
+}
+
 func SchemaExportKv(attr string, val []byte, skipZero bool) (*bpb.KV, error) {
 	if !skipZero {
 		servesTablet, err := groups().ServesTablet(attr)
@@ -1028,13 +1319,16 @@ func ExportOverNetwork(ctx context.Context, input *pb.ExportRequest) (ExportedFi
 		ExportedFiles
 		error
 	}
+	// All the groups write their files into the same directory.
+	unixTs := time.Now().Unix()
 	ch := make(chan filesAndError, len(gids))
 	for _, gid := range gids {
 		go func(group uint32) {
 			req := &pb.ExportRequest{
 				GroupId:   group,
 				ReadTs:    readTs,
-				UnixTs:    time.Now().Unix(),
+				SinceTs:   input.SinceTs,
+				UnixTs:    unixTs,
 				Format:    input.Format,
 				Namespace: input.Namespace,
 
@@ -1060,6 +1354,29 @@ func ExportOverNetwork(ctx context.Context, input *pb.ExportRequest) (ExportedFi
 		allFiles = append(allFiles, pair.ExportedFiles...)
 	}
 
+	if exportFormats[input.Format].tabular {
+		req := &pb.ExportRequest{
+			GroupId:   groups().groupId(),
+			ReadTs:    readTs,
+			UnixTs:    unixTs,
+			Format:    input.Format,
+			Namespace: input.Namespace,
+
+			Destination:  input.Destination,
+			AccessKey:    input.AccessKey,
+			SecretKey:    input.SecretKey,
+			SessionToken: input.SessionToken,
+			Anonymous:    input.Anonymous,
+		}
+		files, err := exportTabular(ctx, req, gids)
+		if err != nil {
+			rerr := errors.Wrapf(err, "Export failed at readTs %d", readTs)
+			glog.Errorln(rerr)
+			return nil, rerr
+		}
+		allFiles = append(allFiles, files...)
+	}
+
 	glog.Infof("Export at readTs %d DONE", readTs)
 	return allFiles, nil
 }
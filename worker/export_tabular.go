@@ -0,0 +1,863 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/xitongsys/parquet-go/parquet"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/badger/v4/options"
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// The csv and parquet formats write one row per node, which needs all the predicates of a node
+// together, while the data keys are sorted by predicate and split across groups. So the export of
+// these formats runs in two phases. First, toTabular turns every posting list into KVs keyed by
+// uid, which the groups stream to the alpha coordinating the export, by exportTabular. They are
+// written into a temporary badger. Then, the temporary badger is iterated in uid order and the
+// rows are written out, by tabularExport.finish, into a single file per type.
+
+// tabularVersion is the version of the KVs produced by toTabular.
+const tabularVersion = 4
+
+// The key of a KV produced by toTabular is the uid of the node, followed by the namespaced
+// predicate and the language tag of the values, if any. Its value is a pb.ValueList with the
+// values of the predicate, uids are stored as 8 byte values of type uid. The nodes that are only
+// the target of an edge get a KV without predicate nor value, so that they make it to the export.
+func tabularKey(uid uint64, nsAttr, lang string) []byte {
+	key := make([]byte, 8, 8+len(nsAttr)+1+len(lang))
+	binary.BigEndian.PutUint64(key, uid)
+	key = append(key, nsAttr...)
+	if lang != "" {
+		key = append(key, '@')
+		key = append(key, lang...)
+	}
+	return key
+}
+
+// parseTabularKey returns the uid, the namespace and the column of a key built by tabularKey.
+// The column is the predicate, suffixed by @lang for language tagged values.
+func parseTabularKey(key []byte) (uint64, uint64, string, error) {
+	if len(key) < 8 || !bytes.Contains(key[8:], []byte(x.NsSeparator)) {
+		return 0, 0, "", errors.Errorf("invalid export key %x", key)
+	}
+	uid := binary.BigEndian.Uint64(key[:8])
+	ns, col := x.ParseNamespaceAttr(string(key[8:]))
+	return uid, ns, col, nil
+}
+
+func (e *exporter) toTabular() (*bpb.KVList, error) {
+	vals := make(map[string]*pb.ValueList)
+	var langs []string
+	var edges pb.ValueList
+	var targets []uint64
+	err := e.pl.Iterate(e.readTs, 0, func(p *pb.Posting) error {
+		if p.PostingType == pb.Posting_REF {
+			var uid [8]byte
+			binary.BigEndian.PutUint64(uid[:], p.Uid)
+			edges.Values = append(edges.Values, &pb.TaskValue{
+				Val:     uid[:],
+				ValType: pb.Posting_UID,
+			})
+			targets = append(targets, p.Uid)
+			return nil
+		}
+		lang := string(p.LangTag)
+		vl, ok := vals[lang]
+		if !ok {
+			vl = &pb.ValueList{}
+			vals[lang] = vl
+			langs = append(langs, lang)
+		}
+		vl.Values = append(vl.Values, &pb.TaskValue{Val: p.Value, ValType: p.ValType})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nsAttr := x.NamespaceAttr(e.namespace, e.attr)
+	list := &bpb.KVList{}
+	add := func(key []byte, vl *pb.ValueList) error {
+		kv := &bpb.KV{Key: key, Version: tabularVersion}
+		if vl != nil {
+			val, err := proto.Marshal(vl)
+			if err != nil {
+				return err
+			}
+			kv.Value = val
+		}
+		list.Kv = append(list.Kv, kv)
+		return nil
+	}
+	for _, lang := range langs {
+		if err := add(tabularKey(e.uid, nsAttr, lang), vals[lang]); err != nil {
+			return nil, err
+		}
+	}
+	if len(edges.Values) > 0 {
+		if err := add(tabularKey(e.uid, nsAttr, ""), &edges); err != nil {
+			return nil, err
+		}
+	}
+	for _, uid := range targets {
+		if err := add(tabularKey(uid, x.NamespaceAttr(e.namespace, ""), ""), nil); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// tabularColumn has what is known about a column after the first phase of the export.
+type tabularColumn struct {
+	name  string
+	tid   types.TypeID
+	mixed bool // The column has values of different types, they are exported as strings.
+	list  bool // Some node has more than one value for the column.
+}
+
+func (c *tabularColumn) typ() types.TypeID {
+	if c.mixed {
+		return types.StringID
+	}
+	return c.tid
+}
+
+func (c *tabularColumn) isUid() bool {
+	return !c.mixed && c.tid == types.UidID
+}
+
+// tabularNode has the values of all the predicates of a node.
+type tabularNode struct {
+	uid   uint64
+	ns    uint64
+	cols  map[string]*pb.ValueList
+	types []string
+}
+
+// tabularOutput writes the nodes of a namespace in one of the tabular formats.
+type tabularOutput interface {
+	write(n *tabularNode) error
+	close() error
+}
+
+type tabularExport struct {
+	in       *pb.ExportRequest
+	skipZero bool
+	dir      string
+	db       *badger.DB
+	batch    *badger.WriteBatch
+	// columns has the columns of every namespace, by name.
+	columns map[uint64]map[string]*tabularColumn
+}
+
+func newTabularExport(in *pb.ExportRequest, skipZero bool) (*tabularExport, error) {
+	dir, err := os.MkdirTemp(x.WorkerConfig.TmpDir, "dgraph_export_")
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating temp dir for export")
+	}
+	opts := badger.DefaultOptions(dir).
+		WithSyncWrites(false).
+		WithLogger(&x.ToGlog{}).
+		WithCompression(options.None).
+		WithLoggingLevel(badger.WARNING).
+		WithMetricsEnabled(false)
+	if len(x.WorkerConfig.EncryptionKey) > 0 {
+		opts.EncryptionKey = x.WorkerConfig.EncryptionKey
+		opts.BlockCacheSize = 100 << 20
+		opts.IndexCacheSize = 100 << 20
+	}
+	db, err := badger.OpenManaged(opts)
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, errors.Wrap(err, "error opening temp badger for export")
+	}
+	return &tabularExport{
+		in:       in,
+		skipZero: skipZero,
+		dir:      dir,
+		db:       db,
+		batch:    db.NewManagedWriteBatch(),
+		columns:  make(map[uint64]map[string]*tabularColumn),
+	}, nil
+}
+
+// add stores a KV produced by toTabular, and keeps track of the columns it belongs to.
+func (t *tabularExport) add(kv *bpb.KV) error {
+	if err := t.batch.SetEntryAt(badger.NewEntry(kv.Key, kv.Value), 1); err != nil {
+		return errors.Wrap(err, "error setting entries in temp badger")
+	}
+	_, ns, name, err := parseTabularKey(kv.Key)
+	if err != nil || name == "" {
+		return err
+	}
+	var vl pb.ValueList
+	if err := proto.Unmarshal(kv.Value, &vl); err != nil {
+		return err
+	}
+	if len(vl.Values) == 0 {
+		return nil
+	}
+	cols, ok := t.columns[ns]
+	if !ok {
+		cols = make(map[string]*tabularColumn)
+		t.columns[ns] = cols
+	}
+	tid := types.TypeID(vl.Values[0].ValType)
+	col, ok := cols[name]
+	if !ok {
+		col = &tabularColumn{name: name, tid: tid}
+		cols[name] = col
+	}
+	col.list = col.list || len(vl.Values) > 1
+	for _, v := range vl.Values {
+		col.mixed = col.mixed || types.TypeID(v.ValType) != col.tid
+	}
+	return nil
+}
+
+// addBuffer stores the KVs of a buffer produced by an export stream, keeping the ones produced
+// by toTabular.
+func (t *tabularExport) addBuffer(buf *z.Buffer) error {
+	kv := &bpb.KV{}
+	return buf.SliceIterate(func(s []byte) error {
+		kv.Reset()
+		if err := proto.Unmarshal(s, kv); err != nil {
+			return err
+		}
+		if kv.Version != tabularVersion {
+			return nil
+		}
+		return t.add(kv)
+	})
+}
+
+// fetch stores the KVs produced by toTabular for the data of group gid.
+func (t *tabularExport) fetch(ctx context.Context, gid uint32) error {
+	in := proto.Clone(t.in).(*pb.ExportRequest)
+	in.GroupId = gid
+	if groups().ServesGroup(gid) {
+		if err := posting.Oracle().WaitForTs(ctx, in.ReadTs); err != nil {
+			return err
+		}
+		stream := exportStream(in, pstore, false)
+		stream.Send = t.addBuffer
+		return stream.Orchestrate(ctx)
+	}
+
+	pl := groups().Leader(gid)
+	if pl == nil {
+		return errors.Errorf("Unable to find leader of group: %d", gid)
+	}
+	stream, err := pb.NewWorkerClient(pl.Get()).ExportTabular(ctx, in)
+	if err != nil {
+		return err
+	}
+	for {
+		kvs, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := t.addBuffer(z.NewBufferSlice(kvs.Data)); err != nil {
+			return err
+		}
+	}
+}
+
+// exportTabular exports the data of the groups gids in a tabular format. It joins the data of
+// all the groups, so that there is a single row per node, and a single file per type.
+func exportTabular(ctx context.Context, in *pb.ExportRequest, gids []uint32) (ExportedFiles,
+	error) {
+
+	t, err := newTabularExport(in, false)
+	if err != nil {
+		return nil, err
+	}
+	defer t.close()
+	for _, gid := range gids {
+		if err := t.fetch(ctx, gid); err != nil {
+			return nil, errors.Wrapf(err, "while fetching the data of group %d for export", gid)
+		}
+	}
+
+	s, err := NewExportStorage(in, exportName(in))
+	if err != nil {
+		return nil, err
+	}
+	writers := &Writers{}
+	if err := t.finish(ctx, pstore, s, writers); err != nil {
+		return nil, err
+	}
+	glog.Infof("Export of the %s data DONE at timestamp %d.", in.Format, in.ReadTs)
+	return s.FinishWriting(writers)
+}
+
+// ExportTabular streams the KVs produced by toTabular for the data of this group, to the alpha
+// coordinating the export.
+func (w *grpcWorker) ExportTabular(in *pb.ExportRequest, out pb.Worker_ExportTabularServer) error {
+	ctx := out.Context()
+	if in.GroupId != groups().groupId() {
+		return errors.Errorf("Export request group mismatch. Mine: %d. Requested: %d",
+			groups().groupId(), in.GroupId)
+	}
+	if err := posting.Oracle().WaitForTs(ctx, in.ReadTs); err != nil {
+		return err
+	}
+	stream := exportStream(in, pstore, false)
+	stream.Send = func(buf *z.Buffer) error {
+		return out.Send(&pb.KVS{Data: buf.Bytes()})
+	}
+	return stream.Orchestrate(ctx)
+}
+
+// close removes the temporary badger.
+func (t *tabularExport) close() {
+	t.batch.Cancel()
+	if err := t.db.Close(); err != nil {
+		glog.Warningf("error closing temp badger for export: %v", err)
+	}
+	if err := os.RemoveAll(t.dir); err != nil {
+		glog.Warningf("error removing temp dir %s of export: %v", t.dir, err)
+	}
+}
+
+// finish runs the second phase of the export, writing the nodes stored in the temporary badger
+// into new files of the export.
+func (t *tabularExport) finish(ctx context.Context, pdb *badger.DB, s ExportStorage,
+	writers *Writers) error {
+
+	if err := t.batch.Flush(); err != nil {
+		return err
+	}
+
+	var typeFields map[uint64]map[string][]string
+	if t.in.Format == "parquet" {
+		var err error
+		if typeFields, err = readTypeFields(pdb, t.in); err != nil {
+			return err
+		}
+	}
+	outputs := make(map[uint64]tabularOutput)
+	output := func(ns uint64) (tabularOutput, error) {
+		if out, ok := outputs[ns]; ok {
+			return out, nil
+		}
+		// The files of an export of the data directory of a group are named after it.
+		var parts []string
+		if t.skipZero {
+			parts = append(parts, fmt.Sprintf("g%02d", t.in.GroupId))
+		}
+		if ns != x.RootNamespace {
+			parts = append(parts, fmt.Sprintf("ns%#x", ns))
+		}
+		prefix := strings.Join(parts, ".")
+		var out tabularOutput
+		var err error
+		switch t.in.Format {
+		case "csv":
+			out, err = newCsvOutput(s, writers, prefix, t.columns[ns])
+		case "parquet":
+			out = newParquetOutput(s, writers, prefix, t.columns[ns], typeFields[ns])
+		}
+		if err != nil {
+			return nil, err
+		}
+		outputs[ns] = out
+		return out, nil
+	}
+
+	write := func(n *tabularNode) error {
+		if n == nil {
+			return nil
+		}
+		if vl, ok := n.cols["dgraph.type"]; ok {
+			for _, v := range vl.Values {
+				n.types = append(n.types, string(v.Val))
+			}
+		}
+		out, err := output(n.ns)
+		if err != nil {
+			return err
+		}
+		return out.write(n)
+	}
+
+	txn := t.db.NewTransactionAt(1, false)
+	defer txn.Discard()
+	itr := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer itr.Close()
+	var node *tabularNode
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		item := itr.Item()
+		uid, ns, name, err := parseTabularKey(item.Key())
+		if err != nil {
+			return err
+		}
+		if node == nil || node.uid != uid {
+			if err := write(node); err != nil {
+				return err
+			}
+			node = &tabularNode{uid: uid, ns: ns, cols: make(map[string]*pb.ValueList)}
+		}
+		if name == "" {
+			continue
+		}
+		var vl pb.ValueList
+		if err := item.Value(func(val []byte) error {
+			return proto.Unmarshal(val, &vl)
+		}); err != nil {
+			return err
+		}
+		node.cols[name] = &vl
+	}
+	if err := write(node); err != nil {
+		return err
+	}
+
+	for _, out := range outputs {
+		if err := out.close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readTypeFields returns the predicates of every type, by namespace.
+func readTypeFields(db *badger.DB, in *pb.ExportRequest) (map[uint64]map[string][]string, error) {
+	txn := db.NewTransactionAt(in.ReadTs, false)
+	defer txn.Discard()
+	iopts := badger.DefaultIteratorOptions
+	iopts.Prefix = []byte{x.ByteType}
+	if in.Namespace != math.MaxUint64 {
+		iopts.Prefix = append(iopts.Prefix, x.NamespaceToBytes(in.Namespace)...)
+	}
+	itr := txn.NewIterator(iopts)
+	defer itr.Close()
+
+	fields := make(map[uint64]map[string][]string)
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		if item.IsDeletedOrExpired() {
+			continue
+		}
+		pk, err := x.Parse(item.Key())
+		if err != nil {
+			return nil, err
+		}
+		var update pb.TypeUpdate
+		if err := item.Value(func(val []byte) error {
+			return proto.Unmarshal(val, &update)
+		}); err != nil {
+			return nil, err
+		}
+		ns, name := x.ParseNamespaceAttr(pk.Attr)
+		if _, ok := fields[ns]; !ok {
+			fields[ns] = make(map[string][]string)
+		}
+		for _, f := range update.Fields {
+			fields[ns][name] = append(fields[ns][name], x.ParseAttr(f.Predicate))
+		}
+	}
+	return fields, nil
+}
+
+// tabularFileName returns the name of a file of the export, prefixed by prefix if it's set.
+func tabularFileName(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// sortedColumns returns the columns of cols sorted by name, skipping dgraph.type.
+func sortedColumns(cols map[string]*tabularColumn) []*tabularColumn {
+	res := make([]*tabularColumn, 0, len(cols))
+	for _, c := range cols {
+		if c.name == "dgraph.type" {
+			continue
+		}
+		res = append(res, c)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].name < res[j].name })
+	return res
+}
+
+// csvTypes has the type suffix of the header of csv columns, as understood by the import tool of
+// Neo4j. Columns of other types are exported as strings.
+var csvTypes = map[types.TypeID]string{
+	types.IntID:      ":long",
+	types.FloatID:    ":double",
+	types.BoolID:     ":boolean",
+	types.DateTimeID: ":datetime",
+}
+
+// csvArrayDelimiter separates the values of list columns.
+const csvArrayDelimiter = ";"
+
+// csvArrayEscaper escapes the delimiter in the values of list columns with a backslash, and the
+// backslashes as well, so that the values holding it aren't split apart.
+var csvArrayEscaper = strings.NewReplacer(`\`, `\\`, csvArrayDelimiter, `\`+csvArrayDelimiter)
+
+// csvArray returns the values of a list column, escaped and joined by the delimiter.
+func csvArray(vals []string) string {
+	escaped := make([]string, len(vals))
+	for i, v := range vals {
+		escaped[i] = csvArrayEscaper.Replace(v)
+	}
+	return strings.Join(escaped, csvArrayDelimiter)
+}
+
+// csvOutput writes a file of nodes, with a column for every scalar predicate, and a file of
+// edges for the uid predicates. The headers follow the format of the neo4j-admin import tool,
+// which other graph databases can import as well. The types of the nodes are exported as labels.
+// Facets aren't exported.
+type csvOutput struct {
+	cols  []*tabularColumn
+	nodes *csv.Writer
+	edges *csv.Writer
+	row   []string
+}
+
+func newCsvOutput(s ExportStorage, writers *Writers, prefix string,
+	cols map[string]*tabularColumn) (*csvOutput, error) {
+
+	out := &csvOutput{}
+	header := []string{"uid:ID", ":LABEL"}
+	for _, c := range sortedColumns(cols) {
+		if c.isUid() {
+			continue
+		}
+		name := c.name + csvTypes[c.typ()]
+		if c.list {
+			if _, ok := csvTypes[c.typ()]; !ok {
+				name += ":string"
+			}
+			name += "[]"
+		}
+		out.cols = append(out.cols, c)
+		header = append(header, name)
+	}
+
+	nw, err := s.OpenFile(tabularFileName(prefix, "nodes.csv.gz"))
+	if err != nil {
+		return nil, err
+	}
+	writers.TabularWriters = append(writers.TabularWriters, nw)
+	ew, err := s.OpenFile(tabularFileName(prefix, "edges.csv.gz"))
+	if err != nil {
+		return nil, err
+	}
+	writers.TabularWriters = append(writers.TabularWriters, ew)
+
+	out.nodes = csv.NewWriter(nw)
+	out.edges = csv.NewWriter(ew)
+	if err := out.nodes.Write(header); err != nil {
+		return nil, err
+	}
+	if err := out.edges.Write([]string{":START_ID", ":END_ID", ":TYPE"}); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (o *csvOutput) write(n *tabularNode) error {
+	src := fmt.Sprintf("%#x", n.uid)
+	o.row = append(o.row[:0], src, csvArray(n.types))
+	for _, c := range o.cols {
+		vl, ok := n.cols[c.name]
+		if !ok {
+			o.row = append(o.row, "")
+			continue
+		}
+		strs := make([]string, 0, len(vl.Values))
+		for _, v := range vl.Values {
+			str, err := valToStr(types.Val{Tid: types.TypeID(v.ValType), Value: v.Val})
+			if err != nil {
+				glog.Errorf("Ignoring error: %+v\n", err)
+				continue
+			}
+			strs = append(strs, str)
+		}
+		if c.list {
+			o.row = append(o.row, csvArray(strs))
+		} else {
+			o.row = append(o.row, strings.Join(strs, ""))
+		}
+	}
+	if err := o.nodes.Write(o.row); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(n.cols))
+	for name, vl := range n.cols {
+		if len(vl.Values) > 0 && types.TypeID(vl.Values[0].ValType) == types.UidID {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		vl := n.cols[name]
+		for _, v := range vl.Values {
+			dst := fmt.Sprintf("%#x", binary.BigEndian.Uint64(v.Val))
+			if err := o.edges.Write([]string{src, dst, name}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (o *csvOutput) close() error {
+	o.nodes.Flush()
+	o.edges.Flush()
+	if err := o.nodes.Error(); err != nil {
+		return err
+	}
+	return o.edges.Error()
+}
+
+// parquetFile is a parquet file with the nodes of a type.
+type parquetFile struct {
+	pw   *parquetWriter
+	cols []*tabularColumn
+	has  map[string]struct{} // The names of cols.
+	row  [][]interface{}
+}
+
+// parquetUntyped is the name of the parquet file with the nodes that don't have a type defined in
+// the schema, and with the predicates of the other nodes that aren't in any of their types. Names
+// starting with dgraph. are reserved, so no type can have a file of this name.
+const parquetUntyped = "dgraph.untyped"
+
+// parquetOutput writes a parquet file for every type, with the nodes of that type. The columns
+// are the uid of the nodes and the predicates of the type, uid predicates are lists of uids.
+// The rest of the data goes to the parquetUntyped file, whose columns are the uid of the nodes
+// and all the predicates, including dgraph.type.
+type parquetOutput struct {
+	s       ExportStorage
+	writers *Writers
+	prefix  string
+	cols    map[string]*tabularColumn
+	fields  map[string][]string
+	files   map[string]*parquetFile
+	untyped *parquetFile
+}
+
+func newParquetOutput(s ExportStorage, writers *Writers, prefix string,
+	cols map[string]*tabularColumn, fields map[string][]string) *parquetOutput {
+
+	return &parquetOutput{
+		s:       s,
+		writers: writers,
+		prefix:  prefix,
+		cols:    cols,
+		fields:  fields,
+		files:   make(map[string]*parquetFile),
+	}
+}
+
+// parquetColumnType returns the physical and the converted types of a parquet column holding
+// values of the given type.
+func parquetColumnType(tid types.TypeID) (parquet.Type, parquet.ConvertedType) {
+	switch tid {
+	case types.IntID:
+		return parquet.Type_INT64, parquetNone
+	case types.FloatID:
+		return parquet.Type_DOUBLE, parquetNone
+	case types.BoolID:
+		return parquet.Type_BOOLEAN, parquetNone
+	case types.DateTimeID:
+		return parquet.Type_INT64, parquet.ConvertedType_TIMESTAMP_MICROS
+	}
+	return parquet.Type_BYTE_ARRAY, parquet.ConvertedType_UTF8
+}
+
+// parquetValue converts a value to the Go type stored in a parquet column of type tid.
+func parquetValue(v *pb.TaskValue, tid types.TypeID) (interface{}, error) {
+	src := types.Val{Tid: types.TypeID(v.ValType), Value: v.Val}
+	switch tid {
+	case types.UidID:
+		return fmt.Sprintf("%#x", binary.BigEndian.Uint64(v.Val)), nil
+	case types.IntID, types.FloatID, types.BoolID:
+		val, err := types.Convert(src, tid)
+		return val.Value, err
+	case types.DateTimeID:
+		val, err := types.Convert(src, tid)
+		if err != nil {
+			return nil, err
+		}
+		return val.Value.(time.Time).UnixMicro(), nil
+	}
+	return valToStr(src)
+}
+
+func (o *parquetOutput) file(typeName string) (*parquetFile, error) {
+	if f, ok := o.files[typeName]; ok {
+		return f, nil
+	}
+	fields, ok := o.fields[typeName]
+	if !ok {
+		return nil, nil
+	}
+
+	var cols []*tabularColumn
+	for _, field := range fields {
+		if strings.HasPrefix(field, "~") || field == "dgraph.type" {
+			continue
+		}
+		// The untagged values of the predicate come first, then the tagged ones.
+		var fieldCols []*tabularColumn
+		for _, c := range o.cols {
+			if c.name == field || strings.HasPrefix(c.name, field+"@") {
+				fieldCols = append(fieldCols, c)
+			}
+		}
+		sort.Slice(fieldCols, func(i, j int) bool { return fieldCols[i].name < fieldCols[j].name })
+		cols = append(cols, fieldCols...)
+	}
+	f, err := o.newFile(typeName, cols)
+	if err != nil {
+		return nil, err
+	}
+	o.files[typeName] = f
+	return f, nil
+}
+
+// newFile creates the parquet file of the given name, with the uid of the nodes and cols.
+func (o *parquetOutput) newFile(name string, cols []*tabularColumn) (*parquetFile, error) {
+	f := &parquetFile{cols: cols, has: make(map[string]struct{}, len(cols))}
+	pcols := []parquetColumn{{name: "uid", typ: parquet.Type_BYTE_ARRAY,
+		converted: parquet.ConvertedType_UTF8, required: true}}
+	for _, c := range cols {
+		typ, converted := parquetColumnType(c.typ())
+		pcols = append(pcols, parquetColumn{
+			name:      c.name,
+			typ:       typ,
+			converted: converted,
+			list:      c.list || c.isUid(),
+		})
+		f.has[c.name] = struct{}{}
+	}
+
+	ew, err := o.s.OpenFile(tabularFileName(o.prefix, url.PathEscape(name)+".parquet"))
+	if err != nil {
+		return nil, err
+	}
+	o.writers.TabularWriters = append(o.writers.TabularWriters, ew)
+	if f.pw, err = newParquetWriter(ew, name, pcols); err != nil {
+		return nil, err
+	}
+	f.row = make([][]interface{}, len(pcols))
+	return f, nil
+}
+
+// writeRow writes the node into f, with the values of the columns for which keep returns true.
+func (f *parquetFile) writeRow(n *tabularNode, keep func(c *tabularColumn) bool) error {
+	f.row[0] = append(f.row[0][:0], fmt.Sprintf("%#x", n.uid))
+	for i, c := range f.cols {
+		vals := f.row[i+1][:0]
+		if vl, ok := n.cols[c.name]; ok && keep(c) {
+			for _, v := range vl.Values {
+				val, err := parquetValue(v, c.typ())
+				if err != nil {
+					glog.Errorf("Ignoring error: %+v\n", err)
+					continue
+				}
+				vals = append(vals, val)
+			}
+		}
+		f.row[i+1] = vals
+	}
+	return f.pw.WriteRow(f.row)
+}
+
+func (o *parquetOutput) write(n *tabularNode) error {
+	var written []*parquetFile
+	for _, typeName := range n.types {
+		f, err := o.file(typeName)
+		if err != nil {
+			return err
+		}
+		if f == nil {
+			continue
+		}
+		if err := f.writeRow(n, func(*tabularColumn) bool { return true }); err != nil {
+			return err
+		}
+		written = append(written, f)
+	}
+
+	inTypes := func(name string) bool {
+		for _, f := range written {
+			if _, ok := f.has[name]; ok {
+				return true
+			}
+		}
+		return false
+	}
+	rest := len(written) == 0
+	for name := range n.cols {
+		if name != "dgraph.type" && !inTypes(name) {
+			rest = true
+			break
+		}
+	}
+	if !rest {
+		return nil
+	}
+	if o.untyped == nil {
+		cols := sortedColumns(o.cols)
+		if c, ok := o.cols["dgraph.type"]; ok {
+			cols = append([]*tabularColumn{c}, cols...)
+		}
+		var err error
+		if o.untyped, err = o.newFile(parquetUntyped, cols); err != nil {
+			return err
+		}
+	}
+	return o.untyped.writeRow(n, func(c *tabularColumn) bool {
+		return c.name == "dgraph.type" || !inTypes(c.name)
+	})
+}
+
+func (o *parquetOutput) close() error {
+	for _, f := range o.files {
+		if err := f.pw.Close(); err != nil {
+			return err
+		}
+	}
+	if o.untyped != nil {
+		return o.untyped.pw.Close()
+	}
+	return nil
+}
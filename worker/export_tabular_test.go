@@ -0,0 +1,206 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go/parquet"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestTabularKey(t *testing.T) {
+	key := tabularKey(0x42, x.NamespaceAttr(2, "name"), "en")
+	uid, ns, col, err := parseTabularKey(key)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x42), uid)
+	require.Equal(t, uint64(2), ns)
+	require.Equal(t, "name@en", col)
+
+	// The keys of the nodes that are only the target of edges don't have a predicate.
+	uid, ns, col, err = parseTabularKey(tabularKey(0x43, x.NamespaceAttr(2, ""), ""))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x43), uid)
+	require.Equal(t, uint64(2), ns)
+	require.Equal(t, "", col)
+
+	_, _, _, err = parseTabularKey(key[:9])
+	require.Error(t, err)
+}
+
+func readCsvFile(t *testing.T, path string) [][]string {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	records, err := csv.NewReader(r).ReadAll()
+	require.NoError(t, err)
+	return records
+}
+
+func TestCsvOutput(t *testing.T) {
+	dir := t.TempDir()
+	s, err := newLocalExportStorage(dir, "export")
+	require.NoError(t, err)
+
+	intVal := func(n int64) *pb.TaskValue {
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], uint64(n))
+		return &pb.TaskValue{Val: b[:], ValType: pb.Posting_INT}
+	}
+	strVal := func(s string) *pb.TaskValue {
+		return &pb.TaskValue{Val: []byte(s), ValType: pb.Posting_STRING}
+	}
+	uidVal := func(uid uint64) *pb.TaskValue {
+		var b [8]byte
+		binary.BigEndian.PutUint64(b[:], uid)
+		return &pb.TaskValue{Val: b[:], ValType: pb.Posting_UID}
+	}
+
+	cols := map[string]*tabularColumn{
+		"age":         {name: "age", tid: types.IntID},
+		"name":        {name: "name", tid: types.StringID},
+		"name@fr":     {name: "name@fr", tid: types.StringID},
+		"nick":        {name: "nick", tid: types.StringID, list: true},
+		"friend":      {name: "friend", tid: types.UidID},
+		"dgraph.type": {name: "dgraph.type", tid: types.StringID},
+	}
+	writers := &Writers{}
+	out, err := newCsvOutput(s, writers, "g01", cols)
+	require.NoError(t, err)
+	require.NoError(t, out.write(&tabularNode{
+		uid: 1,
+		cols: map[string]*pb.ValueList{
+			"age":     {Values: []*pb.TaskValue{intVal(33)}},
+			"name":    {Values: []*pb.TaskValue{strVal("Alice, \"Al\"")}},
+			"name@fr": {Values: []*pb.TaskValue{strVal("Alice")}},
+			"nick":    {Values: []*pb.TaskValue{strVal("al"), strVal("a;l"), strVal(`a\`)}},
+			"friend":  {Values: []*pb.TaskValue{uidVal(2), uidVal(3)}},
+		},
+		types: []string{"Person", "Employee"},
+	}))
+	require.NoError(t, out.write(&tabularNode{uid: 2, cols: map[string]*pb.ValueList{}}))
+	require.NoError(t, out.close())
+
+	files, err := s.FinishWriting(writers)
+	require.NoError(t, err)
+	require.Equal(t, ExportedFiles{"export/g01.nodes.csv.gz", "export/g01.edges.csv.gz"}, files)
+
+	require.Equal(t, [][]string{
+		{"uid:ID", ":LABEL", "age:long", "name", "name@fr", "nick:string[]"},
+		{"0x1", "Person;Employee", "33", "Alice, \"Al\"", "Alice", `al;a\;l;a\\`},
+		{"0x2", "", "", "", "", ""},
+	}, readCsvFile(t, filepath.Join(dir, files[0])))
+	require.Equal(t, [][]string{
+		{":START_ID", ":END_ID", ":TYPE"},
+		{"0x1", "0x2", "friend"},
+		{"0x1", "0x3", "friend"},
+	}, readCsvFile(t, filepath.Join(dir, files[1])))
+}
+
+func TestParquetValue(t *testing.T) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], 7)
+	v, err := parquetValue(&pb.TaskValue{Val: b[:], ValType: pb.Posting_INT}, types.IntID)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), v)
+
+	// Columns with values of mixed types are exported as strings.
+	v, err = parquetValue(&pb.TaskValue{Val: b[:], ValType: pb.Posting_INT}, types.StringID)
+	require.NoError(t, err)
+	require.Equal(t, "7", v)
+
+	binary.BigEndian.PutUint64(b[:], 0x2a)
+	v, err = parquetValue(&pb.TaskValue{Val: b[:], ValType: pb.Posting_UID}, types.UidID)
+	require.NoError(t, err)
+	require.Equal(t, "0x2a", v)
+
+	typ, converted := parquetColumnType(types.DateTimeID)
+	require.Equal(t, parquet.Type_INT64, typ)
+	require.Equal(t, parquet.ConvertedType_TIMESTAMP_MICROS, converted)
+}
+
+func TestParquetOutput(t *testing.T) {
+	dir := t.TempDir()
+	s, err := newLocalExportStorage(dir, "export")
+	require.NoError(t, err)
+
+	strVal := func(s string) *pb.TaskValue {
+		return &pb.TaskValue{Val: []byte(s), ValType: pb.Posting_STRING}
+	}
+	cols := map[string]*tabularColumn{
+		"name":        {name: "name", tid: types.StringID},
+		"nick":        {name: "nick", tid: types.StringID},
+		"dgraph.type": {name: "dgraph.type", tid: types.StringID},
+	}
+	writers := &Writers{}
+	out := newParquetOutput(s, writers, "", cols, map[string][]string{"Person": {"name"}})
+	// A node of a type, with a predicate that isn't in its type.
+	require.NoError(t, out.write(&tabularNode{
+		uid: 1,
+		cols: map[string]*pb.ValueList{
+			"name":        {Values: []*pb.TaskValue{strVal("Alice")}},
+			"nick":        {Values: []*pb.TaskValue{strVal("al")}},
+			"dgraph.type": {Values: []*pb.TaskValue{strVal("Person")}},
+		},
+		types: []string{"Person"},
+	}))
+	// A node with only the predicates of its type.
+	require.NoError(t, out.write(&tabularNode{
+		uid: 2,
+		cols: map[string]*pb.ValueList{
+			"name":        {Values: []*pb.TaskValue{strVal("Bob")}},
+			"dgraph.type": {Values: []*pb.TaskValue{strVal("Person")}},
+		},
+		types: []string{"Person"},
+	}))
+	// A node without a type and one whose type isn't in the schema.
+	require.NoError(t, out.write(&tabularNode{
+		uid:  3,
+		cols: map[string]*pb.ValueList{"name": {Values: []*pb.TaskValue{strVal("Carol")}}},
+	}))
+	require.NoError(t, out.write(&tabularNode{
+		uid: 4,
+		cols: map[string]*pb.ValueList{
+			"nick":        {Values: []*pb.TaskValue{strVal("dan")}},
+			"dgraph.type": {Values: []*pb.TaskValue{strVal("Robot")}},
+		},
+		types: []string{"Robot"},
+	}))
+	require.NoError(t, out.close())
+
+	files, err := s.FinishWriting(writers)
+	require.NoError(t, err)
+	require.ElementsMatch(t, ExportedFiles{"export/Person.parquet", "export/dgraph.untyped.parquet"},
+		files)
+
+	data, err := os.ReadFile(filepath.Join(dir, "export/Person.parquet"))
+	require.NoError(t, err)
+	_, names, pcols := readParquetFile(t, data)
+	require.Equal(t, []string{"Person", "uid", "name"}, names)
+	require.Equal(t, []interface{}{"0x1", "0x2"}, pcols[0].values)
+	require.Equal(t, []interface{}{"Alice", "Bob"}, pcols[1].values)
+
+	// Nothing is lost, the rest of the data is in the untyped file.
+	data, err = os.ReadFile(filepath.Join(dir, "export/dgraph.untyped.parquet"))
+	require.NoError(t, err)
+	_, names, pcols = readParquetFile(t, data)
+	require.Equal(t, []string{"dgraph.untyped", "uid", "dgraph.type", "name", "nick"}, names)
+	require.Equal(t, []interface{}{"0x1", "0x3", "0x4"}, pcols[0].values)
+	require.Equal(t, []interface{}{"Person", nil, "Robot"}, pcols[1].values)
+	require.Equal(t, []interface{}{nil, "Carol", nil}, pcols[2].values)
+	require.Equal(t, []interface{}{"al", nil, "dan"}, pcols[3].values)
+}
@@ -382,6 +382,134 @@ func TestExportJson(t *testing.T) {
 	checkExportGqlSchema(t, gqlSchema)
 }
 
+func TestExportCsv(t *testing.T) {
+	initTestExport(t, `name: string @index(exact) .
+				 [0x2] name: string @index(exact) .`)
+
+	bdir := t.TempDir()
+	time.Sleep(1 * time.Second)
+
+	x.WorkerConfig.ExportPath = bdir
+	readTs := timestamp()
+	// Do the following so export won't block forever for readTs.
+	posting.Oracle().ProcessDelta(&pb.OracleDelta{MaxAssigned: readTs})
+	req := pb.ExportRequest{ReadTs: readTs, GroupId: 1, Format: "csv", Namespace: math.MaxUint64}
+	files, err := export(context.Background(), &req)
+	require.NoError(t, err)
+	// The data of all the groups is written by the alpha coordinating the export.
+	require.Len(t, files, 2, "files=%v", files)
+	dataFiles, err := exportTabular(context.Background(), &req, []uint32{1})
+	require.NoError(t, err)
+	files = append(files, dataFiles...)
+
+	byName := make(map[string]string)
+	for _, f := range files {
+		byName[filepath.Base(f)] = filepath.Join(bdir, f)
+	}
+	require.Len(t, byName, 6, "files=%v", files)
+
+	readCsv := func(name string) [][]string {
+		path, ok := byName[name]
+		require.True(t, ok, "missing file %s in %v", name, files)
+		return readCsvFile(t, path)
+	}
+	require.Equal(t, [][]string{
+		{"uid:ID", ":LABEL", "name", "name@en"},
+		{"0x1", "", "pho\ton", ""},
+		{"0x2", "", "", "pho\ton"},
+		{"0x3", "", "First Line\nSecondLine", ""},
+		{"0x4", "", "", ""},
+		{"0x5", "", "", ""},
+		{"0x6", "", "Ding!\u0007Ding!\u0007Ding!\u0007", ""},
+	}, readCsv("nodes.csv.gz"))
+	require.Equal(t, [][]string{
+		{":START_ID", ":END_ID", ":TYPE"},
+		{"0x1", "0x5", "friend"},
+		{"0x2", "0x5", "friend"},
+		{"0x3", "0x5", "friend"},
+		{"0x4", "0x5", "friend"},
+	}, readCsv("edges.csv.gz"))
+	require.Equal(t, [][]string{
+		{"uid:ID", ":LABEL", "name"},
+		{"0x9", "", "ns2"},
+	}, readCsv("ns0x2.nodes.csv.gz"))
+
+	checkExportSchema(t, []string{byName["g01.schema.gz"]})
+	checkExportGqlSchema(t, []string{byName["g01.gql_schema.gz"]})
+}
+
+func TestExportDelta(t *testing.T) {
+	initTestExport(t, `name: string @index(exact) .
+				 [0x2] name: string @index(exact) .`)
+
+	name := x.AttrInRootNamespace("name")
+	friend := x.AttrInRootNamespace("friend")
+	nameEdge := func(uid uint64, val string) *pb.DirectedEdge {
+		return &pb.DirectedEdge{Entity: uid, Attr: name, Value: []byte(val)}
+	}
+	friendEdge := func(uid uint64) *pb.DirectedEdge {
+		return &pb.DirectedEdge{Entity: 2, Attr: friend, ValueId: uid, ValueType: pb.Posting_UID}
+	}
+
+	sinceTs := timestamp()
+	addEdge(t, nameEdge(1, "changed"), getOrCreate(x.DataKey(name, 1)))
+	addEdge(t, nameEdge(11, "new"), getOrCreate(x.DataKey(name, 11)))
+	delEdge(t, friendEdge(5), getOrCreate(x.DataKey(friend, 2)))
+	addEdge(t, friendEdge(6), getOrCreate(x.DataKey(friend, 2)))
+	t.Cleanup(func() {
+		addEdge(t, nameEdge(1, "pho\ton\u0000"), getOrCreate(x.DataKey(name, 1)))
+		delEdge(t, nameEdge(11, "new"), getOrCreate(x.DataKey(name, 11)))
+		delEdge(t, friendEdge(6), getOrCreate(x.DataKey(friend, 2)))
+		addEdge(t, friendEdge(5), getOrCreate(x.DataKey(friend, 2)))
+	})
+
+	bdir := t.TempDir()
+	x.WorkerConfig.ExportPath = bdir
+	readTs := timestamp()
+	posting.Oracle().ProcessDelta(&pb.OracleDelta{MaxAssigned: readTs})
+	req := &pb.ExportRequest{ReadTs: readTs, SinceTs: sinceTs, GroupId: 1, Format: "rdf",
+		Namespace: math.MaxUint64}
+	files, err := exportInternal(context.Background(), req, pstore, false)
+	require.NoError(t, err)
+
+	readLines := func(name string) []string {
+		for _, f := range files {
+			if filepath.Base(f) != name {
+				continue
+			}
+			fd, err := os.Open(filepath.Join(bdir, f))
+			require.NoError(t, err)
+			defer fd.Close()
+			r, err := gzip.NewReader(fd)
+			require.NoError(t, err)
+			b, err := io.ReadAll(r)
+			require.NoError(t, err)
+			return strings.Split(strings.TrimSpace(string(b)), "\n")
+		}
+		require.Failf(t, "missing export file", "%s not in %v", name, files)
+		return nil
+	}
+	// A changed value is only set, and an edge moved to another node is deleted from the old
+	// one and set to the new one. No triple is in both files, so they can be applied in any
+	// order.
+	sets := readLines("g01.rdf.gz")
+	deletes := readLines("g01.deletes.rdf.gz")
+	require.ElementsMatch(t, []string{
+		`<0x1> <name> "changed" <0x0> .`,
+		`<0xb> <name> "new" <0x0> .`,
+		`<0x2> <friend> <0x6> <0x0> .`,
+	}, sets)
+	require.Equal(t, []string{`<0x2> <friend> <0x5> <0x0> .`}, deletes)
+	for _, line := range deletes {
+		require.NotContains(t, sets, line)
+	}
+
+	// The changes can't be computed from a timestamp that is after the read timestamp.
+	req.SinceTs = readTs
+	_, err = exportInternal(context.Background(), req, pstore, false)
+	require.Error(t, err)
+}
+
 const exportRequest = `mutation export($format: String!) {
 	export(input: {format: $format}) {
 		response { code }
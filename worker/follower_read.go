@@ -0,0 +1,174 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.etcd.io/etcd/raft/v3"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/hypermodeinc/dgraph/v25/conn"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+/*
+A read-only query can declare how stale a result it accepts. It is then read at the max assigned
+timestamp of the Alpha it was sent to instead of one from Zero, as long as that Alpha is no more
+stale than that. Its tasks are sent to the closest replica of each group which is no more stale
+either: this Alpha if it serves the group, otherwise the replicas in the preferred zone, then the
+ones which have already applied the read timestamp, so that they serve it without waiting.
+
+The staleness of a replica is how long it has been behind the leader of its group in applying the
+Raft log, sent to its peers with the heartbeats. The leader is never stale. A follower cut off from
+its leader only notices once the election timeout is over, so it may be stale by that much more.
+*/
+
+type stalenessKey struct{}
+
+// WithMaxStaleness returns a context that lets the reads of a query be up to d stale.
+func WithMaxStaleness(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, stalenessKey{}, d)
+}
+
+// MaxStaleness returns how stale the reads of the query run with ctx can be, zero if they can't.
+func MaxStaleness(ctx context.Context) time.Duration {
+	d, _ := ctx.Value(stalenessKey{}).(time.Duration)
+	return d
+}
+
+// RequestedMaxStaleness returns the max staleness a gRPC client passed as metadata, if any.
+func RequestedMaxStaleness(ctx context.Context) (time.Duration, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["max-staleness"]) == 0 {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(md["max-staleness"][0]))
+	if err != nil || d < 0 {
+		return 0, errors.Errorf("Invalid max-staleness %q", md["max-staleness"][0])
+	}
+	return d, nil
+}
+
+// StaleReadTs returns the timestamp to read at for a query which can be up to maxStaleness stale.
+// It is zero if this Alpha is more stale than that, in which case the query needs a timestamp
+// from Zero.
+func StaleReadTs(maxStaleness time.Duration) uint64 {
+	if ReplicaLag() > maxStaleness {
+		return 0
+	}
+	return posting.Oracle().MaxAssigned()
+}
+
+// ReplicaLag returns how long this Alpha has been behind the leader of its group in applying the
+// Raft log, or conn.NoLeader if it has no leader.
+func ReplicaLag() time.Duration {
+	if groups().Node == nil {
+		return conn.NoLeader
+	}
+	return groups().Node.replicaLag()
+}
+
+// readState returns the max assigned timestamp applied by this node and its lag, sent to the peers
+// with the heartbeats.
+func (n *node) readState() (uint64, time.Duration) {
+	return posting.Oracle().MaxAssigned(), n.replicaLag()
+}
+
+// replicaLag returns the lag of the node. It records when the node was last caught up with the
+// leader, which it is sampled often enough for by the heartbeats.
+func (n *node) replicaLag() time.Duration {
+	r := n.Raft()
+	if r == nil {
+		return conn.NoLeader
+	}
+	status := r.Status()
+	now := time.Now()
+	switch {
+	case status.Lead == raft.None:
+		return conn.NoLeader
+	case status.Lead == status.ID || n.Applied.DoneUntil() >= status.Commit:
+		atomic.StoreInt64(&n.caughtUpAt, now.UnixNano())
+		return 0
+	}
+	caughtUpAt := atomic.LoadInt64(&n.caughtUpAt)
+	if caughtUpAt == 0 {
+		return conn.NoLeader
+	}
+	return now.Sub(time.Unix(0, caughtUpAt))
+}
+
+type replicaState struct {
+	addr        string
+	near        bool // In the preferred zone.
+	maxAssigned uint64
+	lag         time.Duration
+}
+
+// readReplica returns the member of the group to send a read at readTs to, which can be up to
+// maxStaleness stale, preferably in the zone. It returns local if this Alpha serves the group and
+// is recent enough, and an empty address if no member is.
+func (g *groupi) readReplica(gid uint32, readTs uint64, maxStaleness time.Duration,
+	zone string) (addr string, local bool) {
+
+	if g.ServesGroup(gid) && g.Node.replicaLag() <= maxStaleness {
+		return "", true
+	}
+	var replicas []replicaState
+	for _, m := range g.members(gid) {
+		if m.Addr == x.WorkerConfig.MyAddr {
+			continue
+		}
+		pl, err := conn.GetPools().Get(m.Addr)
+		if err != nil || !pl.IsHealthy() {
+			continue
+		}
+		maxAssigned, lag := pl.ReadState()
+		replicas = append(replicas, replicaState{
+			addr:        m.Addr,
+			near:        zone != "" && m.Zone == zone,
+			maxAssigned: maxAssigned,
+			lag:         lag,
+		})
+	}
+	return pickReplica(replicas, readTs, maxStaleness), false
+}
+
+// pickReplica picks the replica no more stale than maxStaleness which serves a read at readTs the
+// soonest. The ones in the preferred zone are the closest. Among them, the ones which have applied
+// readTs serve it without waiting, then the least stale ones are expected to catch up first.
+func pickReplica(replicas []replicaState, readTs uint64, maxStaleness time.Duration) string {
+	var best *replicaState
+	for i, r := range replicas {
+		if r.lag > maxStaleness {
+			continue
+		}
+		ready := r.maxAssigned >= readTs
+		switch {
+		case best == nil:
+		case r.near != best.near:
+			if !r.near {
+				continue
+			}
+		case ready != (best.maxAssigned >= readTs):
+			if !ready {
+				continue
+			}
+		case r.lag >= best.lag:
+			continue
+		}
+		best = &replicas[i]
+	}
+	if best == nil {
+		return ""
+	}
+	return best.addr
+}
@@ -0,0 +1,57 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/hypermodeinc/dgraph/v25/conn"
+)
+
+func TestPickReplica(t *testing.T) {
+	replicas := []replicaState{
+		{addr: "a", maxAssigned: 90, lag: 100 * time.Millisecond},
+		{addr: "b", maxAssigned: 100, lag: 2 * time.Second},
+		{addr: "c", maxAssigned: 110, lag: 4 * time.Second},
+		{addr: "d", maxAssigned: 120, lag: conn.NoLeader},
+	}
+	// The replicas which have applied the read timestamp come first.
+	require.Equal(t, "b", pickReplica(replicas, 100, 5*time.Second))
+	// Then the least stale ones.
+	require.Equal(t, "a", pickReplica(replicas, 200, 5*time.Second))
+	require.Equal(t, "a", pickReplica(replicas, 80, 5*time.Second))
+	require.Equal(t, "c", pickReplica(replicas[1:], 105, 5*time.Second))
+	require.Equal(t, "b", pickReplica(replicas[1:], 105, 3*time.Second))
+	require.Equal(t, "", pickReplica(replicas[1:], 105, time.Second))
+	require.Equal(t, "", pickReplica(nil, 105, time.Second))
+
+	// The replicas in the preferred zone come before all the others.
+	replicas[2].near = true
+	require.Equal(t, "c", pickReplica(replicas, 100, 5*time.Second))
+	require.Equal(t, "b", pickReplica(replicas, 100, 3*time.Second))
+}
+
+func TestRequestedMaxStaleness(t *testing.T) {
+	d, err := RequestedMaxStaleness(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, d)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("max-staleness", "5s"))
+	d, err = RequestedMaxStaleness(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, d)
+	require.Equal(t, 5*time.Second, MaxStaleness(WithMaxStaleness(ctx, d)))
+	require.Zero(t, MaxStaleness(ctx))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("max-staleness", "soon"))
+	_, err = RequestedMaxStaleness(ctx)
+	require.Error(t, err)
+}
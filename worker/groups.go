@@ -34,9 +34,11 @@ type groupi struct {
 	Node         *node
 	gid          uint32
 	tablets      map[string]*pb.Tablet
-	triggerCh    chan struct{} // Used to trigger membership sync
-	blockDeletes *sync.Mutex   // Ensure that deletion won't happen when move is going on.
+	tabletRanges map[string]*pb.TabletRanges // Predicates split across groups.
+	triggerCh    chan struct{}               // Used to trigger membership sync
+	blockDeletes *sync.Mutex                 // Ensure that deletion won't happen when move is going on.
 	closer       *z.Closer
+	zone         string // Zone or region this Alpha runs in.
 
 	// Group checksum is used to determine if the tablets served by the groups have changed from
 	// the membership information that the Alpha has. If so, Alpha cannot service a read.
@@ -98,6 +100,7 @@ func StartRaftNodes(walStore *raftwal.DiskStorage, bindall bool) {
 		GroupId: x.WorkerConfig.ProposedGroupId,
 		Addr:    x.WorkerConfig.MyAddr,
 		Learner: x.WorkerConfig.Raft.GetBool("learner"),
+		Zone:    x.WorkerConfig.Raft.GetString("zone"),
 	}
 	if m.GroupId > 0 {
 		m.ForceGroupId = true
@@ -124,6 +127,11 @@ func StartRaftNodes(walStore *raftwal.DiskStorage, bindall bool) {
 	glog.Infof("Connected to group zero. Assigned group: %+v\n", connState.GetMember().GetGroupId())
 	raftIdx = connState.GetMember().GetId()
 	glog.Infof("Raft Id after connection to Zero: %#x\n", raftIdx)
+	// A learner added through /admin gets its zone from there, unless it is given one.
+	gr.zone = m.Zone
+	if gr.zone == "" {
+		gr.zone = connState.GetMember().GetZone()
+	}
 
 	// This timestamp would be used for reading during snapshot after bulk load.
 	// The stream is async, we need this information before we start or else replica might
@@ -138,6 +146,10 @@ func StartRaftNodes(walStore *raftwal.DiskStorage, bindall bool) {
 	walStore.SetUint(raftwal.GroupId, uint64(gid))
 
 	gr.Node = newNode(walStore, gid, raftIdx, x.WorkerConfig.MyAddr)
+	if connState.GetMember().GetLearner() {
+		// Added as a learner through /admin.
+		gr.Node.RaftContext.IsLearner = true
+	}
 
 	x.Checkf(schema.LoadFromDb(context.Background()), "Error while initializing schema")
 	glog.Infof("Load schema from DB: OK")
@@ -306,6 +318,7 @@ func (g *groupi) applyState(myId uint64, state *pb.MembershipState) {
 	// Sometimes this can cause us to lose latest tablet info, but that shouldn't cause any issues.
 	var foundSelf bool
 	g.tablets = make(map[string]*pb.Tablet)
+	g.tabletRanges = g.state.TabletRanges
 	for gid, group := range g.state.Groups {
 		for _, member := range group.Members {
 			if myId == member.Id {
@@ -449,7 +462,7 @@ func (g *groupi) ServesTablet(key string) (bool, error) {
 	} else if tablet != nil && tablet.GroupId == groups().groupId() {
 		return true, nil
 	}
-	return false, nil
+	return g.servesRange(key), nil
 }
 
 func (g *groupi) sendTablet(tablet *pb.Tablet) (*pb.Tablet, error) {
@@ -562,25 +575,7 @@ func (g *groupi) HasMeInState() bool {
 
 // Returns 0, 1, or 2 valid server addrs.
 func (g *groupi) AnyTwoServers(gid uint32) []string {
-	g.RLock()
-	defer g.RUnlock()
-
-	if g.state == nil {
-		return []string{}
-	}
-	group, has := g.state.Groups[gid]
-	if !has {
-		return []string{}
-	}
-	var res []string
-	for _, m := range group.Members {
-		// map iteration gives us members in no particular order.
-		res = append(res, m.Addr)
-		if len(res) >= 2 {
-			break
-		}
-	}
-	return res
+	return g.closestServers(gid, g.zone, 2)
 }
 
 func (g *groupi) members(gid uint32) map[uint64]*pb.Member {
@@ -742,11 +737,15 @@ func (g *groupi) doSendMembership(tablets map[string]*pb.Tablet) error {
 		Addr:       x.WorkerConfig.MyAddr,
 		Leader:     leader,
 		LastUpdate: uint64(time.Now().Unix()),
+		Learner:    g.Node.RaftContext.IsLearner,
+		Zone:       g.zone,
 	}
 	group := &pb.Group{
 		Members: make(map[uint64]*pb.Member),
 	}
 	group.Members[member.Id] = member
+	// All the members serve queries, so they all report the load on their tablets.
+	group.TabletLoad = tabletLoads.report(time.Now())
 	if leader {
 		// Do not send tablet information, if I'm not the leader.
 		group.Tablets = tablets
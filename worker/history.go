@@ -0,0 +1,202 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// historyWindow holds back the timestamp below which badger discards the versions overwritten by
+// newer writes, so that the versions written within the --history retention window can still be
+// read by @asof queries and the history function. Dgraph timestamps are not tied to the wall
+// clock, so it remembers when each snapshot was taken to map the window to a timestamp. The leader
+// computes the window when it proposes a snapshot, so that it's kept in the snapshot through Raft
+// and is the same on all the members of the group, and after a restart.
+type historyWindow struct {
+	sync.Mutex
+	// snapshots are the snapshots taken within the retention window, oldest first.
+	snapshots []*pb.SnapshotTime
+	// startTs is the timestamp below which the older versions may have been discarded.
+	startTs uint64
+}
+
+var history historyWindow
+
+// next returns the snapshots within the retention window once a snapshot at readTs is taken at
+// the given time, and the timestamp at or below which badger can then discard the older versions
+// of the keys.
+func (h *historyWindow) next(readTs uint64, now time.Time,
+	retention time.Duration) ([]*pb.SnapshotTime, uint64) {
+
+	h.Lock()
+	defer h.Unlock()
+	if retention <= 0 {
+		return nil, readTs
+	}
+
+	snapshots := append(h.snapshots[:len(h.snapshots):len(h.snapshots)],
+		&pb.SnapshotTime{ReadTs: readTs, TakenAt: now.UnixNano()})
+	// The newest snapshot taken before the window started is where the window starts.
+	start := now.Add(-retention).UnixNano()
+	startTs := h.startTs
+	var i int
+	for i < len(snapshots) && snapshots[i].TakenAt <= start {
+		i++
+	}
+	if i > 0 {
+		startTs = snapshots[i-1].ReadTs
+		snapshots = snapshots[i:]
+	}
+	return snapshots, startTs
+}
+
+// set sets the retention window to the one kept in the snapshot, and returns the timestamp it
+// starts at.
+func (h *historyWindow) set(snap *pb.Snapshot) uint64 {
+	h.Lock()
+	defer h.Unlock()
+	h.snapshots = snap.History
+	h.startTs = snap.HistoryStartTs
+	if len(snap.History) == 0 && snap.HistoryStartTs == 0 {
+		// The snapshot was taken without a retention window.
+		h.startTs = snap.ReadTs
+	}
+	return h.startTs
+}
+
+// HistoryStartTs returns the oldest timestamp that the data of the groups served by this alpha
+// can be read at. Older versions might have been discarded.
+func HistoryStartTs() uint64 {
+	history.Lock()
+	defer history.Unlock()
+	return history.startTs
+}
+
+// checkAsOf returns an error if readTs is the timestamp of an @asof directive older than the
+// history retention window of this alpha.
+func checkAsOf(asOf bool, readTs uint64) error {
+	if start := HistoryStartTs(); asOf && readTs < start {
+		return errors.Errorf("@asof timestamp %d is older than the history retention window, "+
+			"which starts at %d", readTs, start)
+	}
+	return nil
+}
+
+// handleHistory fills the history matrix with the value timeline of the predicate for each uid.
+func (qs *queryState) handleHistory(ctx context.Context, arg funcArgs) error {
+	attr := arg.q.Attr
+	if arg.srcFn.atype == types.UidID {
+		return errors.Errorf("history is only supported for scalar predicates, got: %s",
+			x.ParseAttr(attr))
+	}
+
+	since := HistoryStartTs()
+	for _, uid := range arg.q.UidList.GetUids() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		h, err := valueHistory(x.DataKey(attr, uid), arg.q.ReadTs, since, arg.srcFn.atype)
+		if err != nil {
+			return err
+		}
+		arg.out.HistoryMatrix = append(arg.out.HistoryMatrix, h)
+		// Add an empty UID list to make later processing consistent.
+		arg.out.UidMatrix = append(arg.out.UidMatrix, &pb.List{})
+	}
+	return nil
+}
+
+// valueHistory returns the values stored at key after each of its versions committed at or below
+// readTs, leaving out the versions that didn't change them. Of the versions at or below since,
+// only the newest one is read, as the older ones might have been discarded.
+func valueHistory(key []byte, readTs, since uint64, typ types.TypeID) (*pb.ValueHistory, error) {
+	versions, err := keyVersions(key, readTs, since)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &pb.ValueHistory{}
+	var last []*pb.TaskValue
+	for _, ts := range versions {
+		pl, err := posting.GetNoStore(key, ts)
+		if err != nil {
+			return nil, err
+		}
+		vals, err := pl.AllValues(ts)
+		if err != nil {
+			return nil, err
+		}
+		tvs := make([]*pb.TaskValue, 0, len(vals))
+		for _, val := range vals {
+			tv, err := convertToType(val, typ)
+			if err != nil {
+				return nil, err
+			}
+			tvs = append(tvs, tv)
+		}
+		// Rollups write the same values again at a newer version.
+		if sameValues(last, tvs) || (len(h.Versions) == 0 && len(tvs) == 0) {
+			continue
+		}
+		h.Versions = append(h.Versions, &pb.ValueVersion{CommitTs: ts, Values: tvs})
+		last = tvs
+	}
+	return h, nil
+}
+
+// keyVersions returns the versions of key committed at or below readTs in increasing order,
+// stopping at the first version at or below since.
+func keyVersions(key []byte, readTs, since uint64) ([]uint64, error) {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.AllVersions = true
+	iterOpts.PrefetchValues = false
+	itr := txn.NewKeyIterator(key, iterOpts)
+	defer itr.Close()
+
+	var versions []uint64
+	for itr.Seek(key); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		if item.IsDeletedOrExpired() {
+			break
+		}
+		versions = append(versions, item.Version())
+		if item.Version() <= since {
+			break
+		}
+	}
+	for i, j := 0, len(versions)-1; i < j; i, j = i+1, j-1 {
+		versions[i], versions[j] = versions[j], versions[i]
+	}
+	return versions, nil
+}
+
+func sameValues(a, b []*pb.TaskValue) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ValType != b[i].ValType || !bytes.Equal(a[i].Val, b[i].Val) {
+			return false
+		}
+	}
+	return true
+}
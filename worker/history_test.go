@@ -0,0 +1,124 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestHistoryWindow(t *testing.T) {
+	var h historyWindow
+	// take proposes and applies a snapshot, as the leader and the members of a group do.
+	take := func(readTs uint64, now time.Time, retention time.Duration) uint64 {
+		snapshots, startTs := h.next(readTs, now, retention)
+		return h.set(&pb.Snapshot{ReadTs: readTs, History: snapshots, HistoryStartTs: startTs})
+	}
+	start := time.Now()
+	require.Equal(t, uint64(10), take(10, start, 0))
+
+	h = historyWindow{}
+	require.Equal(t, uint64(0), take(10, start, time.Hour))
+	require.Equal(t, uint64(0), take(20, start.Add(30*time.Minute), time.Hour))
+	require.Equal(t, uint64(10), take(30, start.Add(61*time.Minute), time.Hour))
+	require.Equal(t, uint64(20), take(40, start.Add(2*time.Hour), time.Hour))
+	require.Len(t, h.snapshots, 2)
+
+	// The window is restored from the last snapshot after a restart.
+	snapshots, startTs := h.next(50, start.Add(2*time.Hour+time.Minute), time.Hour)
+	snap := &pb.Snapshot{ReadTs: 50, History: snapshots, HistoryStartTs: startTs}
+	h = historyWindow{}
+	require.Equal(t, uint64(30), h.set(snap))
+	require.Len(t, h.snapshots, 2)
+	require.Equal(t, uint64(50), take(60, start.Add(3*time.Hour+time.Minute), time.Hour))
+
+	// A snapshot taken without a retention window starts it at its timestamp.
+	require.Equal(t, uint64(70), h.set(&pb.Snapshot{ReadTs: 70}))
+}
+
+func TestCheckAsOf(t *testing.T) {
+	history.set(&pb.Snapshot{ReadTs: 20, History: []*pb.SnapshotTime{{ReadTs: 20}}, HistoryStartTs: 10})
+	defer history.set(&pb.Snapshot{})
+	require.NoError(t, checkAsOf(false, 5))
+	require.NoError(t, checkAsOf(true, 10))
+	require.ErrorContains(t, checkAsOf(true, 5), "older than the history retention window")
+}
+
+func TestValueHistory(t *testing.T) {
+	ps, err := badger.OpenManaged(badger.DefaultOptions(t.TempDir()).WithLogger(nil))
+	require.NoError(t, err)
+	defer ps.Close()
+	pstore = ps
+	posting.Init(ps, 0, false)
+	Init(ps)
+	require.NoError(t, schema.ParseBytes([]byte("historyName: string ."), 1))
+
+	attr := x.AttrInRootNamespace("historyName")
+	runM := func(startTs, commitTs uint64, op pb.DirectedEdge_Op, value string) {
+		txn := posting.Oracle().RegisterStartTs(startTs)
+		require.NoError(t, runMutation(context.Background(), &pb.DirectedEdge{
+			Value:     []byte(value),
+			ValueType: pb.Posting_STRING,
+			Attr:      attr,
+			Entity:    1,
+			Op:        op,
+		}, txn))
+		txn.Update()
+		writer := posting.NewTxnWriter(pstore)
+		require.NoError(t, txn.CommitToDisk(writer, commitTs))
+		require.NoError(t, writer.Flush())
+		txn.UpdateCachedKeys(commitTs)
+	}
+	runM(1, 2, pb.DirectedEdge_SET, "draft")
+	runM(3, 4, pb.DirectedEdge_SET, "approved")
+	key := x.DataKey(attr, 1)
+	rollup(t, key, ps, 4)
+	runM(6, 7, pb.DirectedEdge_DEL, x.Star)
+	runM(8, 9, pb.DirectedEdge_SET, "archived")
+
+	timeline := func(h *pb.ValueHistory) map[uint64][]string {
+		out := make(map[uint64][]string)
+		for _, v := range h.Versions {
+			out[v.CommitTs] = []string{}
+			for _, tv := range v.Values {
+				out[v.CommitTs] = append(out[v.CommitTs], string(tv.Val))
+			}
+		}
+		return out
+	}
+
+	h, err := valueHistory(key, 10, 0, types.StringID)
+	require.NoError(t, err)
+	require.Equal(t, map[uint64][]string{
+		2: {"draft"},
+		4: {"approved"},
+		7: {},
+		9: {"archived"},
+	}, timeline(h))
+
+	// The versions at or below the start of the history window start at its newest one.
+	h, err = valueHistory(key, 10, 5, types.StringID)
+	require.NoError(t, err)
+	require.Equal(t, map[uint64][]string{
+		5: {"approved"},
+		7: {},
+		9: {"archived"},
+	}, timeline(h))
+
+	h, err = valueHistory(key, 8, 0, types.StringID)
+	require.NoError(t, err)
+	require.Len(t, h.Versions, 3)
+}
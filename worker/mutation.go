@@ -130,7 +130,10 @@ func undoSchemaUpdate(predicate string) {
 	}
 }
 
-func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs uint64) error {
+// runSchemaMutation applies the schema updates. With rebuildVectors set, only the predicates of
+// the updates are used, and their vector indexes are rebuilt with their current schema.
+func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs uint64,
+	rebuildVectors bool) error {
 	if len(updates) == 0 {
 		return nil
 	}
@@ -219,7 +222,7 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 	for _, su := range updates {
 		if tablet, err := groups().Tablet(su.Predicate); err != nil {
 			return err
-		} else if tablet.GetGroupId() != groups().groupId() {
+		} else if tablet.GetGroupId() != groups().groupId() && !groups().servesRange(su.Predicate) {
 			return errors.Errorf("Tablet isn't being served by this group. Tablet: %+v", tablet)
 		}
 
@@ -228,6 +231,15 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 		}
 
 		old, ok := schema.State().Get(ctx, su.Predicate)
+		if rebuildVectors {
+			if !ok || len(old.IndexSpecs) == 0 {
+				return errors.Errorf("Predicate %s has no vector index", x.ParseAttr(su.Predicate))
+			}
+			// The current schema is applied again. Comparing it with the schema without its
+			// vector indexes makes all of them be dropped and built again.
+			su = proto.Clone(&old).(*pb.SchemaUpdate)
+			old.IndexSpecs = nil
+		}
 		rebuild := posting.IndexRebuild{
 			Attr:          su.Predicate,
 			StartTs:       startTs,
@@ -538,8 +550,8 @@ func ValidateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
 		return errors.Errorf("Input for predicate %q of type scalar is uid. Edge: %v",
 			x.ParseAttr(edge.Attr), edge)
 
-	case schemaType == types.TypeID(pb.Posting_VFLOAT):
-		if !(storageType == types.TypeID(pb.Posting_VFLOAT) || storageType == types.TypeID(pb.Posting_STRING) ||
+	case schemaType.IsVector():
+		if !(storageType.IsVector() || storageType == types.TypeID(pb.Posting_STRING) ||
 			storageType == types.TypeID(pb.Posting_DEFAULT)) {
 			return errors.Errorf("Input for predicate %q of type vector is not vector."+
 				" Did you forget to add quotes before []?. Edge: %v", x.ParseAttr(edge.Attr), edge)
@@ -626,6 +638,41 @@ func AssignUidsOverNetwork(ctx context.Context, num *pb.Num) (*pb.AssignedIds, e
 	return c.AssignIds(ctx, num)
 }
 
+// leaseUids leases n uids from Zero, and returns the first one of them.
+func leaseUids(ctx context.Context, n uint64) (uint64, error) {
+	ids, err := AssignUidsOverNetwork(ctx, &pb.Num{Val: n})
+	if err != nil {
+		return 0, err
+	}
+	return ids.StartId, nil
+}
+
+// leaseVectorChunks leases the chunk uids of the vectors set in indexed lists of vectors by the
+// edges, before they are proposed, so that every replica stores the chunks under the same uids.
+func leaseVectorChunks(ctx context.Context, edges []*pb.DirectedEdge) error {
+	var chunked []*pb.DirectedEdge
+	for _, edge := range edges {
+		if edge.Op != pb.DirectedEdge_SET || edge.VectorChunk != 0 {
+			continue
+		}
+		su, ok := schema.State().Get(ctx, edge.Attr)
+		if ok && su.List && types.TypeID(su.ValueType).IsVector() && len(su.IndexSpecs) > 0 {
+			chunked = append(chunked, edge)
+		}
+	}
+	if len(chunked) == 0 {
+		return nil
+	}
+	start, err := leaseUids(ctx, uint64(len(chunked)))
+	if err != nil {
+		return err
+	}
+	for i, edge := range chunked {
+		edge.VectorChunk = start + uint64(i)
+	}
+	return nil
+}
+
 // Timestamps sends a request to assign startTs for a new transaction to the current zero leader.
 func Timestamps(ctx context.Context, num *pb.Num) (*pb.AssignedIds, error) {
 	pl := groups().connToZeroLeader()
@@ -638,9 +685,12 @@ func Timestamps(ctx context.Context, num *pb.Num) (*pb.AssignedIds, error) {
 	return c.Timestamps(ctx, num)
 }
 
-func fillTxnContext(tctx *api.TxnContext, startTs uint64, isErrored bool) {
+func fillTxnContext(tctx *api.TxnContext, startTs uint64, serializable, isErrored bool) {
 	if txn := posting.Oracle().GetTxn(startTs); txn != nil {
 		txn.FillContext(tctx, groups().groupId(), isErrored)
+		if serializable {
+			txn.FillWriteKeys(tctx)
+		}
 	}
 	// We do not need to fill linread mechanism anymore, because transaction
 	// start ts is sufficient to wait for, to achieve lin reads.
@@ -690,7 +740,7 @@ func proposeOrSend(ctx context.Context, gid uint32, m *pb.Mutations, chr chan re
 func populateMutationMap(src *pb.Mutations) (map[uint32]*pb.Mutations, error) {
 	mm := make(map[uint32]*pb.Mutations)
 	for _, edge := range src.Edges {
-		gid, err := groups().BelongsTo(edge.Attr)
+		gid, err := groups().BelongsToUid(edge.Attr, edge.Entity)
 		if err != nil {
 			return nil, err
 		}
@@ -702,6 +752,7 @@ func populateMutationMap(src *pb.Mutations) (map[uint32]*pb.Mutations, error) {
 		}
 		mu.Edges = append(mu.Edges, edge)
 		mu.Metadata = src.Metadata
+		mu.Serializable = src.Serializable
 	}
 
 	for _, schema := range src.Schema {
@@ -710,12 +761,20 @@ func populateMutationMap(src *pb.Mutations) (map[uint32]*pb.Mutations, error) {
 			return nil, err
 		}
 
-		mu := mm[gid]
-		if mu == nil {
-			mu = &pb.Mutations{GroupId: gid}
-			mm[gid] = mu
+		// The schema of a predicate split across groups is applied on all of them.
+		gids := []uint32{gid}
+		if ranges := groups().rangesOf(schema.Predicate); len(ranges) > 0 {
+			gids = rangeGroups(ranges)
+		}
+		for _, gid := range gids {
+			mu := mm[gid]
+			if mu == nil {
+				mu = &pb.Mutations{GroupId: gid}
+				mm[gid] = mu
+			}
+			mu.Schema = append(mu.Schema, schema)
+			mu.RebuildVectorIndex = src.RebuildVectorIndex
 		}
-		mu.Schema = append(mu.Schema, schema)
 	}
 
 	if src.DropOp > 0 {
@@ -945,11 +1004,14 @@ func (w *grpcWorker) proposeAndWait(ctx context.Context, txnCtx *api.TxnContext,
 	if err := posting.Oracle().WaitForTs(ctx, m.StartTs); err != nil {
 		return err
 	}
+	if err := leaseVectorChunks(ctx, m.Edges); err != nil {
+		return err
+	}
 
 	node := groups().Node
 	err := node.proposeAndWait(ctx, &pb.Proposal{Mutations: m})
 	// When we are filling txn context, we don't need to update latest delta if the transaction has failed.
-	fillTxnContext(txnCtx, m.StartTs, err != nil)
+	fillTxnContext(txnCtx, m.StartTs, m.Serializable, err != nil)
 	return err
 }
 
@@ -0,0 +1,215 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xitongsys/parquet-go/common"
+	"github.com/xitongsys/parquet-go/marshal"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// The parquet files of the export are written with parquet-go. The rows are handed to it as JSON
+// objects keyed by column name, which its JSON marshaller turns into the columns of the schema.
+
+// parquetNone leaves the physical type of a column as is, without a converted type.
+const parquetNone parquet.ConvertedType = -1
+
+// parquetRowGroupSize is the size of the buffered values after which a row group is written.
+const parquetRowGroupSize = 8 << 20
+
+// parquetColumn describes a column of a parquet file. Required columns can't be lists and must
+// have a value in every row. List columns are written with the 3-level LIST structure of
+// parquet, with required elements.
+type parquetColumn struct {
+	name      string
+	typ       parquet.Type
+	converted parquet.ConvertedType
+	required  bool
+	list      bool
+}
+
+// schemaElements returns the elements of the column in the schema of the file.
+func (c parquetColumn) schemaElements() []*parquet.SchemaElement {
+	leaf := &parquet.SchemaElement{
+		Name:           c.name,
+		Type:           parquet.TypePtr(c.typ),
+		RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_OPTIONAL),
+	}
+	if c.converted != parquetNone {
+		leaf.ConvertedType = parquet.ConvertedTypePtr(c.converted)
+	}
+	if c.required {
+		leaf.RepetitionType = parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED)
+	}
+	if !c.list {
+		return []*parquet.SchemaElement{leaf}
+	}
+	one := int32(1)
+	leaf.Name = "element"
+	leaf.RepetitionType = parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED)
+	return []*parquet.SchemaElement{
+		{
+			Name:           c.name,
+			RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_OPTIONAL),
+			ConvertedType:  parquet.ConvertedTypePtr(parquet.ConvertedType_LIST),
+			NumChildren:    &one,
+		},
+		{
+			Name:           "list",
+			RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REPEATED),
+			NumChildren:    &one,
+		},
+		leaf,
+	}
+}
+
+// check returns an error if vals can't be the values of the column in a row.
+func (c parquetColumn) check(vals []interface{}) error {
+	if c.required && len(vals) != 1 {
+		return errors.Errorf("column %s requires exactly one value, got %d", c.name, len(vals))
+	}
+	if !c.list && len(vals) > 1 {
+		return errors.Errorf("column %s can't have more than one value, got %d", c.name, len(vals))
+	}
+	for _, v := range vals {
+		var ok bool
+		switch c.typ {
+		case parquet.Type_BOOLEAN:
+			_, ok = v.(bool)
+		case parquet.Type_INT64:
+			_, ok = v.(int64)
+		case parquet.Type_DOUBLE:
+			_, ok = v.(float64)
+		case parquet.Type_BYTE_ARRAY:
+			_, ok = v.(string)
+		default:
+			return errors.Errorf("unsupported parquet type %s for column %s", c.typ, c.name)
+		}
+		if !ok {
+			return errors.Errorf("column %s of type %s can't hold a %T", c.name, c.typ, v)
+		}
+	}
+	return nil
+}
+
+// parquetSink is a write only source.ParquetFile over an io.Writer, as the parquet writer only
+// appends to the file.
+type parquetSink struct {
+	io.Writer
+}
+
+func (s parquetSink) Read([]byte) (int, error) {
+	return 0, errors.New("parquet export file is write only")
+}
+
+func (s parquetSink) Seek(int64, int) (int64, error) {
+	return 0, errors.New("parquet export file is write only")
+}
+
+func (s parquetSink) Close() error { return nil }
+
+func (s parquetSink) Open(string) (source.ParquetFile, error) {
+	return nil, errors.New("parquet export file is write only")
+}
+
+func (s parquetSink) Create(string) (source.ParquetFile, error) {
+	return nil, errors.New("parquet export file is write only")
+}
+
+// parquetWriter writes rows into a parquet file. Rows are buffered in memory and written as a
+// row group once they are big enough. Close must be called to write the file metadata.
+type parquetWriter struct {
+	pw   *writer.ParquetWriter
+	cols []parquetColumn
+}
+
+func newParquetWriter(w io.Writer, name string, cols []parquetColumn) (*parquetWriter, error) {
+	numCols := int32(len(cols))
+	elements := []*parquet.SchemaElement{{
+		Name:           name,
+		RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED),
+		NumChildren:    &numCols,
+	}}
+	// parquet-go matches the columns by a name made of their letters and digits only, so the
+	// names must stay unique once converted.
+	names := make(map[string]string, len(cols))
+	for _, col := range cols {
+		if col.required && col.list {
+			return nil, errors.Errorf("list column %s can't be required", col.name)
+		}
+		inName := common.StringToVariableName(col.name)
+		if other, ok := names[inName]; ok {
+			return nil, errors.Errorf("columns %s and %s can't be told apart in a parquet file",
+				other, col.name)
+		}
+		names[inName] = col.name
+		elements = append(elements, col.schemaElements()...)
+	}
+
+	pw, err := writer.NewParquetWriter(parquetSink{Writer: w}, elements, 1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while creating parquet writer for %s", name)
+	}
+	pw.RowGroupSize = parquetRowGroupSize
+	pw.CompressionType = parquet.CompressionCodec_GZIP
+	pw.MarshalFunc = marshal.MarshalJSON
+	return &parquetWriter{pw: pw, cols: cols}, nil
+}
+
+// WriteRow adds a row with the values of every column. An empty slice stands for a null value.
+// The row is checked against the columns before it is buffered, so that an invalid row doesn't
+// fail the writes of the valid ones buffered with it.
+func (pw *parquetWriter) WriteRow(row [][]interface{}) error {
+	if len(row) != len(pw.cols) {
+		return errors.Errorf("expected %d columns in row, got %d", len(pw.cols), len(row))
+	}
+	obj := make(map[string]interface{}, len(row))
+	for i, c := range pw.cols {
+		vals := row[i]
+		if err := c.check(vals); err != nil {
+			return err
+		}
+		switch {
+		case len(vals) == 0:
+		case c.list:
+			elems := make([]interface{}, len(vals))
+			for j, v := range vals {
+				elems[j] = parquetJSONValue(v)
+			}
+			obj[c.name] = elems
+		default:
+			obj[c.name] = parquetJSONValue(vals[0])
+		}
+	}
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return errors.Wrapf(err, "while encoding parquet row")
+	}
+	return pw.pw.Write(string(b))
+}
+
+// parquetJSONValue returns v as it is encoded in the JSON rows. JSON has no infinite or NaN
+// numbers, so those are written as the strings +Inf, -Inf and NaN, which parquet-go parses back
+// into the same doubles.
+func parquetJSONValue(v interface{}) interface{} {
+	if f, ok := v.(float64); ok && (math.IsInf(f, 0) || math.IsNaN(f)) {
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	return v
+}
+
+// Close writes the buffered rows and the file metadata. It doesn't close the underlying writer.
+func (pw *parquetWriter) Close() error {
+	return pw.pw.WriteStop()
+}
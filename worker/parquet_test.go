@@ -0,0 +1,192 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+)
+
+// bytesFile is a read only source.ParquetFile over the content of a parquet file, so that the
+// files written by parquetWriter can be read back with the reader of parquet-go.
+type bytesFile struct {
+	*bytes.Reader
+	data []byte
+}
+
+func newBytesFile(data []byte) *bytesFile {
+	return &bytesFile{Reader: bytes.NewReader(data), data: data}
+}
+
+func (f *bytesFile) Write([]byte) (int, error) { return 0, errors.New("read only file") }
+func (f *bytesFile) Close() error              { return nil }
+func (f *bytesFile) Open(string) (source.ParquetFile, error) {
+	return newBytesFile(f.data), nil
+}
+func (f *bytesFile) Create(string) (source.ParquetFile, error) {
+	return nil, errors.New("read only file")
+}
+
+// parquetColumnData has the values and the levels read from a column of a parquet file.
+type parquetColumnData struct {
+	values []interface{}
+	reps   []int32
+	defs   []int32
+}
+
+// readParquetFile returns the number of rows, the names of the schema elements and the content of
+// the columns of a parquet file.
+func readParquetFile(t *testing.T, data []byte) (int64, []string, []parquetColumnData) {
+	pr, err := reader.NewParquetColumnReader(newBytesFile(data), 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+
+	var names []string
+	for _, info := range pr.SchemaHandler.Infos {
+		names = append(names, info.ExName)
+	}
+	var cols []parquetColumnData
+	for i := range pr.SchemaHandler.ValueColumns {
+		values, reps, defs, err := pr.ReadColumnByIndex(int64(i), pr.GetNumRows()*4)
+		require.NoError(t, err)
+		cols = append(cols, parquetColumnData{values, reps, defs})
+	}
+	return pr.GetNumRows(), names, cols
+}
+
+func TestParquetWriter(t *testing.T) {
+	var buf bytes.Buffer
+	pw, err := newParquetWriter(&buf, "Person", []parquetColumn{
+		{name: "uid", typ: parquet.Type_BYTE_ARRAY, converted: parquet.ConvertedType_UTF8,
+			required: true},
+		{name: "age", typ: parquet.Type_INT64, converted: parquetNone},
+		{name: "born", typ: parquet.Type_INT64, converted: parquet.ConvertedType_TIMESTAMP_MICROS},
+		{name: "score", typ: parquet.Type_DOUBLE, converted: parquetNone},
+		{name: "alive", typ: parquet.Type_BOOLEAN, converted: parquetNone},
+		{name: "friend", typ: parquet.Type_BYTE_ARRAY, converted: parquet.ConvertedType_UTF8,
+			list: true},
+	})
+	require.NoError(t, err)
+	require.NoError(t, pw.WriteRow([][]interface{}{
+		{"0x1"}, {int64(33)}, {int64(-5)}, {1.5}, {true}, {"0x2", "0x3"}}))
+	require.NoError(t, pw.WriteRow([][]interface{}{
+		{"0x2"}, nil, nil, {-2.0}, {false}, nil}))
+	require.NoError(t, pw.WriteRow([][]interface{}{
+		{"0x3"}, {int64(1) << 60}, nil, nil, {true}, {"0x1"}}))
+	require.NoError(t, pw.Close())
+
+	pr, err := reader.NewParquetColumnReader(newBytesFile(buf.Bytes()), 1)
+	require.NoError(t, err)
+	converted := make(map[string]parquet.ConvertedType)
+	for i, el := range pr.SchemaHandler.SchemaElements {
+		if el.ConvertedType != nil {
+			converted[pr.SchemaHandler.Infos[i].ExName] = *el.ConvertedType
+		}
+	}
+	pr.ReadStop()
+	require.Equal(t, map[string]parquet.ConvertedType{
+		"uid":     parquet.ConvertedType_UTF8,
+		"born":    parquet.ConvertedType_TIMESTAMP_MICROS,
+		"friend":  parquet.ConvertedType_LIST,
+		"element": parquet.ConvertedType_UTF8,
+	}, converted)
+
+	rows, names, cols := readParquetFile(t, buf.Bytes())
+	require.Equal(t, int64(3), rows)
+	require.Equal(t, []string{"Person", "uid", "age", "born", "score", "alive", "friend", "list",
+		"element"}, names)
+	require.Equal(t, []parquetColumnData{
+		{[]interface{}{"0x1", "0x2", "0x3"}, []int32{0, 0, 0}, []int32{0, 0, 0}},
+		{[]interface{}{int64(33), nil, int64(1) << 60}, []int32{0, 0, 0}, []int32{1, 0, 1}},
+		{[]interface{}{int64(-5), nil, nil}, []int32{0, 0, 0}, []int32{1, 0, 0}},
+		{[]interface{}{1.5, -2.0, nil}, []int32{0, 0, 0}, []int32{1, 1, 0}},
+		{[]interface{}{true, false, true}, []int32{0, 0, 0}, []int32{1, 1, 1}},
+		{[]interface{}{"0x2", "0x3", nil, "0x1"}, []int32{0, 1, 0, 0}, []int32{2, 2, 0, 2}},
+	}, cols)
+}
+
+func TestParquetWriterColumnNames(t *testing.T) {
+	// The names of the predicates are kept as they are.
+	var buf bytes.Buffer
+	pw, err := newParquetWriter(&buf, "dgraph.untyped", []parquetColumn{
+		{name: "uid", typ: parquet.Type_BYTE_ARRAY, converted: parquet.ConvertedType_UTF8,
+			required: true},
+		{name: "dgraph.type", typ: parquet.Type_BYTE_ARRAY, converted: parquet.ConvertedType_UTF8,
+			list: true},
+		{name: "name@fr", typ: parquet.Type_BYTE_ARRAY, converted: parquet.ConvertedType_UTF8},
+		{name: "prénom", typ: parquet.Type_BYTE_ARRAY, converted: parquet.ConvertedType_UTF8},
+	})
+	require.NoError(t, err)
+	require.NoError(t, pw.WriteRow([][]interface{}{{"0x1"}, {"Person"}, {"Zoé"}, {"Zoë"}}))
+	require.NoError(t, pw.Close())
+
+	_, names, cols := readParquetFile(t, buf.Bytes())
+	require.Equal(t, []string{"dgraph.untyped", "uid", "dgraph.type", "list", "element",
+		"name@fr", "prénom"}, names)
+	require.Equal(t, []interface{}{"Zoé"}, cols[2].values)
+	require.Equal(t, []interface{}{"Zoë"}, cols[3].values)
+
+	// parquet-go tells the columns apart by their letters and digits, with the other characters
+	// replaced by their code.
+	_, err = newParquetWriter(&buf, "T", []parquetColumn{
+		{name: "a.b", typ: parquet.Type_INT64, converted: parquetNone},
+		{name: "a46b", typ: parquet.Type_INT64, converted: parquetNone},
+	})
+	require.ErrorContains(t, err, "columns a.b and a46b can't be told apart")
+}
+
+func TestParquetWriterNonFinite(t *testing.T) {
+	var buf bytes.Buffer
+	pw, err := newParquetWriter(&buf, "T", []parquetColumn{
+		{name: "score", typ: parquet.Type_DOUBLE, converted: parquetNone},
+		{name: "scores", typ: parquet.Type_DOUBLE, converted: parquetNone, list: true},
+	})
+	require.NoError(t, err)
+	require.NoError(t, pw.WriteRow([][]interface{}{{math.Inf(1)}, {math.Inf(-1), 2.5}}))
+	require.NoError(t, pw.WriteRow([][]interface{}{{math.NaN()}, nil}))
+	require.NoError(t, pw.Close())
+
+	rows, _, cols := readParquetFile(t, buf.Bytes())
+	require.Equal(t, int64(2), rows)
+	require.Equal(t, []interface{}{math.Inf(1)}, cols[0].values[:1])
+	require.True(t, math.IsNaN(cols[0].values[1].(float64)))
+	require.Equal(t, []interface{}{math.Inf(-1), 2.5, nil}, cols[1].values)
+}
+
+func TestParquetWriterInvalidRow(t *testing.T) {
+	var buf bytes.Buffer
+	pw, err := newParquetWriter(&buf, "T", []parquetColumn{
+		{name: "uid", typ: parquet.Type_BYTE_ARRAY, converted: parquet.ConvertedType_UTF8,
+			required: true},
+		{name: "age", typ: parquet.Type_INT64, converted: parquetNone},
+	})
+	require.NoError(t, err)
+	require.Error(t, pw.WriteRow([][]interface{}{nil, {int64(1)}}))
+	require.Error(t, pw.WriteRow([][]interface{}{{"0x1"}, {int64(1), int64(2)}}))
+	require.Error(t, pw.WriteRow([][]interface{}{{"0x1"}, {"1"}}))
+	require.Error(t, pw.WriteRow([][]interface{}{{"0x1"}}))
+	require.NoError(t, pw.WriteRow([][]interface{}{{"0x2"}, {int64(2)}}))
+	require.NoError(t, pw.Close())
+
+	// The invalid rows must not have left anything behind.
+	rows, _, cols := readParquetFile(t, buf.Bytes())
+	require.Equal(t, int64(1), rows)
+	require.Equal(t, []parquetColumnData{
+		{[]interface{}{"0x2"}, []int32{0}, []int32{0}},
+		{[]interface{}{int64(2)}, []int32{0}, []int32{1}},
+	}, cols)
+
+	_, err = newParquetWriter(&buf, "T", []parquetColumn{
+		{name: "friend", typ: parquet.Type_BYTE_ARRAY, required: true, list: true}})
+	require.ErrorContains(t, err, "list column friend can't be required")
+}
@@ -0,0 +1,210 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+)
+
+// EstimateCost returns the estimated number of uids that the function of the given query would
+// match. The estimate is derived from the predicate statistics that this Alpha collects in
+// posting.StatsHolder while reading index posting lists, so it is only available for
+// predicates whose index has been read here before. The second return value is false when no
+// estimate could be made, callers should then keep the order in which the query was written.
+func EstimateCost(ctx context.Context, q *pb.Query) (uint64, bool) {
+	if q == nil || q.SrcFunc == nil || q.SrcFunc.IsCount || q.Reverse {
+		return 0, false
+	}
+	if !schema.State().IsIndexed(ctx, q.Attr) {
+		return 0, false
+	}
+
+	fnType, f := parseFuncType(q.SrcFunc)
+	switch fnType {
+	case compareAttrFn:
+		if f == eq {
+			return estimateEq(ctx, q)
+		}
+		return estimateIneq(ctx, q, f)
+	case standardFn, fullTextSearchFn:
+		tokens, err := getStringTokens(q.SrcFunc.Args, langForFunc(q.Langs), fnType)
+		if err != nil || len(tokens) == 0 {
+			return 0, false
+		}
+		return estimateTokens(q.Attr, tokens, needsIntersect(f))
+	}
+	return 0, false
+}
+
+// estimateTokens sums up the estimates of the given index tokens. If intersect is set, the
+// function needs all the tokens to match, so the smallest estimate is returned instead.
+func estimateTokens(attr string, tokens []string, intersect bool) (uint64, bool) {
+	var est uint64
+	if intersect {
+		est = math.MaxUint64
+	}
+	for _, token := range tokens {
+		count := posting.GetStatsHolder().ProcessEqPredicate(attr, []byte(token))
+		if count == math.MaxUint64 || count == 0 {
+			// Either we don't know anything about the predicate or we have never read this
+			// token. Treat both as unknown rather than guessing.
+			return 0, false
+		}
+		switch {
+		case !intersect:
+			est += count
+		case count < est:
+			est = count
+		}
+	}
+	return est, true
+}
+
+func estimateEq(ctx context.Context, q *pb.Query) (uint64, bool) {
+	tokenizer, err := pickTokenizer(ctx, q.Attr, eq)
+	if err != nil {
+		return 0, false
+	}
+	tokenizer = tok.GetTokenizerForLang(tokenizer, langForFunc(q.Langs))
+
+	var tokens []string
+	for _, arg := range q.SrcFunc.Args {
+		val, err := convertValue(q.Attr, arg)
+		if err != nil {
+			return 0, false
+		}
+		toks, err := tok.BuildTokens(val.Value, tokenizer)
+		if err != nil || len(toks) == 0 {
+			return 0, false
+		}
+		tokens = append(tokens, toks...)
+	}
+	// A single eq argument with a term or fulltext tokenizer needs all its tokens to match.
+	intersect := len(q.SrcFunc.Args) == 1 && (tokenizer.Identifier() == tok.IdentTerm ||
+		tokenizer.Identifier() == tok.IdentFullText)
+	return estimateTokens(q.Attr, tokens, intersect)
+}
+
+func estimateIneq(ctx context.Context, q *pb.Query, f string) (uint64, bool) {
+	tokenizer, err := pickTokenizer(ctx, q.Attr, f)
+	if err != nil || !tokenizer.IsSortable() {
+		return 0, false
+	}
+	tokenizer = tok.GetTokenizerForLang(tokenizer, langForFunc(q.Langs))
+
+	var bounds [][]byte
+	for _, arg := range q.SrcFunc.Args {
+		val, err := convertValue(q.Attr, arg)
+		if err != nil {
+			return 0, false
+		}
+		toks, err := tok.BuildTokens(val.Value, tokenizer)
+		if err != nil || len(toks) != 1 {
+			return 0, false
+		}
+		bounds = append(bounds, []byte(toks[0]))
+	}
+
+	var lower, upper []byte
+	switch {
+	case f == between && len(bounds) == 2:
+		lower, upper = bounds[0], bounds[1]
+	case (f == "ge" || f == "gt") && len(bounds) == 1:
+		lower = bounds[0]
+	case (f == "le" || f == "lt") && len(bounds) == 1:
+		upper = bounds[0]
+	default:
+		return 0, false
+	}
+	return posting.GetStatsHolder().EstimateRange(q.Attr, tokenizer.Identifier(), lower, upper)
+}
+
+// uidListIsCheaper tells whether it is cheaper to fetch the values of the uids in uidlist
+// and compare them, rather than reading the index posting lists for a function that is
+// estimated to match est uids.
+func uidListIsCheaper(uidlist []uint64, est uint64) bool {
+	if Config.TypeFilterUidLimit == 0 {
+		return false
+	}
+	return uint64(len(uidlist)) < est/Config.TypeFilterUidLimit
+}
+
+// planForIneqFilter tells whether an inequality filter over q.UidList should compare the values
+// of the uids directly instead of reading the index posting lists of all the tokens in range.
+func planForIneqFilter(ctx context.Context, q *pb.Query) bool {
+	if q.UidList == nil || checkUidZero(q.UidList.Uids) {
+		return false
+	}
+	_, f := parseFuncType(q.SrcFunc)
+	est, ok := estimateIneq(ctx, q, f)
+	return ok && uidListIsCheaper(q.UidList.Uids, est)
+}
+
+const (
+	// AccessIndex means that the function reads the index posting lists of its tokens.
+	AccessIndex = "index"
+	// AccessUidList means that the values or edges of the given uids are read and compared.
+	AccessUidList = "uid_list"
+	// AccessScan means that all the data keys of the predicate are iterated.
+	AccessScan = "scan"
+)
+
+// accessPath returns how processTask evaluates the function in q once parseSrcFn has planned it
+// into fc, that is one of AccessIndex, AccessUidList or AccessScan. It is returned in the result
+// of the task, so that explain reports the path the serving worker took.
+func accessPath(q *pb.Query, fc *functionContext) string {
+	if q.UidList == nil && needsIndex(fc.fnType, nil) {
+		return AccessIndex
+	}
+	switch fc.fnType {
+	case compareScalarFn:
+		if q.UidList == nil {
+			// gt(count(pred), N) at root reads the count index.
+			return AccessIndex
+		}
+		return AccessUidList
+	case regexFn:
+		if q.UidList == nil {
+			return AccessIndex
+		}
+		return AccessUidList
+	case hasFn:
+		if q.UidList == nil {
+			return AccessScan
+		}
+		return AccessUidList
+	case notAFunction, aggregatorFn, passwordFn, uidInFn:
+		return AccessUidList
+	}
+
+	switch {
+	case len(fc.tokens) > 0:
+		return AccessIndex
+	case q.UidList != nil:
+		return AccessUidList
+	}
+	return AccessScan
+}
+
+// MergeAccess merges the access paths of the results of the same task run on several groups.
+// The groups plan the task over the data they serve, so they might not take the same path.
+func MergeAccess(a, b string) string {
+	switch {
+	case a == "" || a == b:
+		return b
+	case b == "" || slices.Contains(strings.Split(a, ","), b):
+		return a
+	}
+	return a + "," + b
+}
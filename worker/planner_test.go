@@ -0,0 +1,123 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// recordTokens records in the predicate statistics that the index key of each value of attr,
+// built with the tokenizer name, holds count uids.
+func recordTokens(t *testing.T, attr, name string, count uint64, vals ...interface{}) {
+	tokenizer, ok := tok.GetTokenizer(name)
+	require.True(t, ok)
+	for _, val := range vals {
+		tokens, err := tok.BuildTokens(val, tokenizer)
+		require.NoError(t, err)
+		for _, token := range tokens {
+			posting.GetStatsHolder().InsertRecord(attr, []byte(token), count)
+		}
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	ps, err := badger.OpenManaged(badger.DefaultOptions(t.TempDir()).WithLogger(nil))
+	require.NoError(t, err)
+	defer ps.Close()
+	posting.Init(ps, 0, false)
+	planner := x.Config.QueryPlanner
+	x.Config.QueryPlanner = true
+	defer func() { x.Config.QueryPlanner = planner }()
+	require.NoError(t, schema.ParseBytes([]byte(`
+		planName: string @index(exact) .
+		planBio: string @index(term) .
+		planAge: int @index(int) .
+		planNick: string .`), 1))
+	ctx := x.AttachNamespace(context.Background(), x.RootNamespace)
+	name := x.AttrInRootNamespace("planName")
+	bio := x.AttrInRootNamespace("planBio")
+	age := x.AttrInRootNamespace("planAge")
+	recordTokens(t, name, "exact", 10, "alice")
+	recordTokens(t, name, "exact", 3, "bob")
+	recordTokens(t, bio, "term", 20, "graph")
+	recordTokens(t, bio, "term", 5, "rare")
+	for a := int64(0); a < 100; a++ {
+		recordTokens(t, age, "int", 2, a)
+	}
+
+	query := func(attr, fn string, args ...string) *pb.Query {
+		return &pb.Query{Attr: attr, ReadTs: 1, SrcFunc: &pb.SrcFunction{Name: fn, Args: args}}
+	}
+	tests := []struct {
+		q   *pb.Query
+		est uint64
+		ok  bool
+	}{
+		{q: query(name, "eq", "alice"), est: 10, ok: true},
+		// The uids of the values add up.
+		{q: query(name, "eq", "alice", "bob"), est: 13, ok: true},
+		// Nothing is known about the token, the order of the query is kept.
+		{q: query(name, "eq", "carol")},
+		{q: query(bio, "anyofterms", "graph rare"), est: 25, ok: true},
+		// All the terms must match, no more than the rarest one do.
+		{q: query(bio, "allofterms", "graph rare"), est: 5, ok: true},
+		{q: query(age, "ge", "50"), est: 100, ok: true},
+		{q: query(age, "between", "10", "19"), est: 20, ok: true},
+		// Without an index, there are no statistics.
+		{q: query(x.AttrInRootNamespace("planNick"), "eq", "al")},
+		{q: &pb.Query{Attr: name, ReadTs: 1, SrcFunc: &pb.SrcFunction{Name: "eq",
+			Args: []string{"alice"}}, Reverse: true}},
+	}
+	for _, tc := range tests {
+		est, ok := EstimateCost(ctx, tc.q)
+		require.Equal(t, tc.ok, ok, "%s%v", tc.q.SrcFunc.Name, tc.q.SrcFunc.Args)
+		if tc.ok {
+			require.InDelta(t, tc.est, est, float64(tc.est)/10, "%s%v", tc.q.SrcFunc.Name,
+				tc.q.SrcFunc.Args)
+		}
+	}
+}
+
+func TestAccessPath(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		planName: string @index(exact) .
+		planNick: string .`), 1))
+	ctx := x.AttachNamespace(context.Background(), x.RootNamespace)
+	name := x.AttrInRootNamespace("planName")
+	uids := &pb.List{Uids: []uint64{1, 2}}
+
+	access := func(q *pb.Query) string {
+		fc, err := parseSrcFn(ctx, q)
+		require.NoError(t, err)
+		return accessPath(q, fc)
+	}
+	require.Equal(t, AccessIndex, access(&pb.Query{Attr: name, ReadTs: 1,
+		SrcFunc: &pb.SrcFunction{Name: "eq", Args: []string{"alice"}}}))
+	require.Equal(t, AccessScan, access(&pb.Query{Attr: name, ReadTs: 1,
+		SrcFunc: &pb.SrcFunction{Name: "has"}}))
+	require.Equal(t, AccessUidList, access(&pb.Query{Attr: name, ReadTs: 1,
+		SrcFunc: &pb.SrcFunction{Name: "has"}, UidList: uids}))
+	require.Equal(t, AccessUidList, access(&pb.Query{Attr: name, ReadTs: 1,
+		UidList: uids}))
+}
+
+func TestMergeAccess(t *testing.T) {
+	require.Equal(t, AccessIndex, MergeAccess("", AccessIndex))
+	require.Equal(t, AccessIndex, MergeAccess(AccessIndex, AccessIndex))
+	require.Equal(t, AccessIndex, MergeAccess(AccessIndex, ""))
+	require.Equal(t, "index,uid_list", MergeAccess(AccessIndex, AccessUidList))
+	require.Equal(t, "index,uid_list", MergeAccess("index,uid_list", AccessIndex))
+}
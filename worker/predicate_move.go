@@ -82,12 +82,15 @@ func batchAndProposeKeyValues(ctx context.Context, kvs chan *pb.KVS) error {
 				}
 
 				// Delete on all nodes. Remove the schema at timestamp kv.Version-1 and set it at
-				// kv.Version. kv.Version will be the TxnTs of the predicate move.
-				p := &pb.Proposal{CleanPredicate: pk.Attr, StartTs: kv.Version - 1}
+				// kv.Version. kv.Version will be the TxnTs of the predicate move. The data of a
+				// predicate split across groups is kept if a range of it is served here already.
 				glog.Infof("Predicate being received: %v", pk.Attr)
-				if err := n.proposeAndWait(ctx, p); err != nil {
-					glog.Errorf("Error while cleaning predicate %v %v\n", pk.Attr, err)
-					return err
+				if !groups().servesRange(pk.Attr) {
+					p := &pb.Proposal{CleanPredicate: pk.Attr, StartTs: kv.Version - 1}
+					if err := n.proposeAndWait(ctx, p); err != nil {
+						glog.Errorf("Error while cleaning predicate %v %v\n", pk.Attr, err)
+						return err
+					}
 				}
 			}
 
@@ -209,6 +212,18 @@ func (w *grpcWorker) MovePredicate(ctx context.Context,
 		return &emptyPayload, errEmptyPredicate
 	}
 
+	if in.DestGid == 0 && in.UidRange {
+		glog.Infof("Was instructed to clean up the ranges of tablet: %v", in.Predicate)
+		// Like the deletion of a tablet below, this waits for the membership state with the new
+		// ranges of the predicate.
+		p := &pb.Proposal{
+			CleanRange:       in.Predicate,
+			ExpectedChecksum: in.ExpectedChecksum,
+			StartTs:          in.TxnTs,
+		}
+		return &emptyPayload, groups().Node.proposeAndWait(ctx, p)
+	}
+
 	//TODO: need to find possibly a better way to not move __vector_ predicates
 	if in.DestGid == 0 && !strings.Contains(in.Predicate, hnsw.VecKeyword) {
 		glog.Infof("Was instructed to delete tablet: %v", in.Predicate)
@@ -234,6 +249,9 @@ func (w *grpcWorker) MovePredicate(ctx context.Context,
 	}
 
 	gid, err := groups().BelongsTo(in.Predicate)
+	if in.UidRange {
+		gid, err = groups().BelongsToUid(in.Predicate, in.StartUid)
+	}
 	switch {
 	case err != nil:
 		return &emptyPayload, err
@@ -320,6 +338,15 @@ func movePredicateHelper(ctx context.Context, in *pb.MovePredicatePayload) error
 	stream := pstore.NewStreamAt(in.TxnTs)
 	stream.LogPrefix = fmt.Sprintf("Sending predicate: [%s]", in.Predicate)
 	stream.Prefix = x.PredicatePrefix(in.Predicate)
+	if in.UidRange {
+		// Only the data of the range is sent. The receiver builds the indexes from it.
+		stream.Prefix = x.ParsedKey{Attr: in.Predicate}.DataPrefix()
+		stream.ChooseKey = func(item *badger.Item) bool {
+			pk, err := x.Parse(item.Key())
+			return err == nil && !pk.HasStartUid && pk.Uid >= in.StartUid &&
+				(in.EndUid == 0 || pk.Uid < in.EndUid)
+		}
+	}
 	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
 		// For now, just send out full posting lists, because we use delete markers to delete older
 		// data in the prefix range. So, by sending only one version per key, and writing it at a
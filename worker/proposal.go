@@ -153,7 +153,7 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 			return err
 		case tablet == nil || tablet.GroupId == 0:
 			return errNonExistentTablet
-		case tablet.GroupId != groups().groupId():
+		case tablet.GroupId != groups().groupId() && !groups().servesRange(pred):
 			return errUnservedTablet
 		default:
 			return nil
@@ -169,6 +169,11 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 			if err := checkTablet(edge.Attr); err != nil {
 				return err
 			}
+			if gid, err := groups().BelongsToUid(edge.Attr, edge.Entity); err != nil {
+				return err
+			} else if gid != groups().groupId() {
+				return errUnservedTablet
+			}
 			su, ok := schema.State().Get(ctx, edge.Attr)
 			if !ok {
 				// We don't allow mutations for reserved predicates if the schema for them doesn't
@@ -46,7 +46,7 @@ func getSchema(ctx context.Context, s *pb.SchemaRequest) (*pb.SchemaResult, erro
 		fields = s.Fields
 	} else {
 		fields = []string{"type", "index", "tokenizer", "reverse", "count", "list", "upsert", "unique",
-			"lang", "noconflict", "vector_specs"}
+			"lang", "noconflict", "vector_specs", "embedding_source"}
 	}
 
 	myGid := groups().groupId()
@@ -110,6 +110,8 @@ func populateSchema(attr string, fields []string) *pb.SchemaNode {
 			schemaNode.NoConflict = pred.GetNoConflict()
 		case "vector_specs":
 			schemaNode.IndexSpecs = pred.GetIndexSpecs()
+		case "embedding_source":
+			schemaNode.EmbeddingSource = pred.GetEmbeddingSource()
 		default:
 			//pass
 		}
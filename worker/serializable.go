@@ -0,0 +1,104 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+/*
+Transactions get snapshot isolation by default: Zero aborts a transaction if another one committed
+to a key it wrote to since it started, which allows write skew. A client can opt in to
+serializable isolation for a transaction by running its queries as serializable. The Alphas
+serving them then record the keys they read in the transaction, and return them to the client
+with the conflict keys. Zero aborts the transaction at commit if another one committed to a key
+it read since it started.
+
+A read iterating over the keys of a predicate, like has(), an inequality, or sorting, can't be
+tracked key by key, as the keys written later wouldn't be in its read set. It is tracked as a read
+of the whole predicate instead, which conflicts with any write to the predicate.
+
+Only the serializable transactions send the keys they write which aren't conflict keys, so the
+transactions are serializable among the serializable ones.
+*/
+
+type readSetKey struct{}
+
+type readSet struct {
+	sync.Mutex
+	keys map[uint64]struct{}
+}
+
+// WithSerializable returns a context that has the keys read by the query tracked, so that the
+// transaction it runs in is serializable.
+func WithSerializable(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readSetKey{}, &readSet{keys: make(map[uint64]struct{})})
+}
+
+// IsSerializableRequested returns true if a gRPC client asked for the query to be serializable by
+// passing serializable as metadata.
+func IsSerializableRequested(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["serializable"]) == 0 {
+		return false
+	}
+	serializable, _ := strconv.ParseBool(md["serializable"][0])
+	return serializable
+}
+
+func readSetFrom(ctx context.Context) *readSet {
+	rs, _ := ctx.Value(readSetKey{}).(*readSet)
+	return rs
+}
+
+// IsSerializable returns whether the keys read by the query run with ctx are tracked.
+func IsSerializable(ctx context.Context) bool {
+	return readSetFrom(ctx) != nil
+}
+
+// ReadKeys returns the keys read by the serializable query run with ctx, as sent to Zero.
+func ReadKeys(ctx context.Context) []string {
+	rs := readSetFrom(ctx)
+	if rs == nil {
+		return nil
+	}
+	rs.Lock()
+	defer rs.Unlock()
+	keys := make([]string, 0, len(rs.keys))
+	for fp := range rs.keys {
+		keys = append(keys, x.ReadKeyPrefix+strconv.FormatUint(fp, 36))
+	}
+	return keys
+}
+
+func (rs *readSet) add(fps []uint64) {
+	rs.Lock()
+	defer rs.Unlock()
+	for _, fp := range fps {
+		rs.keys[fp] = struct{}{}
+	}
+}
+
+// iteratesPredicate returns whether the task reads the keys of its predicate by iterating over
+// them instead of getting them one by one, so that it has to be tracked as a read of all of them.
+func iteratesPredicate(q *pb.Query) bool {
+	fnType, fname := parseFuncType(q.SrcFunc)
+	switch fnType {
+	case compareAttrFn:
+		return fname != "eq"
+	case compareScalarFn, hasFn, regexFn, matchFn, similarToFn, searchFn:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,47 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestReadKeys(t *testing.T) {
+	require.False(t, IsSerializableRequested(context.Background()))
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("serializable", "true"))
+	require.True(t, IsSerializableRequested(ctx))
+
+	require.False(t, IsSerializable(ctx))
+	require.Nil(t, ReadKeys(ctx))
+
+	ctx = WithSerializable(ctx)
+	require.True(t, IsSerializable(ctx))
+	require.Empty(t, ReadKeys(ctx))
+	readSetFrom(ctx).add([]uint64{36, 35})
+	readSetFrom(ctx).add([]uint64{36})
+	require.ElementsMatch(t, []string{x.ReadKeyPrefix + "10", x.ReadKeyPrefix + "z"}, ReadKeys(ctx))
+}
+
+func TestIteratesPredicate(t *testing.T) {
+	for name, iterates := range map[string]bool{
+		"eq": false, "anyofterms": false, "uid_in": false, "near": false,
+		"ge": true, "lt": true, "between": true, "has": true, "regexp": true, "match": true,
+		"similar_to": true, "search": true,
+	} {
+		q := &pb.Query{SrcFunc: &pb.SrcFunction{Name: name}}
+		require.Equal(t, iterates, iteratesPredicate(q), name)
+	}
+	require.False(t, iteratesPredicate(&pb.Query{}))
+	require.True(t, iteratesPredicate(&pb.Query{SrcFunc: &pb.SrcFunction{Name: "eq", IsCount: true}}))
+}
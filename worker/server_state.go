@@ -31,10 +31,12 @@ const (
 	AuditDefaults  = `compress=false; days=10; size=100; dir=; output=; encrypt-file=;`
 	BadgerDefaults = `compression=snappy; numgoroutines=8;`
 	RaftDefaults   = `learner=false; snapshot-after-entries=10000; ` +
-		`snapshot-after-duration=30m; pending-proposals=256; idx=; group=;`
+		`snapshot-after-duration=30m; pending-proposals=256; idx=; group=; zone=;`
 	SecurityDefaults = `token=; whitelist=;`
 	CDCDefaults      = `file=; kafka=; sasl_user=; sasl_password=; ca_cert=; client_cert=; ` +
-		`client_key=; sasl-mechanism=PLAIN; tls=false;`
+		`client_key=; sasl-mechanism=PLAIN; tls=false; nats-jetstream=false; grpc=false; ` +
+		`retries=3; timeout=10s; envelope=dgraph; webhook=; webhook-secret=; nats=; ` +
+		`namespaces=; predicate-prefixes=; types=;`
 	LimitDefaults = `mutations=allow; query-edge=1000000; normalize-node=10000; ` +
 		`mutations-nquad=1000000; disallow-drop=false; query-timeout=0ms; txn-abort-after=5m; ` +
 		` max-retries=10;max-pending-queries=10000;shared-instance=false;type-filter-uid-limit=10`
@@ -42,7 +44,10 @@ const (
 	GraphQLDefaults    = `introspection=true; debug=false; extensions=true; poll-interval=1s; ` +
 		`lambda-url=;`
 	CacheDefaults        = `size-mb=1024; percentage=40,40,20; remove-on-update=false`
-	FeatureFlagsDefaults = `normalize-compatibility-mode=; enable-detailed-metrics=false`
+	FeatureFlagsDefaults = `normalize-compatibility-mode=; enable-detailed-metrics=false; ` +
+		`query-planner=false`
+	EmbeddingDefaults = `provider=openai; url=; model=; api-key=; timeout=30s;`
+	HistoryDefaults   = `retention=0s;`
 )
 
 // ServerState holds the state of the Dgraph server.
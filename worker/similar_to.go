@@ -0,0 +1,310 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/tok/index"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+const (
+	// maxSim scores a node with the sum, over the query vectors, of the best score of the
+	// vectors of its list, as in late interaction retrieval. With a single query vector, this is
+	// the score of the closest vector of the node.
+	maxSim = "maxsim"
+	// sumSim scores a node with the sum of the similarities of the vectors of its list found
+	// by the search, which favors the nodes matching in many places.
+	sumSim = "sum"
+
+	// chunksPerNeighbor is how many vectors the search of a list of vectors looks up for each
+	// neighbor it returns, as several of the closest vectors may belong to the same node.
+	chunksPerNeighbor = 4
+)
+
+type neighbor struct {
+	uid   uint64
+	score float64
+}
+
+// handleSimilarToFunction searches the vector index of the predicate for the nearest neighbors of
+// the query vector, or of the vector of the query uid. The uids are returned in the UidMatrix, and
+// the scores computed by the search in the ValueMatrix, in the same order: the euclidean or
+// hamming distance, or the cosine or dot product similarity, depending on the metric of the index.
+// When a threshold is given, the neighbors scoring worse than it are left out.
+//
+// A predicate holding a list of vectors per node is searched by its vectors, and the nodes are
+// scored from the scores of their vectors, see maxSim and sumSim. It can be searched with several
+// query vectors, and the vectors of a query uid are all used.
+func (qs *queryState) handleSimilarToFunction(ctx context.Context, args funcArgs) error {
+	q, srcFn := args.q, args.srcFn
+	numNeighbors, err := strconv.ParseInt(q.SrcFunc.Args[0], 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid value for number of neighbors: %s", q.SrcFunc.Args[0])
+	}
+	cspec, err := pickFactoryCreateSpec(ctx, q.Attr)
+	if err != nil {
+		return err
+	}
+	//TODO: generate maxLevels from schema, filter, etc.
+	qc := hnsw.NewQueryCache(
+		posting.NewViLocalCache(qs.cache),
+		q.ReadTs,
+	)
+	var allowed func(uid uint64) bool
+	if q.UidList != nil {
+		// The filters of the query have been evaluated ahead of the search, so that only
+		// the vectors of the uids matching them are returned.
+		uids := q.UidList.Uids
+		allowed = func(uid uint64) bool {
+			i := sort.Search(len(uids), func(i int) bool { return uids[i] >= uid })
+			return i < len(uids) && uids[i] == uid
+		}
+	}
+
+	queryVecs := srcFn.vectorInfo
+	if queryVecs == nil {
+		if queryVecs, err = qs.vectorsOfUid(q.Attr, srcFn.vectorUid, q.ReadTs); err != nil {
+			return err
+		}
+	}
+	queryVecs = slices.DeleteFunc(queryVecs, func(vec []float32) bool { return len(vec) == 0 })
+
+	var nns []neighbor
+	var similarity bool
+	if schema.State().IsList(q.Attr) {
+		nns, similarity, err = searchVectorChunks(ctx, qc, cspec, q.Attr, queryVecs,
+			int(numNeighbors), srcFn.vectorAggregation, allowed)
+	} else {
+		nns, similarity, err = searchVectors(ctx, qc, cspec, q.Attr, queryVecs,
+			int(numNeighbors), allowed)
+	}
+	if err != nil {
+		return err
+	}
+
+	if srcFn.vectorThreshold != nil {
+		nns = slices.DeleteFunc(nns, func(nn neighbor) bool {
+			return (similarity && nn.score < *srcFn.vectorThreshold) ||
+				(!similarity && nn.score > *srcFn.vectorThreshold)
+		})
+	}
+	sort.Slice(nns, func(i, j int) bool { return nns[i].uid < nns[j].uid })
+
+	uids := &pb.List{Uids: make([]uint64, 0, len(nns))}
+	scores := &pb.ValueList{Values: make([]*pb.TaskValue, 0, len(nns))}
+	for _, nn := range nns {
+		tv, err := convertToType(types.Val{Tid: types.FloatID, Value: nn.score}, types.FloatID)
+		if err != nil {
+			return err
+		}
+		uids.Uids = append(uids.Uids, nn.uid)
+		scores.Values = append(scores.Values, tv)
+	}
+	args.out.UidMatrix = append(args.out.UidMatrix, uids)
+	args.out.ValueMatrix = append(args.out.ValueMatrix, scores)
+	return nil
+}
+
+// vectorsOfUid returns the vectors of uid for the predicate attr.
+func (qs *queryState) vectorsOfUid(attr string, uid uint64, readTs uint64) ([][]float32, error) {
+	pl, err := qs.cache.Get(x.DataKey(attr, uid))
+	if err != nil {
+		return nil, err
+	}
+	vals, err := pl.AllValues(readTs)
+	if err != nil {
+		return nil, err
+	}
+	vecs := make([][]float32, 0, len(vals))
+	for _, val := range vals {
+		data, ok := val.Value.([]byte)
+		if !ok {
+			return nil, errors.Errorf("invalid vector for uid %#x", uid)
+		}
+		vec, err := types.BytesAsVector(val.Tid, data)
+		if err != nil {
+			return nil, err
+		}
+		vecs = append(vecs, vec)
+	}
+	return vecs, nil
+}
+
+// searchWithPath searches the vector index for the nearest neighbors of query, treating an
+// empty index as having no neighbors.
+func searchWithPath(ctx context.Context, indexer index.VectorIndex[float32], qc index.CacheType,
+	query []float32, maxResults int, filter index.SearchFilter[float32]) (
+	*index.SearchPathResult, error) {
+	res, err := indexer.SearchWithPath(ctx, qc, query, maxResults, filter)
+	if err != nil && strings.Contains(err.Error(),
+		hnsw.EmptyHNSWTreeError+": "+badger.ErrKeyNotFound.Error()) {
+		return index.NewSearchPathResult(), nil
+	}
+	return res, err
+}
+
+// searchVectors searches the vector index of attr, which holds a single vector per node, for the
+// nearest neighbors of the query vector.
+func searchVectors(ctx context.Context, qc index.CacheType, cspec *tok.FactoryCreateSpec,
+	attr string, queryVecs [][]float32, numNeighbors int, allowed func(uint64) bool) (
+	[]neighbor, bool, error) {
+	if len(queryVecs) == 0 {
+		return nil, false, nil
+	}
+	if len(queryVecs) > 1 {
+		return nil, false, errors.Errorf("similar_to can only search predicate %s with one vector,"+
+			" as it is not a list of vectors", x.ParseAttr(attr))
+	}
+	indexer, err := cspec.CreateIndex(attr)
+	if err != nil {
+		return nil, false, err
+	}
+	filter := index.AcceptAll[float32]
+	if allowed != nil {
+		filter = func(_, _ []float32, uid uint64) bool { return allowed(uid) }
+	}
+	res, err := searchWithPath(ctx, indexer, qc, queryVecs[0], numNeighbors, filter)
+	if err != nil {
+		return nil, false, err
+	}
+	nns := make([]neighbor, len(res.Neighbors))
+	for i, uid := range res.Neighbors {
+		nns[i] = neighbor{uid: uid, score: res.Scores[i]}
+	}
+	return nns, res.Similarity, nil
+}
+
+// searchVectorChunks searches the vector index of the chunks of attr, which holds a list of
+// vectors per node, for the nearest chunks of each query vector, and returns the numNeighbors
+// nodes of these chunks scoring best with aggregation. Under maxSim, a node with no chunk found
+// for a query vector is given the worst score found for it, which bounds the score of its
+// closest chunk.
+func searchVectorChunks(ctx context.Context, qc index.CacheType, cspec *tok.FactoryCreateSpec,
+	attr string, queryVecs [][]float32, numNeighbors int, aggregation string,
+	allowed func(uint64) bool) ([]neighbor, bool, error) {
+	if aggregation == "" {
+		aggregation = maxSim
+	}
+	indexer, err := cspec.CreateIndex(hnsw.ConcatStrings(attr, hnsw.VecChunk))
+	if err != nil {
+		return nil, false, err
+	}
+	ownerAttr := hnsw.ConcatStrings(attr, hnsw.VecChunkOwner)
+	owners := make(map[uint64]uint64)
+	ownerOf := func(chunk uint64) (uint64, error) {
+		if owner, ok := owners[chunk]; ok {
+			return owner, nil
+		}
+		data, err := qc.Get(x.DataKey(ownerAttr, chunk))
+		if err != nil {
+			return 0, err
+		}
+		if len(data) != 8 {
+			return 0, errors.Errorf("invalid owner of vector chunk %#x", chunk)
+		}
+		owner := binary.LittleEndian.Uint64(data)
+		owners[chunk] = owner
+		return owner, nil
+	}
+	filter := index.AcceptAll[float32]
+	if allowed != nil {
+		filter = func(_, _ []float32, chunk uint64) bool {
+			owner, err := ownerOf(chunk)
+			return err == nil && allowed(owner)
+		}
+	}
+
+	var similarity bool
+	// best holds the best score of the chunks of each node for every query vector.
+	best := make(map[uint64][]float64)
+	sums := make(map[uint64]float64)
+	worst := make([]float64, len(queryVecs))
+	for i, query := range queryVecs {
+		res, err := searchWithPath(ctx, indexer, qc, query, chunksPerNeighbor*numNeighbors,
+			filter)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(res.Neighbors) == 0 {
+			continue
+		}
+		similarity = res.Similarity
+		if aggregation == sumSim && !similarity {
+			return nil, false, errors.Errorf("similar_to can only sum the similarities of the"+
+				" vectors of predicate %s with the cosine or dotproduct metric", x.ParseAttr(attr))
+		}
+		// The neighbors are sorted from the best score to the worst.
+		worst[i] = res.Scores[len(res.Scores)-1]
+		for j, chunk := range res.Neighbors {
+			owner, err := ownerOf(chunk)
+			switch {
+			case errors.Is(err, posting.ErrNoValue):
+				// The chunk has been deleted since.
+				continue
+			case err != nil:
+				return nil, false, err
+			}
+			score := res.Scores[j]
+			sums[owner] += score
+			scores, ok := best[owner]
+			if !ok {
+				scores = make([]float64, len(queryVecs))
+				for k := range scores {
+					scores[k] = math.NaN()
+				}
+				best[owner] = scores
+			}
+			if math.IsNaN(scores[i]) || (similarity && score > scores[i]) ||
+				(!similarity && score < scores[i]) {
+				scores[i] = score
+			}
+		}
+	}
+
+	nns := make([]neighbor, 0, len(best))
+	for owner, scores := range best {
+		nn := neighbor{uid: owner, score: sums[owner]}
+		if aggregation == maxSim {
+			nn.score = 0
+			for i, score := range scores {
+				if math.IsNaN(score) {
+					score = worst[i]
+				}
+				nn.score += score
+			}
+		}
+		nns = append(nns, nn)
+	}
+	sort.Slice(nns, func(i, j int) bool {
+		if nns[i].score != nns[j].score {
+			return (similarity && nns[i].score > nns[j].score) ||
+				(!similarity && nns[i].score < nns[j].score)
+		}
+		return nns[i].uid < nns[j].uid
+	})
+	if len(nns) > numNeighbors {
+		nns = nns[:numNeighbors]
+	}
+	return nns, similarity, nil
+}
@@ -0,0 +1,122 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+// grpcSubscriberBuffer is the number of batches that can be queued up for a subscriber before it
+// is considered too slow and gets disconnected.
+const grpcSubscriberBuffer = 256
+
+var errCDCSubscriptionClosed = errors.New("CDC subscription was closed because the subscriber " +
+	"couldn't keep up with the events or the server is shutting down")
+
+// grpcSink hands the events over to the clients subscribed through the CDC gRPC service. A batch
+// is only considered sent if at least one client is subscribed, so that the events are kept
+// around until a client shows up, like they would while Kafka is unreachable.
+type grpcSink struct {
+	sync.Mutex
+	nextId uint64
+	subs   map[uint64]chan *pb.CDCEvents
+}
+
+func newGrpcSink() *grpcSink {
+	return &grpcSink{subs: make(map[uint64]chan *pb.CDCEvents)}
+}
+
+func (g *grpcSink) subscribe() (uint64, <-chan *pb.CDCEvents) {
+	g.Lock()
+	defer g.Unlock()
+	g.nextId++
+	ch := make(chan *pb.CDCEvents, grpcSubscriberBuffer)
+	g.subs[g.nextId] = ch
+	return g.nextId, ch
+}
+
+func (g *grpcSink) unsubscribe(id uint64) {
+	g.Lock()
+	defer g.Unlock()
+	if ch, ok := g.subs[id]; ok {
+		close(ch)
+		delete(g.subs, id)
+	}
+}
+
+func (g *grpcSink) Send(_ context.Context, messages []SinkMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	batch := &pb.CDCEvents{Events: make([]*pb.CDCEvent, len(messages))}
+	for i, m := range messages {
+		batch.Events[i] = &pb.CDCEvent{
+			Namespace: binary.BigEndian.Uint64(m.Key),
+			Event:     m.Value,
+		}
+	}
+
+	g.Lock()
+	defer g.Unlock()
+	if len(g.subs) == 0 {
+		return errors.New("no client is subscribed to the CDC events")
+	}
+	for id, ch := range g.subs {
+		select {
+		case ch <- batch:
+		default:
+			glog.Warningf("CDC: disconnecting subscriber %d as it is too slow", id)
+			close(ch)
+			delete(g.subs, id)
+		}
+	}
+	return nil
+}
+
+func (g *grpcSink) Close() error {
+	g.Lock()
+	defer g.Unlock()
+	for id, ch := range g.subs {
+		close(ch)
+		delete(g.subs, id)
+	}
+	return nil
+}
+
+// SubscribeCDC calls f with every batch of CDC events sent by this Alpha until f returns an error
+// or done is closed. It fails if the grpc sink isn't enabled in the --cdc flag.
+func SubscribeCDC(done <-chan struct{}, f func(*pb.CDCEvents) error) error {
+	var sink *grpcSink
+	if n := groups().Node; n != nil && n.cdcTracker != nil {
+		sink, _ = n.cdcTracker.sink.(*grpcSink)
+	}
+	if sink == nil {
+		return errors.New("the grpc sink is not enabled in the --cdc flag")
+	}
+
+	id, ch := sink.subscribe()
+	defer sink.unsubscribe(id)
+	for {
+		select {
+		case <-done:
+			return nil
+		case batch, ok := <-ch:
+			if !ok {
+				return errCDCSubscriptionClosed
+			}
+			if err := f(batch); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -6,6 +6,7 @@
 package worker
 
 import (
+	"context"
 	"crypto/sha256"
 	"crypto/sha512"
 	"crypto/tls"
@@ -35,8 +36,8 @@ type SinkMeta struct {
 }
 
 type Sink interface {
-	// send in bulk to the sink
-	Send(messages []SinkMessage) error
+	// send in bulk to the sink. ctx is cancelled once the CDC is closed.
+	Send(ctx context.Context, messages []SinkMessage) error
 	// close sink
 	Close() error
 }
@@ -51,6 +52,12 @@ func GetSink(conf *z.SuperFlag) (Sink, error) {
 		return newKafkaSink(conf)
 	case conf.GetPath("file") != "":
 		return newFileSink(conf)
+	case conf.GetString("webhook") != "":
+		return newWebhookSink(conf)
+	case conf.GetString("nats") != "":
+		return newNatsSink(conf)
+	case conf.GetBool("grpc"):
+		return newGrpcSink(), nil
 	}
 	return nil, errors.New("sink config is not provided")
 }
@@ -152,7 +159,7 @@ func newKafkaSink(config *z.SuperFlag) (Sink, error) {
 	}, nil
 }
 
-func (k *kafkaSinkClient) Send(messages []SinkMessage) error {
+func (k *kafkaSinkClient) Send(_ context.Context, messages []SinkMessage) error {
 	if len(messages) == 0 {
 		return nil
 	}
@@ -178,7 +185,7 @@ type fileSink struct {
 	fileWriter *x.LogWriter
 }
 
-func (f *fileSink) Send(messages []SinkMessage) error {
+func (f *fileSink) Send(_ context.Context, messages []SinkMessage) error {
 	for _, m := range messages {
 		_, err := f.fileWriter.Write([]byte(fmt.Sprintf("{ \"key\": \"%d\", \"value\": %s}\n",
 			binary.BigEndian.Uint64(m.Key), string(m.Value))))
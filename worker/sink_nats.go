@@ -0,0 +1,136 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+)
+
+// natsSink publishes the events to NATS, with the topic of the messages as subject. With
+// nats-jetstream, the events are published through JetStream and Send waits for it to acknowledge
+// all of them, so that the events are known to be persisted. The streams capturing the subject
+// must be created beforehand.
+type natsSink struct {
+	nc      *nats.Conn
+	js      jetstream.JetStream // nil unless nats-jetstream is set.
+	retries int
+	timeout time.Duration
+}
+
+func newNatsSink(config *z.SuperFlag) (Sink, error) {
+	var servers []string
+	for _, s := range strings.Split(config.GetString("nats"), ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			servers = append(servers, s)
+		}
+	}
+	if len(servers) == 0 {
+		return nil, errors.New("servers are not provided for the nats config")
+	}
+	retries := config.GetInt64("retries")
+	if retries < 0 {
+		return nil, errors.Errorf("retries must not be negative. Got: %d", retries)
+	}
+	timeout := config.GetDuration("timeout")
+
+	// The client reconnects on its own, and buffers the events published meanwhile. Connecting
+	// doesn't fail if the servers are down when the Alpha starts, the events wait for them.
+	nc, err := nats.Connect(strings.Join(servers, ","),
+		nats.Name("dgraph-cdc"),
+		nats.Timeout(timeout),
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				glog.Warningf("CDC: disconnected from NATS: %v", err)
+			}
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			glog.Infof("CDC: reconnected to NATS server %s", nc.ConnectedUrlRedacted())
+		}))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to connect to NATS")
+	}
+	sink := &natsSink{nc: nc, retries: int(retries), timeout: timeout}
+	if config.GetBool("nats-jetstream") {
+		if sink.js, err = jetstream.New(nc, jetstream.WithPublishAsyncTimeout(timeout)); err != nil {
+			nc.Close()
+			return nil, errors.Wrap(err, "unable to create the JetStream context")
+		}
+	}
+	return sink, nil
+}
+
+// publish publishes the messages and returns the ones that failed, along with the last error.
+func (n *natsSink) publish(messages []SinkMessage) ([]SinkMessage, error) {
+	if n.js == nil {
+		for i, m := range messages {
+			if err := n.nc.Publish(m.Meta.Topic, m.Value); err != nil {
+				return messages[i:], err
+			}
+		}
+		// The server has processed the messages once it replies to the flush. Errors like a
+		// permission violation come before that.
+		if err := n.nc.FlushTimeout(n.timeout); err != nil {
+			return messages, err
+		}
+		return nil, nil
+	}
+
+	type pending struct {
+		msg    SinkMessage
+		future jetstream.PubAckFuture
+	}
+	var published []pending
+	var failed []SinkMessage
+	var lastErr error
+	for _, m := range messages {
+		f, err := n.js.PublishAsync(m.Meta.Topic, m.Value)
+		if err != nil {
+			failed, lastErr = append(failed, m), err
+			continue
+		}
+		published = append(published, pending{msg: m, future: f})
+	}
+	// The futures fail on their own once the publish timeout passes without an ack.
+	for _, p := range published {
+		select {
+		case <-p.future.Ok():
+		case err := <-p.future.Err():
+			failed, lastErr = append(failed, p.msg), err
+		}
+	}
+	return failed, lastErr
+}
+
+func (n *natsSink) Send(_ context.Context, messages []SinkMessage) error {
+	for attempt := 0; len(messages) > 0; attempt++ {
+		var err error
+		if messages, err = n.publish(messages); err == nil {
+			return nil
+		}
+		if attempt >= n.retries {
+			return errors.Wrap(err, "unable to publish events to NATS")
+		}
+		glog.Warningf("CDC: attempt %d to publish events to NATS failed: %v. Retrying",
+			attempt+1, err)
+	}
+	return nil
+}
+
+func (n *natsSink) Close() error {
+	n.nc.Close()
+	return nil
+}
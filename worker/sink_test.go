@@ -0,0 +1,201 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+)
+
+func sinkMessages(values ...string) []SinkMessage {
+	var messages []SinkMessage
+	for i, v := range values {
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, uint64(i))
+		messages = append(messages, SinkMessage{
+			Meta:  SinkMeta{Topic: defaultEventTopic},
+			Key:   key,
+			Value: []byte(v),
+		})
+	}
+	return messages
+}
+
+func sinkConf(conf string) *z.SuperFlag {
+	return z.NewSuperFlag(conf).MergeAndCheckDefault(CDCDefaults)
+}
+
+func TestWebhookSink(t *testing.T) {
+	var calls atomic.Int32
+	var body []byte
+	var signature string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first request fails, the sink must retry it.
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ = io.ReadAll(r.Body)
+		signature = r.Header.Get(webhookSignatureHeader)
+	}))
+	defer srv.Close()
+
+	sink, err := GetSink(sinkConf("webhook=" + srv.URL + "; webhook-secret=s3cr3t; retries=1"))
+	require.NoError(t, err)
+	defer sink.Close()
+	require.NoError(t, sink.Send(context.Background(), sinkMessages(`{"a":1}`, `{"b":2}`)))
+	require.Equal(t, int32(2), calls.Load())
+
+	var events []map[string]interface{}
+	require.NoError(t, json.Unmarshal(body, &events))
+	require.Equal(t, []map[string]interface{}{
+		{"key": "0", "value": map[string]interface{}{"a": 1.0}},
+		{"key": "1", "value": map[string]interface{}{"b": 2.0}},
+	}, events)
+	require.Equal(t, webhookSignature([]byte("s3cr3t"), body), signature)
+	require.True(t, strings.HasPrefix(signature, "sha256="))
+}
+
+func TestWebhookSinkNoRetry(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	sink, err := GetSink(sinkConf("webhook=" + srv.URL + "; retries=3"))
+	require.NoError(t, err)
+	defer sink.Close()
+	// Client errors aren't retried.
+	require.Error(t, sink.Send(context.Background(), sinkMessages(`{}`)))
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestWebhookSinkCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The CDC is closed while the webhook is down.
+		calls.Add(1)
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	sink, err := GetSink(sinkConf("webhook=" + srv.URL + "; retries=100"))
+	require.NoError(t, err)
+	defer sink.Close()
+	require.ErrorIs(t, sink.Send(ctx, sinkMessages(`{}`)), context.Canceled)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+// fakeNats is a NATS server that understands just enough of the protocol for the client. If
+// jetStream is set, it acks every message published with a reply subject, like JetStream does.
+func fakeNats(t *testing.T, jetStream bool) (string, <-chan string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	published := make(chan string, 100)
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		fmt.Fprint(conn, "INFO {\"server_id\":\"fake\",\"proto\":1,\"headers\":true,"+
+			"\"max_payload\":1048576}\r\n")
+		seq, sid := 0, ""
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fields := strings.Fields(line)
+			if len(fields) == 0 {
+				continue
+			}
+			switch fields[0] {
+			case "PING":
+				fmt.Fprint(conn, "PONG\r\n")
+			case "SUB":
+				// The client subscribes to the replies to its requests.
+				sid = fields[len(fields)-1]
+			case "PUB":
+				size, _ := strconv.Atoi(fields[len(fields)-1])
+				payload := make([]byte, size+2)
+				if _, err := io.ReadFull(r, payload); err != nil {
+					return
+				}
+				published <- fields[1] + " " + string(payload[:size])
+				if jetStream && len(fields) == 4 {
+					seq++
+					ack := fmt.Sprintf(`{"stream":"CDC","seq":%d}`, seq)
+					fmt.Fprintf(conn, "MSG %s %s %d\r\n%s\r\n", fields[2], sid, len(ack), ack)
+				}
+			}
+		}
+	}()
+	return l.Addr().String(), published
+}
+
+func TestNatsSink(t *testing.T) {
+	for _, jetStream := range []bool{false, true} {
+		t.Run(fmt.Sprintf("jetstream=%v", jetStream), func(t *testing.T) {
+			addr, published := fakeNats(t, jetStream)
+			sink, err := GetSink(sinkConf(fmt.Sprintf("nats=nats://%s; nats-jetstream=%v",
+				addr, jetStream)))
+			require.NoError(t, err)
+			defer sink.Close()
+
+			require.NoError(t, sink.Send(context.Background(), sinkMessages(`{"a":1}`, `{"b":2}`)))
+			require.Equal(t, `dgraph-cdc {"a":1}`, <-published)
+			require.Equal(t, `dgraph-cdc {"b":2}`, <-published)
+			require.NoError(t, sink.Send(context.Background(), sinkMessages(`{"c":3}`)))
+			require.Equal(t, `dgraph-cdc {"c":3}`, <-published)
+		})
+	}
+}
+
+func TestGrpcSink(t *testing.T) {
+	sink := newGrpcSink()
+	// Nothing can be sent without subscribers.
+	require.Error(t, sink.Send(context.Background(), sinkMessages(`{}`)))
+
+	id, ch := sink.subscribe()
+	require.NoError(t, sink.Send(context.Background(), sinkMessages(`{"a":1}`, `{"b":2}`)))
+	batch := <-ch
+	require.Len(t, batch.Events, 2)
+	require.Equal(t, uint64(1), batch.Events[1].Namespace)
+	require.Equal(t, `{"b":2}`, string(batch.Events[1].Event))
+
+	// A subscriber that doesn't keep up gets disconnected.
+	for range grpcSubscriberBuffer + 1 {
+		require.NoError(t, sink.Send(context.Background(), sinkMessages(`{}`)))
+	}
+	for range ch {
+	}
+	require.Error(t, sink.Send(context.Background(), sinkMessages(`{}`)))
+	sink.unsubscribe(id)
+	require.NoError(t, sink.Close())
+}
@@ -0,0 +1,137 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+)
+
+const (
+	// webhookSignatureHeader holds the HMAC-SHA256 of the body, signed with webhook-secret.
+	webhookSignatureHeader = "X-Dgraph-Signature"
+	webhookMaxBackoff      = 30 * time.Second
+)
+
+// webhookSink POSTs the events as a JSON array to an HTTP endpoint. Every element of the array
+// has the same form as the lines written by the file sink.
+type webhookSink struct {
+	url     string
+	secret  []byte
+	retries int
+	client  *http.Client
+}
+
+func newWebhookSink(config *z.SuperFlag) (Sink, error) {
+	url := config.GetString("webhook")
+	if url == "" {
+		return nil, errors.New("url is not provided for the webhook config")
+	}
+	retries := config.GetInt64("retries")
+	if retries < 0 {
+		return nil, errors.Errorf("retries must not be negative. Got: %d", retries)
+	}
+	return &webhookSink{
+		url:     url,
+		secret:  []byte(config.GetString("webhook-secret")),
+		retries: int(retries),
+		client:  &http.Client{Timeout: config.GetDuration("timeout")},
+	}, nil
+}
+
+// webhookBody returns the JSON array sent to the webhook for messages.
+func webhookBody(messages []SinkMessage) []byte {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, m := range messages {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(&buf, "{\"key\":\"%d\",\"value\":%s}", binary.BigEndian.Uint64(m.Key), m.Value)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes()
+}
+
+// webhookSignature returns the value of the signature header for body.
+func webhookSignature(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// post sends body once. The returned bool tells whether the request is worth retrying.
+func (w *webhookSink) post(ctx context.Context, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.Wrap(err, "unable to create webhook request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(w.secret) > 0 {
+		req.Header.Set(webhookSignatureHeader, webhookSignature(w.secret, body))
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, errors.Wrap(err, "unable to send events to webhook")
+	}
+	defer resp.Body.Close()
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, errors.Errorf("webhook responded with status %s", resp.Status)
+	default:
+		return false, errors.Errorf("webhook responded with status %s", resp.Status)
+	}
+}
+
+func (w *webhookSink) Send(ctx context.Context, messages []SinkMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	body := webhookBody(messages)
+	backoff := 100 * time.Millisecond
+	for attempt := 0; ; attempt++ {
+		retry, err := w.post(ctx, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= w.retries {
+			return err
+		}
+		glog.Warningf("CDC: attempt %d to send events to webhook failed: %v. Retrying in %s",
+			attempt+1, err, backoff)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "unable to send events to webhook")
+		}
+		if backoff *= 2; backoff > webhookMaxBackoff {
+			backoff = webhookMaxBackoff
+		}
+	}
+}
+
+func (w *webhookSink) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
@@ -52,6 +52,9 @@ func SortOverNetwork(ctx context.Context, q *pb.SortMessage) (*pb.SortResult, er
 	} else if gid == 0 {
 		return &emptySortResult,
 			errors.Errorf("Cannot sort by unknown attribute %s", x.ParseAttr(q.Order[0].Attr))
+	} else if len(groups().rangesOf(q.Order[0].Attr)) > 0 {
+		return &emptySortResult, errors.Errorf("Cannot sort by attribute %s split across groups",
+			x.ParseAttr(q.Order[0].Attr))
 	}
 
 	if span := trace.SpanFromContext(ctx); span != nil {
@@ -61,6 +64,13 @@ func SortOverNetwork(ctx context.Context, q *pb.SortMessage) (*pb.SortResult, er
 		)
 	}
 
+	if rs := readSetFrom(ctx); rs != nil {
+		// Sorting iterates over the index, and reads the values of all the uids.
+		for _, order := range q.Order {
+			rs.add([]uint64{posting.PredicateFingerprint(order.Attr)})
+		}
+	}
+
 	if groups().ServesGroup(gid) {
 		// No need for a network call, as this should be run from within this instance.
 		return processSort(ctx, q)
@@ -490,6 +500,9 @@ func processSort(ctx context.Context, ts *pb.SortMessage) (*pb.SortResult, error
 	if err := posting.Oracle().WaitForTs(ctx, ts.ReadTs); err != nil {
 		return nil, err
 	}
+	if err := checkAsOf(ts.AsOf, ts.ReadTs); err != nil {
+		return nil, err
+	}
 	span.SetAttributes(
 		attribute.String("checksumMatch", "true"),
 	)
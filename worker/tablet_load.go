@@ -0,0 +1,107 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+// loadWindow is the time over which the load on the tablets is averaged.
+const loadWindow = time.Minute
+
+// tabletLoads tracks the load on the tablets served by this Alpha, sent to Zero with the membership
+// updates so that it can balance the load across the groups.
+var tabletLoads = newLoadTracker()
+
+type loadCount struct {
+	queries   atomic.Uint64
+	mutations atomic.Uint64
+	cpu       atomic.Int64 // Nanoseconds spent processing queries.
+}
+
+// loadTracker counts the queries and mutations on each tablet, and turns the counts into rates
+// averaged over the loadWindow each time they are reported.
+type loadTracker struct {
+	counts sync.Map // predicate -> *loadCount
+
+	sync.Mutex // Guards the fields below.
+	last       time.Time
+	rates      map[string]*pb.TabletLoad
+}
+
+func newLoadTracker() *loadTracker {
+	return &loadTracker{last: time.Now(), rates: make(map[string]*pb.TabletLoad)}
+}
+
+func (t *loadTracker) count(attr string) *loadCount {
+	if c, ok := t.counts.Load(attr); ok {
+		return c.(*loadCount)
+	}
+	c, _ := t.counts.LoadOrStore(attr, &loadCount{})
+	return c.(*loadCount)
+}
+
+// addQuery records a query processed on the predicate, which took the given time.
+func (t *loadTracker) addQuery(attr string, took time.Duration) {
+	c := t.count(attr)
+	c.queries.Add(1)
+	c.cpu.Add(int64(took))
+}
+
+// addMutations records the number of edges mutated on the predicate.
+func (t *loadTracker) addMutations(attr string, edges int) {
+	t.count(attr).mutations.Add(uint64(edges))
+}
+
+// report returns the rates of the tablets with some load, blending the ones since the last report
+// into the previous rates.
+func (t *loadTracker) report(now time.Time) map[string]*pb.TabletLoad {
+	t.Lock()
+	defer t.Unlock()
+
+	elapsed := now.Sub(t.last).Seconds()
+	if elapsed <= 0 {
+		return nil
+	}
+	t.last = now
+	weight := 1 - math.Exp(-elapsed/loadWindow.Seconds())
+	blend := func(prev float64, count float64) float64 {
+		return prev + weight*(count/elapsed-prev)
+	}
+
+	t.counts.Range(func(key, value any) bool {
+		attr, c := key.(string), value.(*loadCount)
+		queries, mutations := c.queries.Swap(0), c.mutations.Swap(0)
+		cpu := time.Duration(c.cpu.Swap(0))
+
+		rate, ok := t.rates[attr]
+		if !ok {
+			rate = &pb.TabletLoad{}
+			t.rates[attr] = rate
+		}
+		rate.Queries = blend(rate.Queries, float64(queries))
+		rate.Mutations = blend(rate.Mutations, float64(mutations))
+		rate.CpuMs = blend(rate.CpuMs, float64(cpu)/float64(time.Millisecond))
+		if rate.Queries < 0.01 && rate.Mutations < 0.01 && rate.CpuMs < 0.01 {
+			// The tablet has gone cold, stop reporting it.
+			delete(t.rates, attr)
+			t.counts.Delete(attr)
+		}
+		return true
+	})
+
+	out := make(map[string]*pb.TabletLoad, len(t.rates))
+	for attr, rate := range t.rates {
+		out[attr] = &pb.TabletLoad{Queries: rate.Queries, Mutations: rate.Mutations,
+			CpuMs: rate.CpuMs}
+	}
+	return out
+}
@@ -0,0 +1,38 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTracker(t *testing.T) {
+	tracker := newLoadTracker()
+	start := tracker.last
+	for range 600 {
+		tracker.addQuery("name", 5*time.Millisecond)
+	}
+	tracker.addMutations("name", 60)
+	tracker.addMutations("age", 1200)
+
+	// Over a whole window, the rates move most of the way to the ones seen.
+	rates := tracker.report(start.Add(loadWindow))
+	require.InDelta(t, 10*0.632, rates["name"].Queries, 0.01)
+	require.InDelta(t, 50*0.632, rates["name"].CpuMs, 0.01)
+	require.InDelta(t, 1*0.632, rates["name"].Mutations, 0.01)
+	require.InDelta(t, 20*0.632, rates["age"].Mutations, 0.01)
+	require.Zero(t, rates["age"].Queries)
+
+	// The rates decay once the tablets are idle, until they are no longer reported.
+	rates = tracker.report(start.Add(2 * loadWindow))
+	require.Less(t, rates["name"].Queries, 10*0.632)
+	rates = tracker.report(start.Add(20 * loadWindow))
+	require.Empty(t, rates)
+	require.Nil(t, tracker.report(start.Add(20*loadWindow)))
+}
@@ -0,0 +1,315 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hypermodeinc/dgraph/v25/algo"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// rangesOf returns the uid ranges of the predicate if it is split across groups, nil otherwise.
+func (g *groupi) rangesOf(key string) []*pb.TabletRange {
+	g.RLock()
+	defer g.RUnlock()
+	return g.tabletRanges[key].GetRanges()
+}
+
+// rangesAt returns the uid ranges of the predicate to read at ts, nil if it isn't split across
+// groups. Like for a tablet, a read from before the last range move would miss the data moved
+// between the groups. A read with a max staleness is at the timestamp of this Alpha, which can be
+// that old.
+func (g *groupi) rangesAt(key string, ts uint64) ([]*pb.TabletRange, error) {
+	g.RLock()
+	tr := g.tabletRanges[key]
+	g.RUnlock()
+	if ts > 0 && ts < tr.GetMoveTs() {
+		return nil, errors.Errorf("StartTs: %d is from before MoveTs: %d for pred: %q",
+			ts, tr.GetMoveTs(), key)
+	}
+	return tr.GetRanges(), nil
+}
+
+// servesRange returns whether this group serves a range of the predicate split across groups.
+func (g *groupi) servesRange(key string) bool {
+	gid := g.groupId()
+	for _, r := range g.rangesOf(key) {
+		if r.GroupId == gid {
+			return true
+		}
+	}
+	return false
+}
+
+// BelongsToUid returns the group serving the data of uid for the predicate. That is the group
+// serving the tablet, unless the predicate is split across groups.
+func (g *groupi) BelongsToUid(key string, uid uint64) (uint32, error) {
+	if ranges := g.rangesOf(key); len(ranges) > 0 {
+		return rangeGroup(ranges, uid), nil
+	}
+	return g.BelongsTo(key)
+}
+
+// rangeGroup returns the group serving the range holding uid. The first range starts at uid 0.
+func rangeGroup(ranges []*pb.TabletRange, uid uint64) uint32 {
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].StartUid > uid })
+	return ranges[i-1].GroupId
+}
+
+// rangeGroups returns the groups serving the ranges.
+func rangeGroups(ranges []*pb.TabletRange) []uint32 {
+	var gids []uint32
+	seen := make(map[uint32]struct{})
+	for _, r := range ranges {
+		if _, ok := seen[r.GroupId]; !ok {
+			seen[r.GroupId] = struct{}{}
+			gids = append(gids, r.GroupId)
+		}
+	}
+	return gids
+}
+
+type uidPart struct {
+	gid  uint32
+	uids []uint64
+}
+
+// splitUids splits the sorted uids into the parts falling in each of the ranges, in the order of
+// the uids.
+func splitUids(uids []uint64, ranges []*pb.TabletRange) []uidPart {
+	var parts []uidPart
+	for i, r := range ranges {
+		lo := sort.Search(len(uids), func(j int) bool { return uids[j] >= r.StartUid })
+		hi := len(uids)
+		if i+1 < len(ranges) {
+			end := ranges[i+1].StartUid
+			hi = sort.Search(len(uids), func(j int) bool { return uids[j] >= end })
+		}
+		if lo < hi {
+			parts = append(parts, uidPart{gid: r.GroupId, uids: uids[lo:hi]})
+		}
+	}
+	return parts
+}
+
+// processTaskOverRanges processes the query on a predicate split across groups. The rows of the
+// uids are computed by the groups serving them. The functions and the reverse edges look up the
+// index and reverse keys that each group keeps for the data it serves, so they run on all the
+// groups and their results are merged. The query to each group goes to a replica of it within the
+// max staleness of the query, if it has one, as the groups don't lag the same.
+func processTaskOverRanges(ctx context.Context, q *pb.Query,
+	ranges []*pb.TabletRange) (*pb.Result, error) {
+
+	fnType, fname := parseFuncType(q.SrcFunc)
+	switch {
+	case fnType == similarToFn || fnType == searchFn:
+		return nil, errors.Errorf("Function %s is not supported on predicate %s split across groups",
+			fname, x.ParseAttr(q.Attr))
+	case onUidRanges(q, fnType):
+		return processTaskOnUidRanges(ctx, q, ranges)
+	}
+
+	sub := proto.Clone(q).(*pb.Query)
+	paginate := fnType == hasFn && len(q.UidList.GetUids()) == 0
+	if paginate {
+		// Each group returns all of its uids, the page is taken from all of them.
+		sub.First, sub.Offset = math.MaxInt32, 0
+	}
+
+	gids := rangeGroups(ranges)
+	results := make([]*pb.Result, len(gids))
+	errCh := make(chan error, len(gids))
+	for i, gid := range gids {
+		go func(i int, gid uint32) {
+			var err error
+			results[i], err = processTaskOnGroup(ctx, sub, gid)
+			errCh <- err
+		}(i, gid)
+	}
+	for range gids {
+		if err := <-errCh; err != nil {
+			return nil, err
+		}
+	}
+
+	out, err := mergeResults(results)
+	if err != nil {
+		return nil, err
+	}
+	if paginate && len(out.UidMatrix) > 0 {
+		uids := out.UidMatrix[0].Uids
+		start, end := x.PageRange(int(q.First), int(q.Offset), len(uids))
+		out.UidMatrix[0].Uids = uids[start:end]
+	}
+	return out, nil
+}
+
+// onUidRanges returns whether the query only needs the rows of its uids, so that each group is
+// sent the uids it serves. The functions at the root of a query have no uids, they look up the
+// index of every group instead.
+func onUidRanges(q *pb.Query, fnType FuncType) bool {
+	switch fnType {
+	case notAFunction, aggregatorFn, passwordFn, compareAttrFn:
+		return !q.Reverse && len(q.UidList.GetUids()) > 0
+	}
+	return false
+}
+
+// processTaskOnUidRanges sends the uids of the query to the groups serving them and joins the
+// rows of their results.
+func processTaskOnUidRanges(ctx context.Context, q *pb.Query,
+	ranges []*pb.TabletRange) (*pb.Result, error) {
+
+	parts := splitUids(q.UidList.GetUids(), ranges)
+	results := make([]*pb.Result, len(parts))
+	errCh := make(chan error, len(parts))
+	for i, part := range parts {
+		sub := proto.Clone(q).(*pb.Query)
+		sub.UidList = &pb.List{Uids: part.uids}
+		go func(i int, gid uint32) {
+			var err error
+			results[i], err = processTaskOnGroup(ctx, sub, gid)
+			errCh <- err
+		}(i, part.gid)
+	}
+	for range parts {
+		if err := <-errCh; err != nil {
+			return nil, err
+		}
+	}
+
+	out := &pb.Result{}
+	for _, res := range results {
+		out.UidMatrix = append(out.UidMatrix, res.UidMatrix...)
+		out.ValueMatrix = append(out.ValueMatrix, res.ValueMatrix...)
+		out.Counts = append(out.Counts, res.Counts...)
+		out.FacetMatrix = append(out.FacetMatrix, res.FacetMatrix...)
+		out.LangMatrix = append(out.LangMatrix, res.LangMatrix...)
+		out.HistoryMatrix = append(out.HistoryMatrix, res.HistoryMatrix...)
+		out.IntersectDest = out.IntersectDest || res.IntersectDest
+		out.List = out.List || res.List
+		out.ReadKeys = append(out.ReadKeys, res.ReadKeys...)
+		out.Access = MergeAccess(out.Access, res.Access)
+		mergeVectorMetrics(out, res)
+	}
+	return out, nil
+}
+
+// mergeResults merges the results of the same query run on all the groups serving a range of a
+// predicate. A uid is only found by the group serving it, so the rows are joined.
+func mergeResults(results []*pb.Result) (*pb.Result, error) {
+	out := proto.Clone(results[0]).(*pb.Result)
+	for _, res := range results[1:] {
+		if len(res.UidMatrix) != len(out.UidMatrix) || len(res.Counts) != len(out.Counts) {
+			return nil, errors.Errorf("Results of the groups serving a predicate don't match")
+		}
+		for i, list := range res.UidMatrix {
+			hasFacets := len(out.FacetMatrix) == len(out.UidMatrix) &&
+				len(res.FacetMatrix) == len(res.UidMatrix)
+			if hasFacets {
+				out.UidMatrix[i], out.FacetMatrix[i] = mergeFacetRows(out.UidMatrix[i],
+					out.FacetMatrix[i], list, res.FacetMatrix[i])
+				continue
+			}
+			out.UidMatrix[i] = algo.MergeSorted([]*pb.List{out.UidMatrix[i], list})
+		}
+		for i, c := range res.Counts {
+			out.Counts[i] += c
+		}
+		for i, vals := range res.ValueMatrix {
+			if i >= len(out.ValueMatrix) {
+				out.ValueMatrix = append(out.ValueMatrix, vals)
+			} else if len(out.ValueMatrix[i].GetValues()) == 0 {
+				out.ValueMatrix[i] = vals
+			}
+		}
+		for i, langs := range res.LangMatrix {
+			if i >= len(out.LangMatrix) {
+				out.LangMatrix = append(out.LangMatrix, langs)
+			} else if len(out.LangMatrix[i].GetLang()) == 0 {
+				out.LangMatrix[i] = langs
+			}
+		}
+		out.IntersectDest = out.IntersectDest || res.IntersectDest
+		out.List = out.List || res.List
+		out.ReadKeys = append(out.ReadKeys, res.ReadKeys...)
+		out.Access = MergeAccess(out.Access, res.Access)
+		mergeVectorMetrics(out, res)
+	}
+	return out, nil
+}
+
+// mergeFacetRows merges two sorted rows of uids along with the facets of each uid.
+func mergeFacetRows(a *pb.List, af *pb.FacetsList, b *pb.List,
+	bf *pb.FacetsList) (*pb.List, *pb.FacetsList) {
+
+	uids := &pb.List{Uids: make([]uint64, 0, len(a.Uids)+len(b.Uids))}
+	facets := &pb.FacetsList{}
+	var i, j int
+	for i < len(a.Uids) || j < len(b.Uids) {
+		switch {
+		case j == len(b.Uids) || (i < len(a.Uids) && a.Uids[i] < b.Uids[j]):
+			uids.Uids = append(uids.Uids, a.Uids[i])
+			facets.FacetsList = append(facets.FacetsList, af.FacetsList[i])
+			i++
+		case i == len(a.Uids) || b.Uids[j] < a.Uids[i]:
+			uids.Uids = append(uids.Uids, b.Uids[j])
+			facets.FacetsList = append(facets.FacetsList, bf.FacetsList[j])
+			j++
+		default:
+			uids.Uids = append(uids.Uids, a.Uids[i])
+			facets.FacetsList = append(facets.FacetsList, af.FacetsList[i])
+			i++
+			j++
+		}
+	}
+	return uids, facets
+}
+
+func mergeVectorMetrics(out, res *pb.Result) {
+	if len(res.VectorMetrics) > 0 && out.VectorMetrics == nil {
+		out.VectorMetrics = make(map[string]uint64)
+	}
+	for k, v := range res.VectorMetrics {
+		out.VectorMetrics[k] += v
+	}
+}
+
+// cleanRange deletes the data of the predicate outside of the ranges served by this group, and
+// rebuilds its indexes from the data left. It runs after a range of the predicate moved to or
+// from this group.
+func cleanRange(ctx context.Context, attr string, ts uint64) error {
+	gid := groups().groupId()
+	ranges := groups().rangesOf(attr)
+	if len(ranges) == 0 {
+		owner, err := groups().BelongsToReadOnly(attr, 0)
+		if err != nil {
+			return err
+		}
+		if owner != gid {
+			return posting.DeletePredicate(ctx, attr, ts)
+		}
+	} else {
+		if !groups().servesRange(attr) {
+			return posting.DeletePredicate(ctx, attr, ts)
+		}
+		keep := func(uid uint64) bool { return rangeGroup(ranges, uid) == gid }
+		if err := posting.DeletePredicateUids(ctx, attr, ts, keep); err != nil {
+			return err
+		}
+	}
+	glog.Infof("Rebuilding the indexes of predicate %s after a range move", x.ParseAttr(attr))
+	return posting.RebuildIndexes(ctx, attr, ts)
+}
@@ -0,0 +1,176 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestSplitUids(t *testing.T) {
+	ranges := []*pb.TabletRange{{StartUid: 0, GroupId: 1}, {StartUid: 10, GroupId: 2},
+		{StartUid: 20, GroupId: 1}}
+	require.Equal(t, uint32(1), rangeGroup(ranges, 9))
+	require.Equal(t, uint32(2), rangeGroup(ranges, 10))
+	require.Equal(t, uint32(1), rangeGroup(ranges, 1<<40))
+	require.Equal(t, []uint32{1, 2}, rangeGroups(ranges))
+
+	require.Equal(t, []uidPart{
+		{gid: 1, uids: []uint64{1, 9}},
+		{gid: 2, uids: []uint64{10, 15}},
+		{gid: 1, uids: []uint64{20, 100}},
+	}, splitUids([]uint64{1, 9, 10, 15, 20, 100}, ranges))
+	require.Equal(t, []uidPart{{gid: 2, uids: []uint64{12}}}, splitUids([]uint64{12}, ranges))
+	require.Empty(t, splitUids(nil, ranges))
+}
+
+func TestOnUidRanges(t *testing.T) {
+	eq := &pb.SrcFunction{Name: "eq", Args: []string{"alice"}}
+	route := func(q *pb.Query) bool {
+		fnType, _ := parseFuncType(q.SrcFunc)
+		return onUidRanges(q, fnType)
+	}
+	// A root eq() has no uids, it runs on every group serving a range of the predicate.
+	require.False(t, route(&pb.Query{SrcFunc: eq}))
+	require.True(t, route(&pb.Query{SrcFunc: eq, UidList: &pb.List{Uids: []uint64{1, 12}}}))
+	require.True(t, route(&pb.Query{UidList: &pb.List{Uids: []uint64{1}}}))
+	require.False(t, route(&pb.Query{UidList: &pb.List{Uids: []uint64{1}}, Reverse: true}))
+	require.False(t, route(&pb.Query{SrcFunc: &pb.SrcFunction{Name: "has"},
+		UidList: &pb.List{Uids: []uint64{1}}}))
+}
+
+func TestMergeResults(t *testing.T) {
+	facets := func(keys ...string) *pb.FacetsList {
+		fl := &pb.FacetsList{}
+		for _, k := range keys {
+			fl.FacetsList = append(fl.FacetsList, &pb.Facets{Facets: []*api.Facet{{Key: k}}})
+		}
+		return fl
+	}
+	out, err := mergeResults([]*pb.Result{
+		{
+			UidMatrix:   []*pb.List{{Uids: []uint64{1, 5}}, {Uids: []uint64{2}}},
+			FacetMatrix: []*pb.FacetsList{facets("a1", "a5"), facets("a2")},
+			Counts:      []uint32{2, 1},
+			ReadKeys:    []uint64{10},
+		},
+		{
+			UidMatrix:   []*pb.List{{Uids: []uint64{3}}, {}},
+			FacetMatrix: []*pb.FacetsList{facets("b3"), facets()},
+			Counts:      []uint32{1, 0},
+			ReadKeys:    []uint64{20, 30},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3, 5}, out.UidMatrix[0].Uids)
+	require.Equal(t, []uint64{2}, out.UidMatrix[1].Uids)
+	require.Equal(t, []uint32{3, 1}, out.Counts)
+	require.Equal(t, []uint64{10, 20, 30}, out.ReadKeys)
+	var keys []string
+	for _, f := range out.FacetMatrix[0].FacetsList {
+		keys = append(keys, f.Facets[0].Key)
+	}
+	require.Equal(t, []string{"a1", "b3", "a5"}, keys)
+
+	_, err = mergeResults([]*pb.Result{{UidMatrix: []*pb.List{{}}}, {}})
+	require.Error(t, err)
+}
+
+func TestCleanRange(t *testing.T) {
+	ps, err := badger.OpenManaged(badger.DefaultOptions(t.TempDir()).WithLogger(nil))
+	require.NoError(t, err)
+	defer ps.Close()
+	pstore = ps
+	posting.Init(ps, 0, false)
+	Init(ps)
+	require.NoError(t, schema.ParseBytes([]byte("rangeName: string @index(exact) ."), 1))
+
+	attr := x.AttrInRootNamespace("rangeName")
+	txn := posting.Oracle().RegisterStartTs(5)
+	for uid, name := range map[uint64]string{1: "alice", 2: "bob", 3: "alice", 4: "carol"} {
+		require.NoError(t, runMutation(context.Background(), &pb.DirectedEdge{
+			Value:     []byte(name),
+			ValueType: pb.Posting_STRING,
+			Attr:      attr,
+			Entity:    uid,
+			Op:        pb.DirectedEdge_SET,
+		}, txn))
+	}
+	txn.Update()
+	writer := posting.NewTxnWriter(pstore)
+	require.NoError(t, txn.CommitToDisk(writer, 6))
+	require.NoError(t, writer.Flush())
+	txn.UpdateCachedKeys(6)
+
+	// This group serves the uids below 3, the others moved to group 2.
+	gid := atomic.SwapUint32(&groups().gid, 1)
+	groups().Lock()
+	groups().tabletRanges = map[string]*pb.TabletRanges{attr: {Predicate: attr,
+		Ranges: []*pb.TabletRange{{StartUid: 0, GroupId: 1}, {StartUid: 3, GroupId: 2}}}}
+	groups().Unlock()
+	defer func() {
+		atomic.StoreUint32(&groups().gid, gid)
+		groups().Lock()
+		groups().tabletRanges = nil
+		groups().Unlock()
+	}()
+	require.NoError(t, cleanRange(context.Background(), attr, 7))
+
+	uids := func(key []byte) []uint64 {
+		pl, err := posting.GetNoStore(key, 8)
+		require.NoError(t, err)
+		list, err := pl.Uids(posting.ListOptions{ReadTs: 8})
+		require.NoError(t, err)
+		return list.Uids
+	}
+	for uid, want := range map[uint64]int{1: 1, 2: 1, 3: 0, 4: 0} {
+		pl, err := posting.GetNoStore(x.DataKey(attr, uid), 8)
+		require.NoError(t, err)
+		vals, err := pl.AllValues(8)
+		require.NoError(t, err)
+		require.Len(t, vals, want, "uid %d", uid)
+	}
+	require.Equal(t, []uint64{1}, uids(x.IndexKey(attr, "\x02alice")))
+	require.Empty(t, uids(x.IndexKey(attr, "\x02carol")))
+}
+
+func TestRangesAt(t *testing.T) {
+	attr := x.AttrInRootNamespace("rangeName")
+	ranges := []*pb.TabletRange{{StartUid: 0, GroupId: 1}, {StartUid: 3, GroupId: 2}}
+	g := &groupi{tabletRanges: map[string]*pb.TabletRanges{attr: {Predicate: attr,
+		Ranges: ranges, MoveTs: 10}}}
+
+	got, err := g.rangesAt(attr, 10)
+	require.NoError(t, err)
+	require.Equal(t, ranges, got)
+	// A stale read from before the move would miss the data moved to group 2.
+	_, err = g.rangesAt(attr, 9)
+	require.ErrorContains(t, err, "StartTs: 9 is from before MoveTs: 10")
+
+	got, err = g.rangesAt(x.AttrInRootNamespace("name"), 9)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func TestSplitPredicates(t *testing.T) {
+	require.Empty(t, splitPredicates(&pb.MembershipState{}))
+
+	state := &pb.MembershipState{TabletRanges: map[string]*pb.TabletRanges{
+		x.NamespaceAttr(2, "name"):   {},
+		x.AttrInRootNamespace("age"): {},
+	}}
+	require.Equal(t, []string{"age (namespace 0)", "name (namespace 2)"}, splitPredicates(state))
+}
@@ -7,6 +7,7 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"sort"
@@ -33,8 +34,6 @@ import (
 	"github.com/hypermodeinc/dgraph/v25/schema"
 	ctask "github.com/hypermodeinc/dgraph/v25/task"
 	"github.com/hypermodeinc/dgraph/v25/tok"
-	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
-	"github.com/hypermodeinc/dgraph/v25/tok/index"
 	"github.com/hypermodeinc/dgraph/v25/types"
 	"github.com/hypermodeinc/dgraph/v25/types/facets"
 	"github.com/hypermodeinc/dgraph/v25/x"
@@ -61,7 +60,7 @@ func processWithBackupRequest(
 	ctx context.Context,
 	gid uint32,
 	f func(context.Context, pb.WorkerClient) (interface{}, error)) (interface{}, error) {
-	addrs := groups().AnyTwoServers(gid)
+	addrs := groups().closestServers(gid, preferredZone(ctx), 2)
 	if len(addrs) == 0 {
 		return nil, errors.New("No network connection")
 	}
@@ -139,6 +138,47 @@ func ProcessTaskOverNetwork(ctx context.Context, q *pb.Query) (*pb.Result, error
 		attribute.String("readTs", fmt.Sprintf("%d", q.ReadTs)),
 		attribute.String("node_id", fmt.Sprintf("%d", groups().Node.Id))))
 
+	rs := readSetFrom(ctx)
+	q.Serializable = rs != nil
+
+	ranges, err := groups().rangesAt(attr, q.ReadTs)
+	if err != nil {
+		return nil, err
+	}
+	var result *pb.Result
+	if len(ranges) > 0 {
+		result, err = processTaskOverRanges(ctx, q, ranges)
+	} else {
+		result, err = processTaskOnGroup(ctx, q, gid)
+	}
+	if err == nil && rs != nil {
+		rs.add(result.ReadKeys)
+		if iteratesPredicate(q) {
+			rs.add([]uint64{posting.PredicateFingerprint(attr)})
+		}
+	}
+	return result, err
+}
+
+// processTaskOnGroup processes the query on the given group.
+func processTaskOnGroup(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, error) {
+	if maxStaleness := MaxStaleness(ctx); maxStaleness > 0 {
+		addr, local := groups().readReplica(gid, q.ReadTs, maxStaleness, preferredZone(ctx))
+		if local {
+			return processTask(ctx, q, gid)
+		}
+		if addr != "" {
+			reply, err := invokeNetworkRequest(ctx, addr,
+				func(ctx context.Context, c pb.WorkerClient) (interface{}, error) {
+					return c.ServeTask(ctx, q)
+				})
+			if err == nil {
+				return reply.(*pb.Result), nil
+			}
+			glog.V(2).Infof("While reading %s from replica %s: %v. Falling back to any replica.",
+				x.ParseAttr(q.Attr), addr, err)
+		}
+	}
 	if groups().ServesGroup(gid) {
 		// No need for a network call, as this should be run from within this instance.
 		return processTask(ctx, q, gid)
@@ -153,10 +193,10 @@ func ProcessTaskOverNetwork(ctx context.Context, q *pb.Query) (*pb.Result, error
 	}
 
 	reply := result.(*pb.Result)
-	span.AddEvent("Reply from server", trace.WithAttributes(
+	trace.SpanFromContext(ctx).AddEvent("Reply from server", trace.WithAttributes(
 		attribute.Int("len", len(reply.UidMatrix)),
 		attribute.Int64("gid", int64(gid)),
-		attribute.String("attr", attr)))
+		attribute.String("attr", q.Attr)))
 	return reply, nil
 }
 
@@ -216,6 +256,7 @@ const (
 	customIndexFn
 	matchFn
 	similarToFn
+	searchFn
 	standardFn = 100
 )
 
@@ -256,6 +297,8 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 		return uidInFn, f
 	case "similar_to":
 		return similarToFn, f
+	case "search":
+		return searchFn, f
 	case "anyof", "allof":
 		return customIndexFn, f
 	case "match":
@@ -280,7 +323,7 @@ func needsIndex(fnType FuncType, uidList *pb.List) bool {
 		return true
 	case geoFn, fullTextSearchFn, standardFn, matchFn:
 		return true
-	case similarToFn:
+	case similarToFn, searchFn:
 		return true
 	}
 	return false
@@ -311,7 +354,7 @@ func (srcFn *functionContext) needsValuePostings(typ types.TypeID) (bool, error)
 			return false, nil
 		}
 		return true, nil
-	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn:
+	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn, searchFn:
 		// All of these require an index, hence would require fetching uid postings.
 		return false, nil
 	case uidInFn, compareScalarFn:
@@ -347,38 +390,7 @@ func (qs *queryState) handleValuePostings(ctx context.Context, args funcArgs) er
 	}
 
 	if srcFn.fnType == similarToFn {
-		numNeighbors, err := strconv.ParseInt(q.SrcFunc.Args[0], 10, 32)
-		if err != nil {
-			return fmt.Errorf("invalid value for number of neighbors: %s", q.SrcFunc.Args[0])
-		}
-		cspec, err := pickFactoryCreateSpec(ctx, args.q.Attr)
-		if err != nil {
-			return err
-		}
-		//TODO: generate maxLevels from schema, filter, etc.
-		qc := hnsw.NewQueryCache(
-			posting.NewViLocalCache(qs.cache),
-			args.q.ReadTs,
-		)
-		indexer, err := cspec.CreateIndex(args.q.Attr)
-		if err != nil {
-			return err
-		}
-		var nnUids []uint64
-		if srcFn.vectorInfo != nil {
-			nnUids, err = indexer.Search(ctx, qc, srcFn.vectorInfo,
-				int(numNeighbors), index.AcceptAll[float32])
-		} else {
-			nnUids, err = indexer.SearchWithUid(ctx, qc, srcFn.vectorUid,
-				int(numNeighbors), index.AcceptAll[float32])
-		}
-
-		if err != nil && !strings.Contains(err.Error(), hnsw.EmptyHNSWTreeError+": "+badger.ErrKeyNotFound.Error()) {
-			return err
-		}
-		sort.Slice(nnUids, func(i, j int) bool { return nnUids[i] < nnUids[j] })
-		args.out.UidMatrix = append(args.out.UidMatrix, &pb.List{Uids: nnUids})
-		return nil
+		return qs.handleSimilarToFunction(ctx, args)
 	}
 
 	if srcFn.atype == types.PasswordID && srcFn.fnType != passwordFn {
@@ -832,7 +844,8 @@ func (qs *queryState) handleUidPostings(
 				return err
 			}
 
-			if srcFn.fnType == compareAttrFn {
+			switch srcFn.fnType {
+			case compareAttrFn, standardFn, fullTextSearchFn:
 				posting.GetStatsHolder().InsertRecord(
 					q.Attr, []byte(srcFn.tokens[i]), uint64(pl.ApproxLen()))
 			}
@@ -1019,23 +1032,40 @@ func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, erro
 		return nil, err
 	case knownGid == 0:
 		return nil, errNonExistentTablet
-	case knownGid != groups().groupId():
+	case knownGid != groups().groupId() && !groups().servesRange(q.Attr):
 		return nil, errUnservedTablet
 	}
+	// The versions older than the history retention window of the group might be discarded.
+	if err := checkAsOf(q.AsOf, q.ReadTs); err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	defer func() {
+		tabletLoads.addQuery(q.Attr, time.Since(start))
+	}()
 
 	var qs queryState
-	if q.Cache == UseTxnCache {
+	if q.Cache == UseTxnCache || q.Serializable {
 		qs.cache = posting.Oracle().CacheAt(q.ReadTs)
 	}
 	if qs.cache == nil {
 		qs.cache = posting.NoCache(q.ReadTs)
 	}
+	if q.Serializable {
+		// The keys read are tracked by the cache of the transaction if it wrote to this group, to
+		// read its own writes, or by a cache of this task otherwise. This doesn't register the
+		// transaction as pending, it only has to keep the read keys until they are returned.
+		qs.cache.TrackReads()
+	}
 	// For now, remove the query level cache. It is causing contention for queries with high
 	// fan-out.
 	out, err := qs.helpProcessTask(ctx, q, gid)
 	if err != nil {
 		return nil, err
 	}
+	if q.Serializable {
+		out.ReadKeys = qs.cache.ReadKeys()
+	}
 	return out, nil
 }
 
@@ -1054,6 +1084,7 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 	if err != nil {
 		return nil, err
 	}
+	out.Access = accessPath(q, srcFn)
 
 	if q.Reverse && !schema.State().IsReversed(ctx, attr) {
 		return nil, errors.Errorf("Predicate %s doesn't have reverse edge", x.ParseAttr(attr))
@@ -1101,6 +1132,14 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 	}
 
 	args := funcArgs{q, gid, srcFn, out}
+	if q.DoHistory {
+		span.AddEvent("handleHistory")
+		if err := qs.handleHistory(ctx, args); err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+
 	needsValPostings, err := srcFn.needsValuePostings(typ)
 	if err != nil {
 		return nil, err
@@ -1145,6 +1184,13 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 		}
 	}
 
+	if srcFn.fnType == searchFn {
+		span.AddEvent("handleSearchFunction")
+		if err := qs.handleSearchFunction(ctx, args); err != nil {
+			return nil, err
+		}
+	}
+
 	// We fetch the actual value for the uids, compare them to the value in the
 	// request and filter the uids only if the tokenizer IsLossy.
 	if srcFn.fnType == compareAttrFn && len(srcFn.tokens) > 0 {
@@ -1782,8 +1828,15 @@ type functionContext struct {
 	isFuncAtRoot   bool
 	isStringFn     bool
 	atype          types.TypeID
-	vectorInfo     []float32
-	vectorUid      uint64
+	// vectorInfo holds the query vectors of similar_to, several of them for a late interaction
+	// search of a list of vectors.
+	vectorInfo [][]float32
+	vectorUid  uint64
+	// vectorThreshold is the optional cutoff of the scores of similar_to.
+	vectorThreshold *float64
+	// vectorAggregation is how similar_to scores a node from the scores of the vectors of its
+	// list, either maxSim or sumSim.
+	vectorAggregation string
 }
 
 const (
@@ -1859,7 +1912,7 @@ func planForEqFilter(fc *functionContext, pred string, uidlist []uint64) {
 	}
 
 	// TODO make a different config
-	if gotEstimate && uint64(len(uidlist)) < estimatedCount/Config.TypeFilterUidLimit {
+	if gotEstimate && uidListIsCheaper(uidlist, estimatedCount) {
 		fc.tokens = fc.tokens[:0]
 		fc.n = len(uidlist)
 		return
@@ -1985,6 +2038,9 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 		case q.UidList != nil && len(fc.tokens) > len(q.UidList.Uids) && fc.fname != eq:
 			fc.tokens = fc.tokens[:0]
 			fc.n = len(q.UidList.Uids)
+		case q.UidList != nil && fc.fname != eq && planForIneqFilter(ctx, q):
+			fc.tokens = fc.tokens[:0]
+			fc.n = len(q.UidList.Uids)
 		case q.UidList != nil && fc.fname == eq:
 			if len(fc.tokens) > 0 {
 				planForEqFilter(fc, attr, q.UidList.Uids)
@@ -2040,6 +2096,22 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 		}
 		fc.intersectDest = needsIntersect(f)
 		fc.n = len(fc.tokens)
+	case searchFn:
+		if err = ensureArgsCount(q.SrcFunc, 1); err != nil {
+			return nil, err
+		}
+		required, found := verifyStringIndex(ctx, attr, fnType)
+		if !found {
+			return nil, errors.Errorf("Attribute %s is not indexed with type %s", x.ParseAttr(attr),
+				required)
+		}
+		lang := langForFunc(q.Langs)
+		if lang == "." {
+			lang = "en"
+		}
+		// The terms are looked up by handleSearchFunction, not by handleUidPostings.
+		fc.tokens = tok.GetBM25Terms(q.SrcFunc.Args[0], lang)
+		fc.n = 0
 	case matchFn:
 		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
 			return nil, err
@@ -2111,10 +2183,30 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 		}
 		checkRoot(q, fc)
 	case similarToFn:
+		// The threshold and the aggregation are optional last arguments, in any order.
+		if len(q.SrcFunc.Args) > 2 && len(q.SrcFunc.Args) <= 4 {
+			for _, arg := range q.SrcFunc.Args[2:] {
+				if (arg == maxSim || arg == sumSim) && fc.vectorAggregation == "" {
+					fc.vectorAggregation = arg
+					continue
+				}
+				threshold, err := strconv.ParseFloat(arg, 64)
+				if err != nil || fc.vectorThreshold != nil {
+					return nil, errors.Errorf("Invalid threshold or aggregation %q for similar_to",
+						arg)
+				}
+				fc.vectorThreshold = &threshold
+			}
+			q.SrcFunc.Args = q.SrcFunc.Args[:2]
+		}
 		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
 			return nil, err
 		}
-		fc.vectorInfo, fc.vectorUid, err = interpretVFloatOrUid(q.SrcFunc.Args[1])
+		fc.vectorInfo, fc.vectorUid, err = interpretVFloatsOrUid(q.SrcFunc.Args[1])
+		if su, ok := schema.State().Get(ctx, attr); err != nil && ok && su.EmbeddingSource != "" {
+			// The argument is neither a vector nor a uid, search by the embedding of the text.
+			fc.vectorInfo, err = embedQuery(ctx, attr, q.SrcFunc.Args[1])
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -2143,16 +2235,21 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 	return fc, nil
 }
 
-func interpretVFloatOrUid(val string) ([]float32, uint64, error) {
+// interpretVFloatsOrUid parses val as a vector, a list of vectors or a uid.
+func interpretVFloatsOrUid(val string) ([][]float32, uint64, error) {
 	vf, err := types.ParseVFloat(val)
 	if err == nil {
-		return vf, 0, nil
+		return [][]float32{vf}, 0, nil
+	}
+	var vfs [][]float32
+	if err := json.Unmarshal([]byte(val), &vfs); err == nil && len(vfs) > 0 {
+		return vfs, 0, nil
 	}
 	uid, err := strconv.ParseUint(val, 0, 64)
 	if err == nil {
 		return nil, uid, nil
 	}
-	return nil, uid, errors.Errorf("Value %q is not a uid or vector", val)
+	return nil, uid, errors.Errorf("Value %q is not a uid, vector or list of vectors", val)
 }
 
 // ServeTask is used to respond to a query.
@@ -2176,9 +2273,10 @@ func (w *grpcWorker) ServeTask(ctx context.Context, q *pb.Query) (*pb.Result, er
 		return nil, err
 	case gid == 0:
 		return nil, errNonExistentTablet
-	case gid != groups().groupId():
+	case gid != groups().groupId() && !groups().servesRange(q.Attr):
 		return nil, errUnservedTablet
 	}
+	gid = groups().groupId()
 
 	var numUids int
 	if q.UidList != nil {
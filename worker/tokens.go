@@ -25,6 +25,8 @@ func verifyStringIndex(ctx context.Context, attr string, funcType FuncType) (str
 		requiredTokenizer = tok.FullTextTokenizer{}
 	case matchFn:
 		requiredTokenizer = tok.TrigramTokenizer{}
+	case searchFn:
+		requiredTokenizer = tok.BM25Tokenizer{}
 	default:
 		requiredTokenizer = tok.TermTokenizer{}
 	}
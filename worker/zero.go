@@ -35,3 +35,15 @@ func MoveTabletOverNetwork(ctx context.Context, req *pb.MoveTabletRequest) (*pb.
 	c := pb.NewZeroClient(pl.Get())
 	return c.MoveTablet(ctx, req)
 }
+
+// AddLearnerOverNetwork sends a request to add a learner to the given group to the current zero
+// leader.
+func AddLearnerOverNetwork(ctx context.Context, req *pb.AddLearnerRequest) (*pb.Status, error) {
+	pl := groups().Leader(0)
+	if pl == nil {
+		return nil, conn.ErrNoConnection
+	}
+
+	c := pb.NewZeroClient(pl.Get())
+	return c.AddLearner(ctx, req)
+}
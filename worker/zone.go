@@ -0,0 +1,87 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+/*
+Alphas can be labelled with the zone or region they run in, with the zone option of the raft flag
+or when a learner is added through /admin. Learners get all the updates of their group but don't
+vote, so a group can have read replicas in other regions without slowing down its writes.
+
+The tasks of a query are sent to the replicas in the zone asked for by the client, or in the zone
+of the Alpha the query was sent to. A client picks the Alphas in its region from the labels of the
+members in the membership state. Outside of the zone, the voters are preferred, as the learners
+may be far away and lag behind.
+*/
+
+type zoneKey struct{}
+
+// WithZone returns a context that has the tasks of a query sent to the replicas in the zone.
+func WithZone(ctx context.Context, zone string) context.Context {
+	return context.WithValue(ctx, zoneKey{}, zone)
+}
+
+// RequestedZone returns the zone a gRPC client passed as metadata, if any.
+func RequestedZone(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["zone"]) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(md["zone"][0])
+}
+
+// preferredZone returns the zone to send the tasks of the query run with ctx to.
+func preferredZone(ctx context.Context) string {
+	if zone, _ := ctx.Value(zoneKey{}).(string); zone != "" {
+		return zone
+	}
+	return groups().zone
+}
+
+// closestServers returns the addresses of up to n members of the group, the ones in the zone
+// first, then the voters. Members with the same rank come in random order to spread the load.
+func (g *groupi) closestServers(gid uint32, zone string, n int) []string {
+	var members []*pb.Member
+	// map iteration gives us members in no particular order.
+	for _, m := range g.members(gid) {
+		members = append(members, m)
+	}
+	rankMembers(members, zone)
+
+	res := []string{}
+	for _, m := range members {
+		if len(res) >= n {
+			break
+		}
+		res = append(res, m.Addr)
+	}
+	return res
+}
+
+// rankMembers orders the members by how close they are to the zone.
+func rankMembers(members []*pb.Member, zone string) {
+	rank := func(m *pb.Member) int {
+		switch {
+		case zone != "" && m.Zone == zone:
+			return 0
+		case !m.Learner:
+			return 1
+		}
+		return 2
+	}
+	sort.SliceStable(members, func(i, j int) bool {
+		return rank(members[i]) < rank(members[j])
+	})
+}
@@ -0,0 +1,55 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+func TestRankMembers(t *testing.T) {
+	members := func() []*pb.Member {
+		return []*pb.Member{
+			{Addr: "eu-learner", Learner: true, Zone: "eu"},
+			{Addr: "us-voter", Zone: "us"},
+			{Addr: "ap-learner", Learner: true, Zone: "ap"},
+			{Addr: "voter"},
+		}
+	}
+	addrs := func(members []*pb.Member) []string {
+		var res []string
+		for _, m := range members {
+			res = append(res, m.Addr)
+		}
+		return res
+	}
+
+	// Without a zone, the voters come before the learners.
+	ms := members()
+	rankMembers(ms, "")
+	require.Equal(t, []string{"us-voter", "voter", "eu-learner", "ap-learner"}, addrs(ms))
+
+	// The members in the zone come first, even the learners.
+	ms = members()
+	rankMembers(ms, "eu")
+	require.Equal(t, []string{"eu-learner", "us-voter", "voter", "ap-learner"}, addrs(ms))
+
+	ms = members()
+	rankMembers(ms, "us")
+	require.Equal(t, []string{"us-voter", "voter", "eu-learner", "ap-learner"}, addrs(ms))
+}
+
+func TestRequestedZone(t *testing.T) {
+	require.Equal(t, "", RequestedZone(context.Background()))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("zone", " eu-west "))
+	require.Equal(t, "eu-west", RequestedZone(ctx))
+	require.Equal(t, "eu-west", preferredZone(WithZone(ctx, RequestedZone(ctx))))
+}
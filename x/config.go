@@ -60,6 +60,9 @@ type Options struct {
 
 	// feature flags
 	NormalizeCompatibilityMode string
+	// QueryPlanner enables reordering of filters and root functions based on predicate
+	// statistics.
+	QueryPlanner bool
 }
 
 // Config stores the global instance of this package's options.
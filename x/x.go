@@ -128,6 +128,9 @@ const (
 		"Content-Type, Content-Length, Accept-Encoding, Cache-Control, " +
 		"X-CSRF-Token, X-Auth-Token, X-Requested-With"
 	DgraphCostHeader = "Dgraph-TouchedUids"
+	// DgraphExplainHeader is the gRPC header carrying the explain output of a query. It is a
+	// binary header, as the output holds the function arguments which may not be ASCII.
+	DgraphExplainHeader = "Dgraph-Explain-Bin"
 
 	ManifestVersion = 2105
 )
@@ -678,6 +681,15 @@ func Unique(a []string) []string {
 	return a[:idx]
 }
 
+// The conflict keys of a transaction are sent to Zero as fingerprints in base 36. A serializable
+// transaction also sends the keys it read, with ReadKeyPrefix, which Zero checks for commits since
+// the transaction started. To catch those commits, transactions send the keys they wrote which
+// aren't conflict keys, with WriteKeyPrefix, which Zero only records.
+const (
+	ReadKeyPrefix  = "r:"
+	WriteKeyPrefix = "w:"
+)
+
 // ReadLine reads a single line from a buffered reader. The line is read into the
 // passed in buffer to minimize allocations. This is the preferred
 // method for loading long lines which could be longer than the buffer